@@ -60,10 +60,11 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 }
 
 const (
-	// MaxClassIDLength values chosen arbitrarily
-	MaxClassIDLength      = 2048
-	MaxTokenIds           = 256
-	MaxTokenIDLength      = 2048
+	// MaxClassIDLength and the token limits mirror the default nft-transfer params,
+	// which enforce them in consensus; the receiver length is chosen arbitrarily
+	MaxClassIDLength      = nfttypes.DefaultMaxClassIDLength
+	MaxTokenIds           = nfttypes.DefaultMaxTokenIds
+	MaxTokenIDLength      = nfttypes.DefaultMaxTokenIDLength
 	MaximumReceiverLength = 2048
 )
 
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
@@ -28,6 +29,7 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v10/modules/core"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solom "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
@@ -35,12 +37,17 @@ import (
 	"github.com/crypto-org-chain/chain-main/v8/app/docs"
 	appparams "github.com/crypto-org-chain/chain-main/v8/app/params"
 	"github.com/crypto-org-chain/chain-main/v8/x/chainmain"
+	chainmainrest "github.com/crypto-org-chain/chain-main/v8/x/chainmain/client/rest"
 	chainmainkeeper "github.com/crypto-org-chain/chain-main/v8/x/chainmain/keeper"
 	chainmaintypes "github.com/crypto-org-chain/chain-main/v8/x/chainmain/types"
 	inflation "github.com/crypto-org-chain/chain-main/v8/x/inflation"
 	inflationkeeper "github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
 	inflationtypes "github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft"
+	nfttransfer "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer"
+	nfttransferkeeper "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/keeper"
+	nfttransfertypes "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	nfttransferv2 "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/v2"
 	nftkeeper "github.com/crypto-org-chain/chain-main/v8/x/nft/keeper"
 	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 	supply "github.com/crypto-org-chain/chain-main/v8/x/supply"
@@ -69,6 +76,7 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	sdknft "cosmossdk.io/x/nft"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -157,6 +165,7 @@ var (
 		stakingtypes.BondedPoolName:        {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:     {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:                {authtypes.Burner},
+		inflationtypes.ModuleName:          {authtypes.Burner},
 		ibctransfertypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:                nil,
 		tieredrewardstypes.RewardsPoolName: nil,
@@ -225,6 +234,7 @@ type ChainApp struct {
 	chainmainKeeper       chainmainkeeper.Keeper
 	SupplyKeeper          supplykeeper.Keeper
 	NFTKeeper             nftkeeper.Keeper
+	NFTTransferKeeper     nfttransferkeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
 	InflationKeeper       inflationkeeper.Keeper
 	TieredRewardsKeeper   tieredrewardskeeper.Keeper
@@ -339,13 +349,24 @@ func New(
 		address.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	)
-	// has to be before mint keeper since mint keeper uses the inflation keeper's DeflationCalculationFn
+	app.DistrKeeper = distrkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[distrtypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		authtypes.FeeCollectorName,
+		authAddr,
+	)
+	// has to be before mint keeper since mint keeper uses the inflation keeper's MintFn
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[inflationtypes.StoreKey]),
 		logger,
 		app.BankKeeper,
 		app.StakingKeeper,
+		app.AccountKeeper,
+		app.DistrKeeper,
 		authAddr,
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -356,17 +377,9 @@ func New(
 		app.BankKeeper,
 		authtypes.FeeCollectorName,
 		authAddr,
-		mintkeeper.WithMintFn(mintkeeper.DefaultMintFn(app.InflationKeeper.DeflationCalculationFn())),
-	)
-	app.DistrKeeper = distrkeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(keys[distrtypes.StoreKey]),
-		app.AccountKeeper,
-		app.BankKeeper,
-		app.StakingKeeper,
-		authtypes.FeeCollectorName,
-		authAddr,
+		mintkeeper.WithMintFn(app.InflationKeeper.MintFn()),
 	)
+	app.InflationKeeper.SetMintKeeper(&app.MintKeeper)
 	app.TieredRewardsKeeper = tieredrewardskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[tieredrewardstypes.StoreKey]),
@@ -376,6 +389,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.DistrKeeper,
+		app.InflationKeeper,
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
@@ -397,14 +411,6 @@ func New(
 	}
 	app.UpgradeKeeper = *upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), appCodec, homePath, app.BaseApp, authAddr)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(
-		app.DistrKeeper.Hooks(),
-		app.SlashingKeeper.Hooks(),
-		app.TieredRewardsKeeper.Hooks(),
-	))
-
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), appCodec, app.MsgServiceRouter(), app.AccountKeeper)
 
 	groupConfig := group.DefaultConfig()
@@ -414,9 +420,21 @@ func New(
 		appCodec, keys[chainmaintypes.StoreKey], keys[chainmaintypes.MemStoreKey],
 	)
 	k := keys[supplytypes.StoreKey]
-	app.SupplyKeeper = supplykeeper.NewKeeper(appCodec, k, app.BankKeeper, app.AccountKeeper)
+	app.SupplyKeeper = supplykeeper.NewKeeper(
+		appCodec, k, app.BankKeeper, app.AccountKeeper, app.InflationKeeper, app.StakingKeeper, app.TieredRewardsKeeper,
+		app.AccountKeeper.Accounts.Indexes.Number, authAddr,
+	)
+
+	// register the staking hooks
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	app.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(
+		app.DistrKeeper.Hooks(),
+		app.SlashingKeeper.Hooks(),
+		app.TieredRewardsKeeper.Hooks(),
+		app.SupplyKeeper.Hooks(),
+	))
 
-	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, keys[nfttypes.StoreKey])
+	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[nfttypes.StoreKey]))
 
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -489,12 +507,30 @@ func New(
 
 	var icaHostStack porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, keys[nfttransfertypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeperV2,
+		app.IBCKeeper.ClientKeeper,
+		app.NFTKeeper,
+		app.AccountKeeper,
+		authAddr,
+	)
+	nftTransferModule := nfttransfer.NewAppModule(app.NFTTransferKeeper)
+
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
 	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
+
+	// Create the IBC v2 router. ICS-721 stays disabled on classic channels, NFTs only
+	// move over IBC v2 light-client connections.
+	ibcRouterV2 := ibcapi.NewRouter()
+	ibcRouterV2.AddRoute(nfttransfertypes.PortID, nfttransferv2.NewIBCModule(app.NFTTransferKeeper))
+	app.IBCKeeper.SetRouterV2(ibcRouterV2)
 	clientKeeper := app.IBCKeeper.ClientKeeper
 	storeProvider := clientKeeper.GetStoreProvider()
 
@@ -558,6 +594,7 @@ func New(
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		transferModule,
 		icaModule,
+		nftTransferModule,
 		chainmain.NewAppModule(app.chainmainKeeper),
 		supply.NewAppModule(app.SupplyKeeper),
 		nft.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper),
@@ -578,6 +615,7 @@ func New(
 		})
 	app.BasicModuleManager.RegisterLegacyAminoCodec(encodingConfig.Amino)
 	app.BasicModuleManager.RegisterInterfaces(interfaceRegistry)
+	sdknft.RegisterInterfaces(interfaceRegistry)
 
 	app.ModuleManager.SetOrderPreBlockers(
 		upgradetypes.ModuleName,
@@ -590,6 +628,7 @@ func New(
 		ibctm.ModuleName,
 		solom.ModuleName,
 		ibctransfertypes.ModuleName,
+		nfttransfertypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -622,6 +661,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
+		nfttransfertypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		govtypes.ModuleName,
@@ -643,6 +683,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
+		nfttransfertypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -685,6 +726,7 @@ func New(
 		feegrant.ModuleName,
 		group.ModuleName,
 		ibctransfertypes.ModuleName,
+		nfttransfertypes.ModuleName,
 		icatypes.ModuleName,
 		chainmaintypes.ModuleName,
 		supplytypes.ModuleName,
@@ -701,6 +743,10 @@ func New(
 	if err := app.ModuleManager.RegisterServices(app.configurator); err != nil {
 		panic(err)
 	}
+	// serve the NFT module under the Cosmos SDK x/nft service names as well
+	nftAdapter := nftkeeper.NewSDKAdapter(app.NFTKeeper)
+	sdknft.RegisterMsgServer(app.MsgServiceRouter(), nftAdapter)
+	sdknft.RegisterQueryServer(app.GRPCQueryRouter(), nftAdapter)
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -887,6 +933,21 @@ func (app *ChainApp) TxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// GetBaseApp returns the base app of the application, for the ibc-go testing package.
+func (app *ChainApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetIBCKeeper returns the IBC keeper, for the ibc-go testing package.
+func (app *ChainApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetTxConfig returns the TxConfig, for the ibc-go testing package.
+func (app *ChainApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 func (app *ChainApp) EncodingConfig() appparams.EncodingConfig {
 	return appparams.EncodingConfig{
 		InterfaceRegistry: app.InterfaceRegistry(),
@@ -961,11 +1022,17 @@ func (app *ChainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	if err := sdknft.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, sdknft.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
+
+	// register the plain-text supply endpoints of data aggregators
+	chainmainrest.RegisterRoutes(clientCtx, apiSvr.Router)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -1028,6 +1095,7 @@ func StoreKeys() (
 		inflationtypes.StoreKey,
 		tieredrewardstypes.StoreKey,
 		nfttypes.StoreKey,
+		nfttransfertypes.StoreKey,
 		consensusparamtypes.StoreKey,
 		circuittypes.StoreKey,
 	}
@@ -3,9 +3,18 @@ package app
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	inflationtypes "github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	nfttransfertypes "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdknft "cosmossdk.io/x/nft"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,6 +28,23 @@ import (
 
 const UpgradeV8PlanName = "v8"
 
+// ICAHostNFTMessages are the NFT messages that interchain accounts can execute on this
+// chain, which the v8 upgrade adds to the messages allowed by the interchain accounts
+// host.
+var ICAHostNFTMessages = []string{
+	sdk.MsgTypeURL(&nfttypes.MsgIssueDenom{}),
+	sdk.MsgTypeURL(&nfttypes.MsgMintNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgEditNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgTransferNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgBurnNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgBatchMintNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgBatchTransferNFT{}),
+	sdk.MsgTypeURL(&nfttypes.MsgBatchBurnNFT{}),
+	sdk.MsgTypeURL(&sdknft.MsgSend{}),
+	sdk.MsgTypeURL(&nfttransfertypes.MsgTransfer{}),
+	sdk.MsgTypeURL(&nfttransfertypes.MsgTransferV2{}),
+}
+
 func EnsureModuleAccountIfExists(ctx sdk.Context, ak authkeeper.AccountKeeper, moduleName string, perms ...string) error {
 	addr := ak.GetModuleAddress(moduleName)
 	if addr == nil {
@@ -45,8 +71,39 @@ func EnsureModuleAccountIfExists(ctx sdk.Context, ak authkeeper.AccountKeeper, m
 	return nil
 }
 
+// AllowICAHostMessages adds the messages to the messages allowed by the interchain
+// accounts host, unless all messages are allowed.
+func AllowICAHostMessages(ctx sdk.Context, k icahostkeeper.Keeper, msgs ...string) {
+	params := k.GetParams(ctx)
+	if len(params.AllowMessages) == 1 && params.AllowMessages[0] == icahosttypes.AllowAllHostMsgs {
+		return
+	}
+	for _, msg := range msgs {
+		if !slices.Contains(params.AllowMessages, msg) {
+			params.AllowMessages = append(params.AllowMessages, msg)
+		}
+	}
+	k.SetParams(ctx, params)
+}
+
 func (app *ChainApp) RegisterUpgradeHandlers(cdc codec.BinaryCodec) {
 	app.registerV8UpgradeHandler()
+
+	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	if upgradeInfo.Name == UpgradeV8PlanName {
+		// the nft-transfer module is mounted for the first time
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{nfttransfertypes.StoreKey},
+		}
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+	}
 }
 
 // registerV8UpgradeHandler registers the "v8" plan
@@ -54,6 +111,15 @@ func (app *ChainApp) registerV8UpgradeHandler() {
 	app.UpgradeKeeper.SetUpgradeHandler(UpgradeV8PlanName, func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+		// the inflation module account burns coins, an account funded at its address
+		// beforehand would make the burns fail
+		if err := EnsureModuleAccountIfExists(sdkCtx, app.AccountKeeper, inflationtypes.ModuleName, authtypes.Burner); err != nil {
+			return map[string]uint64{}, err
+		}
+
+		// interchain accounts can manage NFTs, including through IBC
+		AllowICAHostMessages(sdkCtx, app.ICAHostKeeper, ICAHostNFTMessages...)
+
 		sdkCtx.Logger().Info("v8: running module migrations...")
 		m, err := app.ModuleManager.RunMigrations(ctx, app.configurator, fromVM)
 		if err != nil {
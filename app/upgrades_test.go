@@ -1,20 +1,36 @@
 package app_test
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
 	"github.com/crypto-org-chain/chain-main/v8/app"
 	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	inflationtypes "github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	nfttransfertypes "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
 	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
@@ -224,3 +240,76 @@ func (suite *AppTestSuite) TestEnsureModuleAccountIfExists() {
 		suite.Require().ErrorContains(err, "cannot convert to module account")
 	})
 }
+
+// TestV8UpgradeHandler tests that the v8 upgrade converts an orphan BaseAccount at the
+// inflation module address into the module account with the burner permission.
+func (suite *AppTestSuite) TestV8UpgradeHandler() {
+	suite.SetupTest()
+	addr := suite.app.AccountKeeper.GetModuleAddress(inflationtypes.ModuleName)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr))
+
+	plan := upgradetypes.Plan{Name: app.UpgradeV8PlanName, Height: suite.ctx.BlockHeight()}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan))
+
+	macc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(sdk.ModuleAccountI)
+	suite.Require().True(ok)
+	suite.Require().True(macc.HasPermission(authtypes.Burner))
+}
+
+// TestAllowICAHostMessages tests that the NFT messages are added to the messages
+// allowed by the interchain accounts host, unless all messages are allowed.
+func (suite *AppTestSuite) TestAllowICAHostMessages() {
+	suite.SetupTest()
+	suite.Require().Equal([]string{icahosttypes.AllowAllHostMsgs}, suite.app.ICAHostKeeper.GetParams(suite.ctx).AllowMessages)
+	app.AllowICAHostMessages(suite.ctx, suite.app.ICAHostKeeper, app.ICAHostNFTMessages...)
+	suite.Require().Equal([]string{icahosttypes.AllowAllHostMsgs}, suite.app.ICAHostKeeper.GetParams(suite.ctx).AllowMessages)
+
+	sendMsg := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	transferMsg := sdk.MsgTypeURL(&nfttransfertypes.MsgTransfer{})
+	suite.app.ICAHostKeeper.SetParams(suite.ctx, icahosttypes.NewParams(true, []string{sendMsg, transferMsg}))
+	plan := upgradetypes.Plan{Name: app.UpgradeV8PlanName, Height: suite.ctx.BlockHeight()}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan))
+
+	allowed := suite.app.ICAHostKeeper.GetParams(suite.ctx).AllowMessages
+	suite.Require().Equal([]string{sendMsg, transferMsg}, allowed[:2])
+	suite.Require().ElementsMatch(app.ICAHostNFTMessages, allowed[1:])
+}
+
+// loadWithoutNFTTransferStore commits a version of the stores of the previous binary,
+// which doesn't mount the nft-transfer store, and loads it with the upgrade binary
+// and the upgrade info written to disk, if any
+func loadWithoutNFTTransferStore(t *testing.T, upgradeInfo *upgradetypes.Plan) (*app.ChainApp, error) {
+	t.Helper()
+	db := dbm.NewMemDB()
+	keys, _, _ := app.StoreKeys()
+	cms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for name, key := range keys {
+		if name != nfttransfertypes.StoreKey {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.Commit()
+
+	home := t.TempDir()
+	if upgradeInfo != nil {
+		require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+		bz, err := json.Marshal(upgradeInfo)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(home, "data", upgradetypes.UpgradeInfoFilename), bz, 0o600))
+	}
+
+	chainApp := app.New(log.NewNopLogger(), db, nil, false, simtestutil.AppOptionsMap{flags.FlagHome: home})
+	return chainApp, chainApp.LoadLatestVersion()
+}
+
+func TestV8UpgradeStoreLoader(t *testing.T) {
+	// the nft-transfer store can't be loaded without the store upgrades
+	_, err := loadWithoutNFTTransferStore(t, nil)
+	require.ErrorContains(t, err, "new stores should be added using StoreUpgrades")
+
+	chainApp, err := loadWithoutNFTTransferStore(t, &upgradetypes.Plan{Name: app.UpgradeV8PlanName, Height: 2})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), chainApp.LastBlockHeight())
+	require.NotNil(t, chainApp.CommitMultiStore().GetCommitKVStore(chainApp.GetKey(nfttransfertypes.StoreKey)))
+}
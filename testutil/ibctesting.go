@@ -0,0 +1,30 @@
+package testutil
+
+import (
+	"encoding/json"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/crypto-org-chain/chain-main/v8/app"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+// SetupTestingApp initializes a new ChainApp and its default genesis for the chains
+// of an ibc-go testing coordinator, to be passed to ibctesting.NewCustomAppCoordinator.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[server.FlagInvCheckPeriod] = 5
+	appOptions[flags.FlagHome] = app.DefaultNodeHome
+	chainApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOptions)
+	return chainApp, chainApp.DefaultGenesis()
+}
+
+// ChainApp returns the ChainApp of a chain set up with SetupTestingApp.
+func ChainApp(chain *ibctesting.TestChain) *app.ChainApp {
+	return chain.App.(*app.ChainApp)
+}
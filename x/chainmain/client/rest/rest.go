@@ -1,12 +1,137 @@
 package rest
 
 import (
+	"net/http"
+	"strings"
+
+	inflationtypes "github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	supplytypes "github.com/crypto-org-chain/chain-main/v8/x/supply/types"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // RegisterRoutes registers chainmain-related REST handlers to a router
 func RegisterRoutes(ctx client.Context, r *mux.Router) {
-	// TODO: custom API (e.g. for supply summaries) to be registered here
+	registerSupplyRoutes(ctx, r)
+}
+
+// registerSupplyRoutes registers the plain-text supply endpoints of data aggregators,
+// which return the supply of the bond denom as a bare number in display units.
+func registerSupplyRoutes(ctx client.Context, r *mux.Router) {
+	r.HandleFunc("/supply/total", totalSupplyHandler(ctx)).Methods(http.MethodGet)
+	r.HandleFunc("/supply/circulating", circulatingSupplyHandler(ctx)).Methods(http.MethodGet)
+	r.HandleFunc("/supply/max", maxSupplyHandler(ctx)).Methods(http.MethodGet)
+}
+
+// supplyHandler returns a handler writing the amount returned by the query of the
+// bond denom in display units
+func supplyHandler(ctx client.Context, query func(r *http.Request, denom string) (math.Int, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stakingParams, err := stakingtypes.NewQueryClient(ctx).Params(r.Context(), &stakingtypes.QueryParamsRequest{})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		denom := stakingParams.Params.BondDenom
+
+		amount, err := query(r, denom)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		var metadata banktypes.Metadata
+		res, err := banktypes.NewQueryClient(ctx).DenomMetadata(r.Context(), &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		switch {
+		case err == nil:
+			metadata = res.Metadata
+		case status.Code(err) != codes.NotFound:
+			writeError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(DisplayAmount(amount, metadata)))
+	}
+}
+
+func totalSupplyHandler(ctx client.Context) http.HandlerFunc {
+	return supplyHandler(ctx, func(r *http.Request, denom string) (math.Int, error) {
+		res, err := supplytypes.NewQueryClient(ctx).TotalSupply(r.Context(), &supplytypes.SupplyRequest{Denom: denom}) //nolint: staticcheck
+		if err != nil {
+			return math.Int{}, err
+		}
+		return res.Supply.AmountOf(denom), nil
+	})
+}
+
+// circulatingSupplyHandler returns the liquid supply, net of the unvested amounts and
+// of the balances of the excluded accounts
+func circulatingSupplyHandler(ctx client.Context) http.HandlerFunc {
+	return supplyHandler(ctx, func(r *http.Request, denom string) (math.Int, error) {
+		res, err := supplytypes.NewQueryClient(ctx).LiquidSupply(r.Context(), &supplytypes.SupplyRequest{Denom: denom}) //nolint: staticcheck
+		if err != nil {
+			return math.Int{}, err
+		}
+		return res.Supply.AmountOf(denom), nil
+	})
+}
+
+// maxSupplyHandler returns the x/inflation max supply, and a not found error when it
+// is unlimited
+func maxSupplyHandler(ctx client.Context) http.HandlerFunc {
+	return supplyHandler(ctx, func(r *http.Request, _ string) (math.Int, error) {
+		res, err := inflationtypes.NewQueryClient(ctx).Params(r.Context(), &inflationtypes.QueryParamsRequest{})
+		if err != nil {
+			return math.Int{}, err
+		}
+		if !res.Params.MaxSupply.IsPositive() {
+			return math.Int{}, status.Error(codes.NotFound, "the max supply is unlimited")
+		}
+		return res.Params.MaxSupply, nil
+	})
+}
+
+// writeError writes the query error as plain text, with a not found status for not
+// found errors
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if status.Code(err) == codes.NotFound {
+		code = http.StatusNotFound
+	}
+	http.Error(w, err.Error(), code)
+}
+
+// DisplayAmount converts the amount of the metadata base denom to its display denom
+// unit, without trailing zeros. The amount is returned unchanged when the metadata
+// doesn't define the display denom unit.
+func DisplayAmount(amount math.Int, metadata banktypes.Metadata) string {
+	var exponent int
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			exponent = int(unit.Exponent)
+			break
+		}
+	}
+
+	digits := amount.String()
+	if exponent == 0 {
+		return digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+
+	integer, fraction := digits[:len(digits)-exponent], strings.TrimRight(digits[len(digits)-exponent:], "0")
+	if fraction == "" {
+		return integer
+	}
+	return integer + "." + fraction
 }
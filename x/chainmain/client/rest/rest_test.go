@@ -0,0 +1,43 @@
+package rest_test
+
+import (
+	"testing"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/chainmain/client/rest"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDisplayAmount(t *testing.T) {
+	metadata := banktypes.Metadata{
+		Base:    "basecro",
+		Display: "cro",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "basecro", Exponent: 0},
+			{Denom: "cro", Exponent: 8},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		amount   math.Int
+		metadata banktypes.Metadata
+		expected string
+	}{
+		{"whole amount", math.NewInt(3_000_000_000_000_000), metadata, "30000000"},
+		{"fractional amount", math.NewInt(1_234_500_000), metadata, "12.345"},
+		{"amount below one display unit", math.NewInt(42), metadata, "0.00000042"},
+		{"zero", math.ZeroInt(), metadata, "0"},
+		{"no metadata", math.NewInt(1_234_500_000), banktypes.Metadata{}, "1234500000"},
+		{"undefined display unit", math.NewInt(100), banktypes.Metadata{Display: "cro"}, "100"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, rest.DisplayAmount(tc.amount, tc.metadata))
+		})
+	}
+}
@@ -7,8 +7,6 @@ import (
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 
-	"cosmossdk.io/math"
-
 	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
@@ -18,21 +16,12 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	if err != nil {
 		panic("could not get params: " + err.Error())
 	}
-	totalsupply, denom, err := k.GetSupplyAndDenom(ctx)
+	totalsupply, _, err := k.GetSupplyNetOfBurned(ctx, params)
 	if err != nil {
-		panic("could not get supply and denom: " + err.Error())
-	}
-
-	burned := math.NewInt(0)
-	for _, ba := range params.BurnedAddresses {
-		balance, err := k.GetAddressBalance(ctx, ba, denom)
-		if err != nil {
-			panic(fmt.Sprintf("could not get %s balance: %s", ba, err.Error()))
-		}
-		burned = burned.Add(balance)
+		panic("could not get supply: " + err.Error())
 	}
-	totalsupply = totalsupply.Sub(burned)
 
+	// the mint function clamps minting at the maximum supply, this is only a safety assertion
 	maxsupply := params.MaxSupply
 	if maxsupply.IsPositive() && totalsupply.GT(maxsupply) {
 		panic(fmt.Sprintf("the total supply has exceeded the maximum supply: %s > %s", totalsupply, maxsupply))
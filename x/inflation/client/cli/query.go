@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"github.com/spf13/cobra"
@@ -22,7 +23,12 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(GetCmdQueryInflationParams())
+	cmd.AddCommand(
+		GetCmdQueryInflationParams(),
+		GetCmdQueryCurrentInflation(),
+		GetCmdQueryDecayEpochStart(),
+		GetCmdQueryInflationProjection(),
+	)
 
 	return cmd
 }
@@ -61,3 +67,114 @@ $ %s query inflation params
 
 	return cmd
 }
+
+// GetCmdQueryCurrentInflation implements the current inflation query command.
+func GetCmdQueryCurrentInflation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-inflation",
+		Args:  cobra.NoArgs,
+		Short: "Query the current inflation rate with the decay applied",
+		Long: fmt.Sprintf(`Query the current inflation rate, with the base inflation rate, decay factor and months elapsed it is calculated from.
+
+Example:
+$ %s query inflation current-inflation
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentInflation(context.Background(), &types.QueryCurrentInflationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDecayEpochStart implements the decay epoch start query command.
+func GetCmdQueryDecayEpochStart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decay-epoch-start",
+		Args:  cobra.NoArgs,
+		Short: "Query the block height from which inflation decays",
+		Long: fmt.Sprintf(`Query the block height from which inflation decays in the continuous block decay mode.
+
+Example:
+$ %s query inflation decay-epoch-start
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DecayEpochStart(context.Background(), &types.QueryDecayEpochStartRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflationProjection implements the inflation projection query command.
+func GetCmdQueryInflationProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-projection [months]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a projection of the inflation and supply for the next months",
+		Long: fmt.Sprintf(`Query a projection of the annual inflation and supply at the end of each of the next months,
+under the current bonded ratio and max supply.
+
+Example:
+$ %s query inflation inflation-projection 12
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			months, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid months: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InflationProjection(context.Background(), &types.QueryInflationProjectionRequest{Months: uint32(months)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"github.com/spf13/cobra"
@@ -12,9 +13,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const (
+	FlagDecayMode        = "decay-mode"
+	FlagDecayStartTime   = "decay-start-time"
+	FlagInflationStep    = "inflation-step"
+	FlagMintDistribution = "mint-distribution"
+
+	decayModeContinuousBlocks = "continuous-blocks"
+	decayModeContinuousTime   = "continuous-time"
+	decayModeStepSchedule     = "step-schedule"
+)
+
 // GetTxCmd returns the parent command for all x/inflation CLI tx commands.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,7 +38,11 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdUpdateParams())
+	cmd.AddCommand(
+		CmdUpdateParams(),
+		CmdBurn(),
+		CmdSweepBurnedAddresses(),
+	)
 
 	return cmd
 }
@@ -41,11 +58,21 @@ max-supply: maximum token supply (integer, 0 = unlimited)
 decay-rate: annual decay rate as a decimal (e.g. 0.068 for 6.8%%)
 burned-addresses: optional comma-separated list of burned addresses
 
+The decay mode is one of continuous-blocks (default), continuous-time, which decays from the
+decay start time, or step-schedule, which applies the inflation bounds of the inflation steps
+given as <effective-time>,<inflation-max>,<inflation-min>.
+
+Shares of the minted provisions can be diverted from the fee collector to module accounts with mint
+distributions given as <module-name>,<share>, the share of the distribution module funding the community pool.
+
 Example:
 $ %s tx inflation update-params 100000000000 0.068 --from authority
 $ %s tx inflation update-params 100000000000 0.068 cro1addr1,cro1addr2 --from authority
+$ %s tx inflation update-params 100000000000 0.068 --decay-mode=continuous-time --decay-start-time=2027-01-01T00:00:00Z --from authority
+$ %s tx inflation update-params 100000000000 0 --decay-mode=step-schedule --inflation-step=2027-01-01T00:00:00Z,0.05,0.025 --from authority
+$ %s tx inflation update-params 100000000000 0.068 --mint-distribution=rewards_pool,0.1 --mint-distribution=distribution,0.05 --from authority
 `,
-			version.AppName, version.AppName,
+			version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 		),
 		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -58,10 +85,49 @@ $ %s tx inflation update-params 100000000000 0.068 cro1addr1,cro1addr2 --from au
 			if err != nil {
 				return err
 			}
+			decayMode, err := cmd.Flags().GetString(FlagDecayMode)
+			if err != nil {
+				return err
+			}
+			decayStartTime, err := cmd.Flags().GetString(FlagDecayStartTime)
+			if err != nil {
+				return err
+			}
+			inflationSteps, err := cmd.Flags().GetStringArray(FlagInflationStep)
+			if err != nil {
+				return err
+			}
+			mintDistributions, err := cmd.Flags().GetStringArray(FlagMintDistribution)
+			if err != nil {
+				return err
+			}
 
 			authority := clientCtx.GetFromAddress().String()
 
 			params := types.NewParams(maxSupply, burnedAddresses, decayRate)
+			if params.DecayMode, err = ParseDecayMode(decayMode); err != nil {
+				return err
+			}
+			if decayStartTime != "" {
+				if params.DecayStartTime, err = time.Parse(time.RFC3339, decayStartTime); err != nil {
+					return fmt.Errorf("invalid decay start time: %w", err)
+				}
+			}
+			for _, step := range inflationSteps {
+				inflationStep, err := ParseInflationStep(step)
+				if err != nil {
+					return err
+				}
+				params.InflationSchedule = append(params.InflationSchedule, inflationStep)
+			}
+			for _, distribution := range mintDistributions {
+				mintDistribution, err := ParseMintDistribution(distribution)
+				if err != nil {
+					return err
+				}
+				params.MintDistributions = append(params.MintDistributions, mintDistribution)
+			}
+
 			msg := &types.MsgUpdateParams{
 				Authority: authority,
 				Params:    params,
@@ -71,6 +137,81 @@ $ %s tx inflation update-params 100000000000 0.068 cro1addr1,cro1addr2 --from au
 		},
 	}
 
+	cmd.Flags().String(FlagDecayMode, decayModeContinuousBlocks, "Decay mode: continuous-blocks, continuous-time or step-schedule")
+	cmd.Flags().String(FlagDecayStartTime, "", "RFC3339 block time from which inflation decays in the continuous-time decay mode")
+	cmd.Flags().StringArray(FlagInflationStep, nil, "Inflation step of the step-schedule decay mode, as <effective-time>,<inflation-max>,<inflation-min> (repeatable)")
+	cmd.Flags().StringArray(FlagMintDistribution, nil, "Share of the minted provisions diverted to a module account, as <module-name>,<share> (repeatable)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBurn returns a CLI command handler for MsgBurn.
+func CmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn coins of the sender",
+		Long: fmt.Sprintf(`Burn coins of the sender, which are destroyed and added to the burned totals.
+
+Example:
+$ %s tx inflation burn 1000000basecro --from mykey
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBurn{
+				FromAddress: clientCtx.GetFromAddress().String(),
+				Amount:      amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSweepBurnedAddresses returns a CLI command handler for MsgSweepBurnedAddresses.
+func CmdSweepBurnedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-burned-addresses",
+		Args:  cobra.NoArgs,
+		Short: "Submit a sweep-burned-addresses transaction for the inflation module",
+		Long: fmt.Sprintf(`Submit a sweep-burned-addresses transaction for the inflation module, which burns the
+balances of the burned addresses of the params, and clears them.
+
+Example:
+$ %s tx inflation sweep-burned-addresses --from authority
+`,
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSweepBurnedAddresses{
+				Authority: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -94,3 +235,57 @@ func ParseUpdateParamsArgs(args []string) (maxSupply sdkmath.Int, decayRate sdkm
 
 	return maxSupply, decayRate, burnedAddresses, nil
 }
+
+// ParseDecayMode parses the decay mode flag of the update-params command.
+func ParseDecayMode(mode string) (types.DecayMode, error) {
+	switch mode {
+	case decayModeContinuousBlocks:
+		return types.DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS, nil
+	case decayModeContinuousTime:
+		return types.DecayMode_DECAY_MODE_CONTINUOUS_TIME, nil
+	case decayModeStepSchedule:
+		return types.DecayMode_DECAY_MODE_STEP_SCHEDULE, nil
+	default:
+		return types.DecayMode_DECAY_MODE_UNSPECIFIED, fmt.Errorf("invalid decay mode: %s", mode)
+	}
+}
+
+// ParseInflationStep parses an inflation step flag of the update-params command, given
+// as <effective-time>,<inflation-max>,<inflation-min>.
+func ParseInflationStep(step string) (types.InflationStep, error) {
+	parts := strings.Split(step, ",")
+	if len(parts) != 3 {
+		return types.InflationStep{}, fmt.Errorf("invalid inflation step %s, expected <effective-time>,<inflation-max>,<inflation-min>", step)
+	}
+
+	effectiveTime, err := time.Parse(time.RFC3339, parts[0])
+	if err != nil {
+		return types.InflationStep{}, fmt.Errorf("invalid inflation step effective time: %w", err)
+	}
+	inflationMax, err := sdkmath.LegacyNewDecFromStr(parts[1])
+	if err != nil {
+		return types.InflationStep{}, fmt.Errorf("invalid inflation step inflation max: %w", err)
+	}
+	inflationMin, err := sdkmath.LegacyNewDecFromStr(parts[2])
+	if err != nil {
+		return types.InflationStep{}, fmt.Errorf("invalid inflation step inflation min: %w", err)
+	}
+
+	return types.NewInflationStep(effectiveTime.UTC(), inflationMax, inflationMin), nil
+}
+
+// ParseMintDistribution parses a mint distribution flag of the update-params command,
+// given as <module-name>,<share>.
+func ParseMintDistribution(distribution string) (types.MintDistribution, error) {
+	parts := strings.Split(distribution, ",")
+	if len(parts) != 2 {
+		return types.MintDistribution{}, fmt.Errorf("invalid mint distribution %s, expected <module-name>,<share>", distribution)
+	}
+
+	share, err := sdkmath.LegacyNewDecFromStr(parts[1])
+	if err != nil {
+		return types.MintDistribution{}, fmt.Errorf("invalid mint distribution share: %w", err)
+	}
+
+	return types.NewMintDistribution(strings.TrimSpace(parts[0]), share), nil
+}
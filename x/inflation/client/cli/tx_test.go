@@ -2,8 +2,10 @@ package cli_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/client/cli"
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
@@ -83,3 +85,36 @@ func TestCmdUpdateParams_ParsesDecimalFormats(t *testing.T) {
 		})
 	}
 }
+
+// TestParseInflationStep verifies the parsing of the inflation steps of the step schedule decay mode.
+func TestParseInflationStep(t *testing.T) {
+	step, err := cli.ParseInflationStep("2027-01-01T00:00:00Z,0.05,0.025")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), step.EffectiveTime)
+	require.True(t, sdkmath.LegacyMustNewDecFromStr("0.05").Equal(step.InflationMax))
+	require.True(t, sdkmath.LegacyMustNewDecFromStr("0.025").Equal(step.InflationMin))
+
+	for _, invalid := range []string{"2027-01-01T00:00:00Z,0.05", "2027-01-01,0.05,0.025", "2027-01-01T00:00:00Z,abc,0.025"} {
+		_, err := cli.ParseInflationStep(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	mode, err := cli.ParseDecayMode("step-schedule")
+	require.NoError(t, err)
+	require.Equal(t, types.DecayMode_DECAY_MODE_STEP_SCHEDULE, mode)
+	_, err = cli.ParseDecayMode("halving")
+	require.Error(t, err)
+}
+
+// TestParseMintDistribution verifies the parsing of the mint distributions.
+func TestParseMintDistribution(t *testing.T) {
+	distribution, err := cli.ParseMintDistribution("rewards_pool,0.25")
+	require.NoError(t, err)
+	require.Equal(t, "rewards_pool", distribution.ModuleName)
+	require.True(t, sdkmath.LegacyMustNewDecFromStr("0.25").Equal(distribution.Share))
+
+	for _, invalid := range []string{"rewards_pool", "rewards_pool,abc", "rewards_pool,0.1,0.2"} {
+		_, err := cli.ParseMintDistribution(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Burn destroys coins of an account through the module account, and adds them to
+// the burned totals.
+func (k Keeper) Burn(ctx context.Context, from sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, amount); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	for _, coin := range amount {
+		burned, err := k.GetBurned(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if err := k.setBurned(ctx, coin.Denom, burned.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeyBurner, from.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// GetBurned returns the cumulative amount of a denom burned by the module.
+func (k Keeper) GetBurned(ctx context.Context, denom string) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.BurnedKey(denom))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// GetTotalBurned returns the cumulative amounts of all the denoms burned by the module.
+func (k Keeper) GetTotalBurned(ctx context.Context) (sdk.Coins, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(types.BurnedKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+		burned = burned.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned, nil
+}
+
+// setBurned sets the cumulative amount of a denom burned by the module.
+func (k Keeper) setBurned(ctx context.Context, denom string, amount math.Int) error {
+	bz, err := amount.Marshal()
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.BurnedKey(denom), bz)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	supplytypes "github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (s *KeeperSuite) TestBurn() {
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	supply, denom, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+
+	owner := sdk.AccAddress([]byte("burner_addr_12345678"))
+	funds := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1_000)))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, owner, funds))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	for _, amount := range []sdk.Coins{nil, {sdk.Coin{Denom: denom, Amount: math.NewInt(-1)}}} {
+		_, err = msgServer.Burn(s.ctx, &types.MsgBurn{FromAddress: owner.String(), Amount: amount})
+		s.Require().Error(err)
+	}
+	_, err = msgServer.Burn(s.ctx, &types.MsgBurn{FromAddress: owner.String(), Amount: funds.Add(funds...)})
+	s.Require().Error(err, "insufficient funds")
+
+	amount := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(400)))
+	for range 2 {
+		_, err = msgServer.Burn(s.ctx, &types.MsgBurn{FromAddress: owner.String(), Amount: amount})
+		s.Require().NoError(err)
+	}
+	s.Require().Equal(2, s.countEvents(types.EventTypeBurn))
+
+	// the burned coins are destroyed, and accounted for in the burned totals
+	newSupply, _, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(supply.Add(math.NewInt(200)), newSupply)
+	s.Require().Equal(math.NewInt(200), s.app.BankKeeper.GetBalance(s.ctx, owner, denom).Amount)
+
+	burned, err := s.keeper.GetBurned(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(800), burned)
+
+	res, err := s.app.SupplyKeeper.TotalSupply(s.ctx, &supplytypes.SupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, burned)), res.Burned)
+}
+
+func (s *KeeperSuite) TestSweepBurnedAddresses() {
+	_, denom, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+
+	burnedAddr1 := sdk.AccAddress([]byte("burned_addr_1_______"))
+	burnedAddr2 := sdk.AccAddress([]byte("burned_addr_2_______"))
+	emptyAddr := sdk.AccAddress([]byte("burned_addr_3_______"))
+	vestingAddr := sdk.AccAddress([]byte("burned_addr_4_______"))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, burnedAddr1, sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, burnedAddr2, sdk.NewCoins(sdk.NewInt64Coin(denom, 500), sdk.NewInt64Coin("other", 7))))
+
+	// the locked coins of a vesting account are not swept
+	locked := sdk.NewCoins(sdk.NewInt64Coin(denom, 300))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, burnedAddr1, locked))
+	_, err = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper).CreatePermanentLockedAccount(s.ctx, vestingtypes.NewMsgCreatePermanentLockedAccount(burnedAddr1, vestingAddr, locked))
+	s.Require().NoError(err)
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, vestingAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+
+	params := types.DefaultParams()
+	params.BurnedAddresses = []string{burnedAddr1.String(), burnedAddr2.String(), emptyAddr.String(), vestingAddr.String()}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	_, err = msgServer.SweepBurnedAddresses(s.ctx, &types.MsgSweepBurnedAddresses{Authority: burnedAddr1.String()})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	res, err := msgServer.SweepBurnedAddresses(s.ctx, &types.MsgSweepBurnedAddresses{Authority: s.keeper.GetAuthority()})
+	s.Require().NoError(err)
+	expected := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_700))
+	s.Require().Equal(expected, res.Burned)
+
+	// only the spendable balances of the bond denom are burned
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, burnedAddr1).IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("other", 7)), s.app.BankKeeper.GetAllBalances(s.ctx, burnedAddr2))
+	s.Require().Equal(locked, s.app.BankKeeper.GetAllBalances(s.ctx, vestingAddr))
+	totalBurned, err := s.keeper.GetTotalBurned(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, totalBurned)
+
+	stored, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(stored.BurnedAddresses)
+
+	liquid, err := s.app.SupplyKeeper.LiquidSupply(s.ctx, &supplytypes.SupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(expected, liquid.Burned)
+}
@@ -11,6 +11,9 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
 	if err := genState.Validate(); err != nil {
 		panic(err)
 	}
+	if err := k.ValidateMintDistributions(genState.Params); err != nil {
+		panic(err)
+	}
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&genState.Params)
 	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
@@ -21,6 +24,11 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, coin := range genState.Burned {
+		if err := k.setBurned(ctx, coin.Denom, coin.Amount); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -38,6 +46,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	if ok {
 		genesis.DecayEpochStart = epoch
 	}
+	genesis.Burned, err = k.GetTotalBurned(ctx)
+	if err != nil {
+		panic("fail to get burned totals:" + err.Error())
+	}
 
 	return genesis
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 
 	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperSuite) TestInitExportGenesis_RoundTrip() {
@@ -13,9 +15,11 @@ func (s *KeeperSuite) TestInitExportGenesis_RoundTrip() {
 		[]string{"cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5"},
 		sdkmath.LegacyNewDecWithPrec(68, 3), // 0.068
 	)
+	burned := sdk.NewCoins(sdk.NewInt64Coin("basecro", 1_000), sdk.NewInt64Coin("stake", 5))
 	s.keeper.InitGenesis(s.ctx, types.GenesisState{
 		Params:          customParams,
 		DecayEpochStart: 1,
+		Burned:          burned,
 	})
 
 	// Export and verify round-trip
@@ -25,6 +29,7 @@ func (s *KeeperSuite) TestInitExportGenesis_RoundTrip() {
 	s.Require().Equal(customParams.BurnedAddresses, exported.Params.BurnedAddresses)
 	s.Require().True(customParams.DecayRate.Equal(exported.Params.DecayRate))
 	s.Require().Equal(uint64(1), exported.DecayEpochStart)
+	s.Require().Equal(burned, exported.Burned)
 }
 
 func (s *KeeperSuite) TestInitExportGenesis_DefaultParams() {
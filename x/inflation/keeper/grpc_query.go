@@ -2,14 +2,20 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxProjectionMonths is the maximum number of months of an inflation projection
+const MaxProjectionMonths = 600
+
 var _ types.QueryServer = Keeper{}
 
 // Params returns the inflation parameters
@@ -28,3 +34,112 @@ func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*typ
 		Params: params,
 	}, nil
 }
+
+// CurrentInflation returns the inflation rate calculated for the current block
+func (k Keeper) CurrentInflation(ctx context.Context, req *types.QueryCurrentInflationRequest) (*types.QueryCurrentInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.GetParams(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "failed to get params: "+err.Error())
+	}
+	minter, mintParams, bondedRatio, err := k.getMintState(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	inflation, baseInflation, decayFactor, monthsElapsed := k.calculateInflation(sdkCtx, params, minter, mintParams, bondedRatio)
+	return &types.QueryCurrentInflationResponse{
+		Inflation:     inflation,
+		BaseInflation: baseInflation,
+		DecayFactor:   decayFactor,
+		MonthsElapsed: monthsElapsed,
+		DecayMode:     params.DecayMode,
+	}, nil
+}
+
+// DecayEpochStart returns the block height from which inflation decays
+func (k Keeper) DecayEpochStart(ctx context.Context, req *types.QueryDecayEpochStartRequest) (*types.QueryDecayEpochStartResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height, ok, err := k.getDecayEpochStart(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "decay epoch start not set")
+	}
+
+	return &types.QueryDecayEpochStartResponse{Height: height}, nil
+}
+
+// InflationProjection returns the projected inflation and supply at the end of each
+// of the next months. The base rate is changed from the inflation of the previous month
+// over the blocks of the month under the current bonded ratio, clamped at the inflation
+// bounds, and the decay is then applied as by DeflationCalculationFn at the simulated
+// height and block time of the end of the month. A month of block provisions is then
+// minted, clamped at the max supply.
+func (k Keeper) InflationProjection(ctx context.Context, req *types.QueryInflationProjectionRequest) (*types.QueryInflationProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Months == 0 || req.Months > MaxProjectionMonths {
+		return nil, status.Errorf(codes.InvalidArgument, "months must be between 1 and %d", MaxProjectionMonths)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params, err := k.GetParams(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "failed to get params: "+err.Error())
+	}
+	minter, mintParams, bondedRatio, err := k.getMintState(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalSupply, _, err := k.GetSupplyAndDenom(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	supply, _, err := k.GetSupplyNetOfBurned(sdkCtx, params)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blocksPerMonth := int64(mintParams.BlocksPerYear / MonthsInYear)
+	projections := make([]types.InflationProjection, 0, req.Months)
+	for month := int64(1); month <= int64(req.Months); month++ {
+		height := sdkCtx.BlockHeight() + month*blocksPerMonth
+		blockTime := sdkCtx.BlockTime().Add(time.Duration(month*SecondsPerMonth) * time.Second)
+		monthCtx := sdkCtx.WithBlockHeight(height).WithBlockTime(blockTime)
+
+		minter.Inflation, _, _, _ = k.calculateInflationOver(monthCtx, params, minter, mintParams, bondedRatio, blocksPerMonth)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(mintParams, totalSupply)
+
+		minted := minter.BlockProvision(mintParams).Amount.MulRaw(blocksPerMonth)
+		if params.MaxSupply.IsPositive() {
+			minted = math.MinInt(minted, math.MaxInt(params.MaxSupply.Sub(supply), math.ZeroInt()))
+		}
+		totalSupply = totalSupply.Add(minted)
+		supply = supply.Add(minted)
+
+		projections = append(projections, types.InflationProjection{
+			Month:             uint32(month),
+			Height:            height,
+			Time:              blockTime,
+			Inflation:         minter.Inflation,
+			AnnualProvisions:  minter.AnnualProvisions,
+			TotalSupply:       totalSupply,
+			SupplyNetOfBurned: supply,
+		})
+	}
+
+	return &types.QueryInflationProjectionResponse{
+		BondedRatio: bondedRatio,
+		Projections: projections,
+	}, nil
+}
@@ -1,9 +1,14 @@
 package keeper_test
 
 import (
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 func (suite *KeeperSuite) TestMaxSupply() {
@@ -57,3 +62,104 @@ func (suite *KeeperSuite) TestBurnedAddressesEmpty() {
 	suite.Equal(len(burnedAddresses), 0)
 	suite.Empty(burnedAddresses)
 }
+
+func (suite *KeeperSuite) TestCurrentInflation() {
+	params := types.DefaultParams()
+	params.DecayRate = sdkmath.LegacyNewDecWithPrec(65, 3)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// no decay before the decay epoch is set
+	res, err := suite.keeper.CurrentInflation(suite.ctx, &types.QueryCurrentInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyOneDec(), res.DecayFactor)
+	suite.Require().True(res.MonthsElapsed.IsZero())
+	suite.Require().Equal(res.BaseInflation, res.Inflation)
+	suite.Require().Equal(types.DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS, res.DecayMode)
+
+	_, err = suite.keeper.DecayEpochStart(suite.ctx, &types.QueryDecayEpochStartRequest{})
+	suite.Require().Equal(codes.NotFound, status.Code(err))
+
+	// a month of blocks after the decay epoch
+	mintParams, err := suite.app.MintKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	blocksPerMonth := int64(mintParams.BlocksPerYear / keeper.MonthsInYear)
+	suite.Require().NoError(suite.keeper.SetDecayEpochStart(suite.ctx, 1000))
+	suite.ctx = suite.ctx.WithBlockHeight(1000 + blocksPerMonth)
+
+	epoch, err := suite.keeper.DecayEpochStart(suite.ctx, &types.QueryDecayEpochStartRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1000), epoch.Height)
+
+	res, err = suite.keeper.CurrentInflation(suite.ctx, &types.QueryCurrentInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyOneDec(), res.MonthsElapsed)
+	suite.Require().Equal(sdkmath.LegacyMustNewDecFromStr("0.935"), res.DecayFactor)
+	suite.Require().Equal(res.BaseInflation.Mul(res.DecayFactor), res.Inflation)
+}
+
+func (suite *KeeperSuite) TestInflationProjection() {
+	supply, _, err := suite.keeper.GetSupplyAndDenom(suite.ctx)
+	suite.Require().NoError(err)
+	params := types.DefaultParams()
+	params.DecayRate = sdkmath.LegacyNewDecWithPrec(65, 3)
+	params.MaxSupply = supply.Add(sdkmath.NewInt(1_000_000_000))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.keeper.SetDecayEpochStart(suite.ctx, 1))
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	for _, months := range []uint32{0, keeper.MaxProjectionMonths + 1} {
+		_, err := suite.keeper.InflationProjection(suite.ctx, &types.QueryInflationProjectionRequest{Months: months})
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+
+	res, err := suite.keeper.InflationProjection(suite.ctx, &types.QueryInflationProjectionRequest{Months: 24})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Projections, 24)
+
+	mintParams, err := suite.app.MintKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	blocksPerMonth := int64(mintParams.BlocksPerYear / keeper.MonthsInYear)
+	for i, projection := range res.Projections {
+		suite.Require().Equal(uint32(i+1), projection.Month)
+		suite.Require().Equal(1+int64(i+1)*blocksPerMonth, projection.Height)
+		// the supply never exceeds the max supply
+		suite.Require().True(projection.SupplyNetOfBurned.LTE(params.MaxSupply))
+		if i > 0 {
+			suite.Require().True(projection.TotalSupply.GTE(res.Projections[i-1].TotalSupply))
+		}
+	}
+	suite.Require().Equal(params.MaxSupply, res.Projections[23].SupplyNetOfBurned)
+
+	// the projection doesn't change the state
+	newSupply, _, err := suite.keeper.GetSupplyAndDenom(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(supply, newSupply)
+}
+
+func (suite *KeeperSuite) TestInflationProjection_RateChangeOverMonth() {
+	params := types.DefaultParams()
+	params.DecayRate = sdkmath.LegacyZeroDec()
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	minter, err := suite.app.MintKeeper.Minter.Get(suite.ctx)
+	suite.Require().NoError(err)
+	minter.Inflation = sdkmath.LegacyNewDecWithPrec(10, 2)
+	suite.Require().NoError(suite.app.MintKeeper.Minter.Set(suite.ctx, minter))
+	mintParams, err := suite.app.MintKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	res, err := suite.keeper.InflationProjection(suite.ctx, &types.QueryInflationProjectionRequest{Months: 1})
+	suite.Require().NoError(err)
+
+	// the rate changes once per block of the month, as the mint module does
+	blocksPerMonth := int64(mintParams.BlocksPerYear / keeper.MonthsInYear)
+	expected := minter
+	for range blocksPerMonth {
+		expected.Inflation = minttypes.DefaultInflationCalculationFn(suite.ctx, expected, mintParams, res.BondedRatio)
+	}
+	suite.Require().NotEqual(minter.Inflation, expected.Inflation)
+	suite.Require().True(
+		res.Projections[0].Inflation.Sub(expected.Inflation).Abs().LTE(sdkmath.LegacyNewDecWithPrec(1, 12)),
+		"projected %s, expected %s", res.Projections[0].Inflation, expected.Inflation,
+	)
+}
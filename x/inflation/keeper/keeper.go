@@ -14,6 +14,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 type Keeper struct {
@@ -22,6 +24,10 @@ type Keeper struct {
 	logger        log.Logger
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	accountKeeper types.AccountKeeper
+	distrKeeper   types.DistributionKeeper
+	// set after the mint keeper is created, which depends on this keeper's MintFn
+	mintKeeper *mintkeeper.Keeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/inflation module account.
@@ -30,10 +36,12 @@ type Keeper struct {
 	cache *decayCache
 }
 
+// decayCache holds the decay factor per block or second, for a month of
+// PeriodsPerMonth blocks or seconds
 type decayCache struct {
-	DecayRate     math.LegacyDec
-	BlocksPerYear uint64
-	BlockFactor   math.LegacyDec
+	DecayRate       math.LegacyDec
+	PeriodsPerMonth uint64
+	PeriodFactor    math.LegacyDec
 }
 
 // NewKeeper creates a new inflation Keeper instance
@@ -43,6 +51,8 @@ func NewKeeper(
 	logger log.Logger,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
+	accountKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
@@ -51,6 +61,8 @@ func NewKeeper(
 		logger:        logger,
 		bankKeeper:    bankKeeper,
 		stakingKeeper: stakingKeeper,
+		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
 		authority:     authority,
 	}
 }
@@ -86,6 +98,17 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return store.Set([]byte(types.ParamsKey), bz)
 }
 
+// ValidateMintDistributions checks that the recipients of the mint distributions of
+// the params are registered module accounts.
+func (k Keeper) ValidateMintDistributions(params types.Params) error {
+	for _, distribution := range params.MintDistributions {
+		if k.accountKeeper.GetModuleAddress(distribution.ModuleName) == nil {
+			return fmt.Errorf("mint distribution module account %s does not exist", distribution.ModuleName)
+		}
+	}
+	return nil
+}
+
 // SetDecayEpochStart persists the block height at which inflation decay begins (e.g. upgrade activation height).
 func (k Keeper) SetDecayEpochStart(ctx context.Context, height uint64) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -128,6 +151,52 @@ func (k Keeper) GetSupplyAndDenom(ctx context.Context) (math.Int, string, error)
 	return k.bankKeeper.GetSupply(ctx, bondDenom).Amount, bondDenom, nil
 }
 
+// GetSupplyNetOfBurned returns the total supply of the bond denomination minus the
+// balances of the burned addresses, which is the supply capped by MaxSupply.
+func (k Keeper) GetSupplyNetOfBurned(ctx context.Context, params types.Params) (math.Int, string, error) {
+	supply, denom, err := k.GetSupplyAndDenom(ctx)
+	if err != nil {
+		return math.ZeroInt(), "", err
+	}
+
+	for _, ba := range params.BurnedAddresses {
+		balance, err := k.GetAddressBalance(ctx, ba, denom)
+		if err != nil {
+			return math.ZeroInt(), "", fmt.Errorf("could not get %s balance: %w", ba, err)
+		}
+		supply = supply.Sub(balance)
+	}
+	return supply, denom, nil
+}
+
+// SetMintKeeper sets the mint keeper, whose minter and params are queried for the
+// current inflation and its projection.
+func (k *Keeper) SetMintKeeper(mintKeeper *mintkeeper.Keeper) {
+	k.mintKeeper = mintKeeper
+}
+
+// getMintState returns the minter and params of the mint module, and the current
+// bonded ratio.
+func (k Keeper) getMintState(ctx context.Context) (minttypes.Minter, minttypes.Params, math.LegacyDec, error) {
+	if k.mintKeeper == nil {
+		return minttypes.Minter{}, minttypes.Params{}, math.LegacyDec{}, errors.New("mint keeper not set")
+	}
+
+	minter, err := k.mintKeeper.Minter.Get(ctx)
+	if err != nil {
+		return minttypes.Minter{}, minttypes.Params{}, math.LegacyDec{}, err
+	}
+	params, err := k.mintKeeper.Params.Get(ctx)
+	if err != nil {
+		return minttypes.Minter{}, minttypes.Params{}, math.LegacyDec{}, err
+	}
+	bondedRatio, err := k.mintKeeper.BondedRatio(ctx)
+	if err != nil {
+		return minttypes.Minter{}, minttypes.Params{}, math.LegacyDec{}, err
+	}
+	return minter, params, bondedRatio, nil
+}
+
 // GetAuthority returns the inflation module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
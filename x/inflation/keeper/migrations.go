@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the continuous block decay mode, which keeps the decay behaviour
+// predating decay modes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.DecayMode = types.DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS
+	if params.InflationSchedule == nil {
+		params.InflationSchedule = []types.InflationStep{}
+	}
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+
+	"cosmossdk.io/math"
+)
+
+// TestMigrate1to2 tests that params stored before decay modes keep the continuous block decay.
+func (s *KeeperSuite) TestMigrate1to2() {
+	params := types.Params{
+		MaxSupply:       math.NewInt(1_000_000),
+		BurnedAddresses: []string{},
+		DecayRate:       math.LegacyNewDecWithPrec(68, 3),
+	}
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set([]byte(types.ParamsKey), s.app.AppCodec().MustMarshal(&params))
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+	migrated, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS, migrated.DecayMode)
+	s.Require().Empty(migrated.InflationSchedule)
+	s.Require().Equal(params.MaxSupply, migrated.MaxSupply)
+	s.Require().Equal(params.DecayRate, migrated.DecayRate)
+	s.Require().NoError(migrated.Validate())
+}
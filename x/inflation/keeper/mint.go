@@ -3,92 +3,367 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-const MonthsInYear = 12
+const (
+	MonthsInYear = 12
+
+	// SecondsPerMonth is the number of seconds in a month of a 365 days year
+	SecondsPerMonth = 365 * 24 * 60 * 60 / MonthsInYear
+)
 
-// DeflationCalculationFn returns a custom InflationCalculationFn which applies continuous exponential decay to inflation.
+// DeflationCalculationFn returns a custom InflationCalculationFn which applies the decay mode of the params to
+// inflation. The base_rate is the inflation rate calculated using the default method.
+//
+// In the continuous block mode (the default), it applies continuous exponential decay to inflation.
 // Formula: inflation_rate = base_rate × (1 - monthly_decay)^months_elapsed
 // where months_elapsed = blocks_elapsed / blocks_per_month (continuous decimal value).
-// The base_rate is the inflation rate calculated using the default method.
 // Decay uses DecayRate from params; elapsed blocks are measured from the decay epoch in store.
+//
+// In the continuous time mode, months_elapsed = seconds_elapsed / seconds_per_month instead, with the
+// elapsed block time measured from DecayStartTime, so that block-time drift doesn't change the decay.
+//
+// In the step schedule mode, the base rate is calculated with the inflation bounds of the latest
+// effective step of the InflationSchedule, and DecayRate is not applied.
 func (k *Keeper) DeflationCalculationFn() func(ctx context.Context, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec) math.LegacyDec {
 	return func(ctx context.Context, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec) math.LegacyDec {
 		inflationParams, err := k.GetParams(ctx)
 		if err != nil {
 			panic(fmt.Sprintf("failed to get inflation params: %s", err))
 		}
-		decayRate := inflationParams.DecayRate
 
-		// Calculate base inflation rate using default method
-		baseRate := minttypes.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+		inflation, _, _, _ := k.calculateInflation(sdk.UnwrapSDKContext(ctx), inflationParams, minter, params, bondedRatio)
+		return inflation
+	}
+}
+
+// calculateInflation returns the inflation rate of DeflationCalculationFn at the block of the context, with
+// the base inflation rate, decay factor and months elapsed it is calculated from.
+func (k *Keeper) calculateInflation(
+	ctx sdk.Context, inflationParams types.Params, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec,
+) (inflation, baseRate, decayFactor, monthsElapsed math.LegacyDec) {
+	return k.calculateInflationOver(ctx, inflationParams, minter, params, bondedRatio, 1)
+}
+
+// calculateInflationOver returns the inflation rate of DeflationCalculationFn at the block of the context as
+// calculateInflation does, with the base rate changed over the given number of blocks ending at that block
+// under a constant bonded ratio, before the decay factor of the block is applied.
+func (k *Keeper) calculateInflationOver(
+	ctx sdk.Context, inflationParams types.Params, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec, blocks int64,
+) (inflation, baseRate, decayFactor, monthsElapsed math.LegacyDec) {
+	if inflationParams.DecayMode == types.DecayMode_DECAY_MODE_STEP_SCHEDULE {
+		params = stepParams(ctx, inflationParams.InflationSchedule, params)
+		inflation = baseInflation(ctx, minter, params, bondedRatio, blocks)
+		return inflation, inflation, math.LegacyOneDec(), math.LegacyZeroDec()
+	}
 
-		sdkCtx := sdk.UnwrapSDKContext(ctx)
-		currentHeight := uint64(sdkCtx.BlockHeight())
+	// Calculate base inflation rate using default method
+	baseRate = baseInflation(ctx, minter, params, bondedRatio, blocks)
+	decayFactor, monthsElapsed = k.decay(ctx, inflationParams, params)
+	return baseRate.Mul(decayFactor), baseRate, decayFactor, monthsElapsed
+}
+
+// baseInflation returns the inflation rate calculated by the default method after the given number of
+// blocks. The rate change per block is constant under a constant bonded ratio, so once the first block has
+// brought the rate within the bounds, the remaining blocks change it by their total change, clamped again.
+func baseInflation(ctx sdk.Context, minter minttypes.Minter, params minttypes.Params, bondedRatio math.LegacyDec, blocks int64) math.LegacyDec {
+	minter.Inflation = minttypes.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+	if blocks <= 1 {
+		return minter.Inflation
+	}
 
-		if !decayRate.IsPositive() {
-			return baseRate
+	params.InflationRateChange = params.InflationRateChange.MulInt64(blocks - 1)
+	return minttypes.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+}
+
+// decay returns the decay factor applied to the base inflation rate at the block of the context, and the
+// months elapsed since the decay started, which are zero in the step schedule decay mode.
+func (k *Keeper) decay(ctx sdk.Context, inflationParams types.Params, params minttypes.Params) (decayFactor, monthsElapsed math.LegacyDec) {
+	var periodsElapsed, periodsPerMonth uint64
+	switch inflationParams.DecayMode {
+	case types.DecayMode_DECAY_MODE_STEP_SCHEDULE:
+		return math.LegacyOneDec(), math.LegacyZeroDec()
+
+	case types.DecayMode_DECAY_MODE_CONTINUOUS_TIME:
+		// elapsed block time is measured from the decay start time
+		blockTime := ctx.BlockTime()
+		if blockTime.Before(inflationParams.DecayStartTime) {
+			return math.LegacyOneDec(), math.LegacyZeroDec()
 		}
+		periodsElapsed = uint64(blockTime.Sub(inflationParams.DecayStartTime) / time.Second)
+		periodsPerMonth = SecondsPerMonth
 
+	default:
+		// elapsed blocks are measured from the decay epoch in store
 		decayEpoch, ok, err := k.getDecayEpochStart(ctx)
 		if err != nil {
 			panic(fmt.Sprintf("failed to get decay epoch start: %s", err))
 		}
-		if !ok {
-			return baseRate
+		currentHeight := uint64(ctx.BlockHeight())
+		if !ok || currentHeight < decayEpoch {
+			return math.LegacyOneDec(), math.LegacyZeroDec()
 		}
-		if currentHeight < decayEpoch {
-			return baseRate
+		periodsElapsed = currentHeight - decayEpoch
+		periodsPerMonth = params.BlocksPerYear / MonthsInYear
+		if periodsPerMonth == 0 {
+			return math.LegacyOneDec(), math.LegacyZeroDec()
 		}
+	}
 
-		finalInflation := baseRate
-		blocksPerYear := params.BlocksPerYear
-		blocksPerMonth := blocksPerYear / MonthsInYear
-		blocksElapsed := currentHeight - decayEpoch
-
-		if blocksPerMonth > 0 {
-			// Compute months elapsed as a decimal for continuous decay
-			monthsElapsed := math.LegacyNewDec(int64(blocksElapsed)).Quo(math.LegacyNewDec(int64(blocksPerMonth)))
-
-			// Power() only accepts uint64, so decompose the exponent:
-			// x^months = x^n × (x^(1/m))^r, where months = n + r/m
-			// Note: we compute (x^(1/m))^r, NOT (x^r)^(1/m), because x^r underflows
-			// to 0 for large r when x < 1, whereas x^(1/m) stays close to 1.
-			n := uint64(monthsElapsed.TruncateInt64())
-			r := blocksElapsed % blocksPerMonth
+	monthsElapsed = math.LegacyNewDec(int64(periodsElapsed)).QuoInt64(int64(periodsPerMonth))
+	if !inflationParams.DecayRate.IsPositive() {
+		return math.LegacyOneDec(), monthsElapsed
+	}
+	return k.decayFactor(inflationParams.DecayRate, periodsElapsed, periodsPerMonth), monthsElapsed
+}
 
-			decayFactor := math.LegacyOneDec().Sub(decayRate)
-			intPart := decayFactor.Power(n)
-			perBlockFactor := k.getPerBlockFactor(decayRate, blocksPerYear, blocksPerMonth) // x^(1/m)
-			fracPart := perBlockFactor.Power(r)                                             // (x^(1/m))^r = x^(r/m)
-			finalInflation = baseRate.Mul(intPart.Mul(fracPart))
+// stepParams returns the mint params with the inflation bounds of the latest step of the schedule effective
+// at the block time, or the mint params unchanged before the first step.
+func stepParams(ctx sdk.Context, schedule []types.InflationStep, params minttypes.Params) minttypes.Params {
+	blockTime := ctx.BlockTime()
+	for i := len(schedule) - 1; i >= 0; i-- {
+		if !schedule[i].EffectiveTime.After(blockTime) {
+			params.InflationMax = schedule[i].InflationMax
+			params.InflationMin = schedule[i].InflationMin
+			break
 		}
-
-		return finalInflation
 	}
+	return params
 }
 
-func (k *Keeper) getPerBlockFactor(decayRate math.LegacyDec, blocksPerYear, blocksPerMonth uint64) math.LegacyDec {
+// decayFactor returns (1 - decay_rate)^(periods_elapsed / periods_per_month), a month being made of
+// periodsPerMonth blocks or seconds.
+func (k *Keeper) decayFactor(decayRate math.LegacyDec, periodsElapsed, periodsPerMonth uint64) math.LegacyDec {
+	// Power() only accepts uint64, so decompose the exponent:
+	// x^months = x^n × (x^(1/m))^r, where months = n + r/m
+	// Note: we compute (x^(1/m))^r, NOT (x^r)^(1/m), because x^r underflows
+	// to 0 for large r when x < 1, whereas x^(1/m) stays close to 1.
+	n := periodsElapsed / periodsPerMonth
+	r := periodsElapsed % periodsPerMonth
+
+	decayFactor := math.LegacyOneDec().Sub(decayRate)
+	intPart := decayFactor.Power(n)
+	perPeriodFactor := k.getPerPeriodFactor(decayRate, periodsPerMonth) // x^(1/m)
+	fracPart := perPeriodFactor.Power(r)                                // (x^(1/m))^r = x^(r/m)
+	return intPart.Mul(fracPart)
+}
+
+func (k *Keeper) getPerPeriodFactor(decayRate math.LegacyDec, periodsPerMonth uint64) math.LegacyDec {
 	cache := k.GetDecayCache()
-	validCache := cache != nil && cache.DecayRate.Equal(decayRate) && cache.BlocksPerYear == blocksPerYear
+	validCache := cache != nil && cache.DecayRate.Equal(decayRate) && cache.PeriodsPerMonth == periodsPerMonth
 	if validCache {
-		return cache.BlockFactor
+		return cache.PeriodFactor
 	}
 	decayFactor := math.LegacyOneDec().Sub(decayRate)
-	perBlockFactor, err := decayFactor.ApproxRoot(blocksPerMonth)
+	perPeriodFactor, err := decayFactor.ApproxRoot(periodsPerMonth)
 	if err != nil {
-		panic(fmt.Sprintf("failed to approximate root: decayRate=%s, blocksPerYear=%d, blocksPerMonth=%d, error=%s", decayRate, blocksPerYear, blocksPerMonth, err))
+		panic(fmt.Sprintf("failed to approximate root: decayRate=%s, periodsPerMonth=%d, error=%s", decayRate, periodsPerMonth, err))
 	}
 	updatedCache := &decayCache{
-		DecayRate:     decayRate,
-		BlocksPerYear: blocksPerYear,
-		BlockFactor:   perBlockFactor,
+		DecayRate:       decayRate,
+		PeriodsPerMonth: periodsPerMonth,
+		PeriodFactor:    perPeriodFactor,
 	}
 	k.SetDecayCache(updatedCache)
-	return perBlockFactor
+	return perPeriodFactor
+}
+
+// MintFn returns the mint function of the mint module. It mints the block provision
+// with the inflation of DeflationCalculationFn as the default mint function does, but
+// clamps it so that the supply net of burned addresses never exceeds MaxSupply, and
+// stops minting once the cap is reached.
+func (k *Keeper) MintFn() mintkeeper.MintFn {
+	inflationFn := k.DeflationCalculationFn()
+	return func(ctx sdk.Context, mk *mintkeeper.Keeper) error {
+		minter, err := mk.Minter.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		params, err := mk.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalStakingSupply, err := mk.StakingTokenSupply(ctx)
+		if err != nil {
+			return err
+		}
+
+		bondedRatio, err := mk.BondedRatio(ctx)
+		if err != nil {
+			return err
+		}
+
+		minter.Inflation = inflationFn(ctx, minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+		if err = mk.Minter.Set(ctx, minter); err != nil {
+			return err
+		}
+
+		mintedCoin, err := k.clampMint(ctx, minter.BlockProvision(params))
+		if err != nil {
+			return err
+		}
+
+		diverted := sdk.NewCoins()
+		if mintedCoin.IsPositive() {
+			mintedCoins := sdk.NewCoins(mintedCoin)
+			if err := mk.MintCoins(ctx, mintedCoins); err != nil {
+				return err
+			}
+
+			diverted, err = k.divertMinted(ctx, mintedCoin)
+			if err != nil {
+				return err
+			}
+
+			// send the remaining minted coins to the fee collector account
+			if collected := mintedCoins.Sub(diverted...); !collected.IsZero() {
+				if err := mk.AddCollectedFees(ctx, collected); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := k.setBlockDiverted(ctx, sdk.NewCoin(mintedCoin.Denom, diverted.AmountOf(mintedCoin.Denom))); err != nil {
+			return err
+		}
+
+		if mintedCoin.Amount.IsInt64() {
+			defer telemetry.ModuleSetGauge(minttypes.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				minttypes.EventTypeMint,
+				sdk.NewAttribute(minttypes.AttributeKeyBondedRatio, bondedRatio.String()),
+				sdk.NewAttribute(minttypes.AttributeKeyInflation, minter.Inflation.String()),
+				sdk.NewAttribute(minttypes.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+			),
+		)
+
+		return nil
+	}
+}
+
+// divertMinted sends the shares of the minted coin of the mint distributions to their
+// module accounts, and returns the coins diverted from the fee collector. The share of
+// the distribution module funds the community pool.
+func (k *Keeper) divertMinted(ctx sdk.Context, minted sdk.Coin) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	diverted := sdk.NewCoins()
+	for _, distribution := range params.MintDistributions {
+		amount := math.LegacyNewDecFromInt(minted.Amount).MulTruncate(distribution.Share).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(minted.Denom, amount))
+		if distribution.ModuleName == distrtypes.ModuleName {
+			err = k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(minttypes.ModuleName))
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distribution.ModuleName, coins)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDiverted,
+				sdk.NewAttribute(types.AttributeKeyModule, distribution.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+		diverted = diverted.Add(coins...)
+	}
+	return diverted, nil
+}
+
+// setBlockDiverted records the coin diverted by the mint distributions in the block.
+func (k Keeper) setBlockDiverted(ctx context.Context, diverted sdk.Coin) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if diverted.IsZero() {
+		return store.Delete([]byte(types.BlockDivertedKey))
+	}
+	return store.Set([]byte(types.BlockDivertedKey), k.cdc.MustMarshal(&diverted))
+}
+
+// GetBlockDiverted returns the amount of a denom diverted from the fee collector by the
+// mint distributions in the last minted block, which is the current block once the mint
+// begin blocker has run.
+func (k Keeper) GetBlockDiverted(ctx context.Context, denom string) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.BlockDivertedKey))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	var diverted sdk.Coin
+	if err := k.cdc.Unmarshal(bz, &diverted); err != nil {
+		return math.ZeroInt(), err
+	}
+	if diverted.Denom != denom {
+		return math.ZeroInt(), nil
+	}
+	return diverted.Amount, nil
+}
+
+// clampMint returns the coin to mint in a block, clamped to the room left under
+// MaxSupply. An event is emitted when the provision is clamped.
+func (k *Keeper) clampMint(ctx sdk.Context, provision sdk.Coin) (sdk.Coin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !params.MaxSupply.IsPositive() {
+		return provision, nil
+	}
+
+	supply, denom, err := k.GetSupplyNetOfBurned(ctx, params)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if provision.Denom != denom {
+		return provision, nil
+	}
+
+	room := math.MaxInt(params.MaxSupply.Sub(supply), math.ZeroInt())
+	if provision.Amount.LTE(room) {
+		return provision, nil
+	}
+
+	minted := sdk.NewCoin(provision.Denom, room)
+	k.Logger(ctx).Info("mint clamped at the maximum supply", "requested", provision, "minted", minted)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintClamped,
+			sdk.NewAttribute(types.AttributeKeyRequested, provision.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeySupply, supply.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, params.MaxSupply.String()),
+		),
+	)
+	return minted, nil
 }
@@ -1,10 +1,19 @@
 package keeper_test
 
 import (
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation"
+	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -384,8 +393,8 @@ func (s *KeeperSuite) TestDeflationCalculationFn_Cache() {
 	cache1 := s.keeper.GetDecayCache()
 	s.Require().NotNil(cache1, "cache1 should be populated after deflation call")
 	s.Require().Equal(params.DecayRate, cache1.DecayRate, "decay rate should match")
-	s.Require().Equal(mintParams.BlocksPerYear, cache1.BlocksPerYear, "blocks per year should match")
-	s.Require().Equal(perBlockFactor, cache1.BlockFactor, "block factor should match")
+	s.Require().Equal(blocksPerMonth, cache1.PeriodsPerMonth, "blocks per month should match")
+	s.Require().Equal(perBlockFactor, cache1.PeriodFactor, "block factor should match")
 
 	// Verify result1 is mathematically correct
 	blocksElapsed1 := uint64(s.ctx.BlockHeight()) - decayEpoch
@@ -412,8 +421,8 @@ func (s *KeeperSuite) TestDeflationCalculationFn_Cache() {
 	s.Require().True(result2.LT(result1), "result2 decay will be larger than result1")
 	s.Require().NotNil(cache2, "cache2 should be populated after deflation call")
 	s.Require().Equal(cache1.DecayRate, cache2.DecayRate, "caches decay rate should match")
-	s.Require().Equal(cache1.BlocksPerYear, cache2.BlocksPerYear, "caches blocks per year should match")
-	s.Require().Equal(cache1.BlockFactor, cache2.BlockFactor, "caches block factor should match")
+	s.Require().Equal(cache1.PeriodsPerMonth, cache2.PeriodsPerMonth, "caches blocks per month should match")
+	s.Require().Equal(cache1.PeriodFactor, cache2.PeriodFactor, "caches block factor should match")
 
 	// Verify result2 is mathematically correct
 	blocksElapsed2 := uint64(s.ctx.BlockHeight()) - decayEpoch
@@ -454,8 +463,8 @@ func (s *KeeperSuite) TestDeflationCalculationFn_Cache() {
 	cache3 := s.keeper.GetDecayCache()
 	s.Require().NotNil(cache3, "cache3 should be populated after deflation call")
 	s.Require().Equal(params.DecayRate, cache3.DecayRate, "decay rate should match")
-	s.Require().Equal(mintParams.BlocksPerYear, cache3.BlocksPerYear, "blocks per year should match")
-	s.Require().Equal(perBlockFactor3, cache3.BlockFactor, "block factor should match")
+	s.Require().Equal(blocksPerMonth, cache3.PeriodsPerMonth, "blocks per month should match")
+	s.Require().Equal(perBlockFactor3, cache3.PeriodFactor, "block factor should match")
 
 	// Verify result3 is mathematically correct
 	blocksElapsed3 := uint64(s.ctx.BlockHeight()) - decayEpoch
@@ -482,8 +491,8 @@ func (s *KeeperSuite) TestDeflationCalculationFn_Cache() {
 	s.Require().True(result4.LT(result3), "result4 decay will be larger than result3")
 	s.Require().NotNil(cache4, "cache2 should be populated after deflation call")
 	s.Require().Equal(cache3.DecayRate, cache4.DecayRate, "caches decay rate should match")
-	s.Require().Equal(cache3.BlocksPerYear, cache4.BlocksPerYear, "caches blocks per year should match")
-	s.Require().Equal(cache3.BlockFactor, cache4.BlockFactor, "caches block factor should match")
+	s.Require().Equal(cache3.PeriodsPerMonth, cache4.PeriodsPerMonth, "caches blocks per month should match")
+	s.Require().Equal(cache3.PeriodFactor, cache4.PeriodFactor, "caches block factor should match")
 
 	// Verify result4 is mathematically correct
 	blocksElapsed4 := uint64(s.ctx.BlockHeight()) - decayEpoch
@@ -502,3 +511,194 @@ func (s *KeeperSuite) TestDeflationCalculationFn_Cache() {
 		result4, expectedInflation4, diff4,
 	)
 }
+
+// TestDeflationCalculationFn_UnspecifiedDecayMode tests that params leaving out the
+// decay mode apply the continuous block decay.
+func (s *KeeperSuite) TestDeflationCalculationFn_UnspecifiedDecayMode() {
+	const decayEpoch int64 = 1000
+	s.ctx = s.ctx.WithBlockHeight(decayEpoch)
+	params := types.DefaultParams()
+	params.DecayMode = types.DecayMode_DECAY_MODE_UNSPECIFIED
+	params.DecayRate = math.LegacyNewDecWithPrec(65, 3)
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.keeper.SetDecayEpochStart(s.ctx, uint64(decayEpoch)))
+
+	mintParams := minttypes.DefaultParams()
+	mintParams.BlocksPerYear = 6307200 // divisible by 12
+	minter := minttypes.DefaultInitialMinter()
+	bondedRatio := math.LegacyNewDecWithPrec(50, 2)
+	baseInflation := minttypes.DefaultInflationCalculationFn(s.ctx, minter, mintParams, bondedRatio)
+
+	s.ctx = s.ctx.WithBlockHeight(decayEpoch + int64(mintParams.BlocksPerYear/12))
+	inflation := s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio)
+	s.Require().Equal(baseInflation.Mul(math.LegacyOneDec().Sub(params.DecayRate)), inflation)
+}
+
+// TestDeflationCalculationFn_ContinuousTime tests that decay follows the block time elapsed since the
+// decay start time, regardless of block heights.
+func (s *KeeperSuite) TestDeflationCalculationFn_ContinuousTime() {
+	start := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := types.DefaultParams()
+	params.DecayMode = types.DecayMode_DECAY_MODE_CONTINUOUS_TIME
+	params.DecayRate = math.LegacyNewDecWithPrec(65, 3)
+	params.DecayStartTime = start
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	minter := minttypes.DefaultInitialMinter()
+	mintParams := minttypes.DefaultParams()
+	bondedRatio := math.LegacyNewDecWithPrec(50, 2)
+	baseInflation := minttypes.DefaultInflationCalculationFn(s.ctx, minter, mintParams, bondedRatio)
+	decayFactor := math.LegacyOneDec().Sub(params.DecayRate)
+
+	// no decay before the decay start time, even without a decay epoch
+	s.ctx = s.ctx.WithBlockHeight(1).WithBlockTime(start.Add(-time.Hour))
+	s.Require().Equal(baseInflation, s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio))
+
+	// a month of block time decays inflation by the monthly rate at any height
+	s.ctx = s.ctx.WithBlockHeight(10).WithBlockTime(start.Add(keeper.SecondsPerMonth * time.Second))
+	s.Require().Equal(baseInflation.Mul(decayFactor), s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio))
+
+	// half a month decays by the square root of the monthly factor
+	s.ctx = s.ctx.WithBlockTime(start.Add(keeper.SecondsPerMonth / 2 * time.Second))
+	halfMonthFactor, err := decayFactor.ApproxSqrt()
+	s.Require().NoError(err)
+	inflation := s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio)
+	diff := inflation.Sub(baseInflation.Mul(halfMonthFactor)).Abs()
+	s.Require().True(diff.LT(math.LegacyNewDecWithPrec(1, 12)), "got %s, diff %s", inflation, diff)
+}
+
+// TestDeflationCalculationFn_StepSchedule tests that the inflation bounds of the latest effective step
+// apply, and that the decay rate is ignored.
+func (s *KeeperSuite) TestDeflationCalculationFn_StepSchedule() {
+	start := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	halving := start.AddDate(4, 0, 0)
+	params := types.DefaultParams()
+	params.DecayMode = types.DecayMode_DECAY_MODE_STEP_SCHEDULE
+	params.DecayRate = math.LegacyNewDecWithPrec(65, 3)
+	params.InflationSchedule = []types.InflationStep{
+		types.NewInflationStep(start, math.LegacyNewDecWithPrec(10, 2), math.LegacyNewDecWithPrec(5, 2)),
+		types.NewInflationStep(halving, math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(25, 3)),
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.keeper.SetDecayEpochStart(s.ctx, 1))
+
+	minter := minttypes.DefaultInitialMinter()
+	mintParams := minttypes.DefaultParams()
+	bondedRatio := math.LegacyNewDecWithPrec(50, 2)
+	baseInflation := minttypes.DefaultInflationCalculationFn(s.ctx, minter, mintParams, bondedRatio)
+
+	// the mint params bounds apply before the first step
+	s.ctx = s.ctx.WithBlockHeight(10_000_000).WithBlockTime(start.Add(-time.Second))
+	s.Require().Equal(baseInflation, s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio))
+
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.Require().Equal(math.LegacyNewDecWithPrec(10, 2), s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio))
+
+	s.ctx = s.ctx.WithBlockTime(halving.Add(time.Second))
+	s.Require().Equal(math.LegacyNewDecWithPrec(5, 2), s.keeper.DeflationCalculationFn()(s.ctx, minter, mintParams, bondedRatio))
+}
+
+func (s *KeeperSuite) countEvents(eventType string) int {
+	count := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+// TestMintFn_Unlimited tests that the block provision is minted when there is no maximum supply.
+func (s *KeeperSuite) TestMintFn_Unlimited() {
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	supply, _, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.keeper.MintFn()(s.ctx, &s.app.MintKeeper))
+
+	newSupply, _, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(newSupply.GT(supply))
+	s.Require().Zero(s.countEvents(types.EventTypeMintClamped))
+}
+
+// TestMintFn_ClampedAtMaxSupply tests that minting is clamped at the maximum supply, then stops.
+func (s *KeeperSuite) TestMintFn_ClampedAtMaxSupply() {
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	supply, denom, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+
+	// burned address balances are not counted in the capped supply
+	burnedAddr := sdk.AccAddress([]byte("burned_addr_12345678"))
+	burnedAmount := math.NewInt(1_000)
+	err = banktestutil.FundAccount(s.ctx, s.app.BankKeeper, burnedAddr, sdk.NewCoins(sdk.NewCoin(denom, burnedAmount)))
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MaxSupply = supply.Add(math.NewInt(10))
+	params.BurnedAddresses = []string{burnedAddr.String()}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	s.Require().NoError(s.keeper.MintFn()(s.ctx, &s.app.MintKeeper))
+	s.Require().Equal(1, s.countEvents(types.EventTypeMintClamped))
+
+	capped, _, err := s.keeper.GetSupplyNetOfBurned(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxSupply, capped)
+
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom)
+
+	// nothing is minted once the cap is reached
+	s.Require().NoError(s.keeper.MintFn()(s.ctx, &s.app.MintKeeper))
+	s.Require().Equal(2, s.countEvents(types.EventTypeMintClamped))
+	capped, _, err = s.keeper.GetSupplyNetOfBurned(s.ctx, params)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MaxSupply, capped)
+	s.Require().Equal(collected, s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom))
+
+	// the safety assertion of the begin blocker holds
+	s.Require().NotPanics(func() {
+		s.Require().NoError(inflation.BeginBlocker(s.ctx, s.keeper))
+	})
+}
+
+// TestMintFn_MintDistributions tests that shares of the minted provisions are diverted to module
+// accounts and the community pool, the remainder going to the fee collector.
+func (s *KeeperSuite) TestMintFn_MintDistributions() {
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	supply, denom, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	params.MintDistributions = []types.MintDistribution{
+		types.NewMintDistribution(tieredrewardstypes.RewardsPoolName, math.LegacyNewDecWithPrec(25, 2)),
+		types.NewMintDistribution(distrtypes.ModuleName, math.LegacyNewDecWithPrec(10, 2)),
+	}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	rewardsPool := s.app.AccountKeeper.GetModuleAddress(tieredrewardstypes.RewardsPoolName)
+	collectedBefore := s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom).Amount
+	poolBefore := s.app.BankKeeper.GetBalance(s.ctx, rewardsPool, denom).Amount
+	feePool, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err)
+	communityPoolBefore := feePool.CommunityPool.AmountOf(denom)
+
+	s.Require().NoError(s.keeper.MintFn()(s.ctx, &s.app.MintKeeper))
+	s.Require().Equal(2, s.countEvents(types.EventTypeMintDiverted))
+
+	newSupply, _, err := s.keeper.GetSupplyAndDenom(s.ctx)
+	s.Require().NoError(err)
+	minted := math.LegacyNewDecFromInt(newSupply.Sub(supply))
+	s.Require().True(minted.IsPositive())
+
+	poolShare := minted.MulTruncate(math.LegacyNewDecWithPrec(25, 2)).TruncateInt()
+	communityShare := minted.MulTruncate(math.LegacyNewDecWithPrec(10, 2)).TruncateInt()
+	s.Require().Equal(poolBefore.Add(poolShare), s.app.BankKeeper.GetBalance(s.ctx, rewardsPool, denom).Amount)
+	feePool, err = s.app.DistrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(communityPoolBefore.Add(math.LegacyNewDecFromInt(communityShare)), feePool.CommunityPool.AmountOf(denom))
+	collected := minted.TruncateInt().Sub(poolShare).Sub(communityShare)
+	s.Require().Equal(collectedBefore.Add(collected), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, denom).Amount)
+}
@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -31,9 +32,72 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ValidateMintDistributions(msg.Params); err != nil {
+		return nil, err
+	}
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// Burn implements MsgServer.Burn method.
+// It destroys coins of the sender and adds them to the burned totals.
+func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if err := k.Keeper.Burn(goCtx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// SweepBurnedAddresses implements MsgServer.SweepBurnedAddresses method.
+// It burns the spendable bond denom balances of the legacy burned addresses of the
+// params, which are then cleared. The locked coins of vesting accounts are left.
+func (k msgServer) SweepBurnedAddresses(goCtx context.Context, msg *types.MsgSweepBurnedAddresses) (*types.MsgSweepBurnedAddressesResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	burned := sdk.NewCoins()
+	for _, burnedAddress := range params.BurnedAddresses {
+		addr, err := sdk.AccAddressFromBech32(burnedAddress)
+		if err != nil {
+			return nil, err
+		}
+		spendable := sdk.NewCoin(bondDenom, k.bankKeeper.SpendableCoins(ctx, addr).AmountOf(bondDenom))
+		if spendable.IsZero() {
+			continue
+		}
+		if err := k.Keeper.Burn(ctx, addr, sdk.NewCoins(spendable)); err != nil {
+			return nil, errors.Wrapf(err, "failed to burn the balance of %s", burnedAddress)
+		}
+		burned = burned.Add(spendable)
+	}
+
+	params.BurnedAddresses = []string{}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSweepBurnedAddressesResponse{Burned: burned}, nil
+}
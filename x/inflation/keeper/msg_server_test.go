@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/keeper"
 	"github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
+	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
 
 	"cosmossdk.io/math"
 )
@@ -150,3 +151,22 @@ func (s *KeeperSuite) TestUpdateParams_DecayRateBoundaries() {
 	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params1})
 	s.Require().NoError(err)
 }
+
+func (s *KeeperSuite) TestUpdateParams_MintDistributions() {
+	authority := s.keeper.GetAuthority()
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+
+	// the recipients must be registered module accounts
+	params := types.DefaultParams()
+	params.MintDistributions = []types.MintDistribution{types.NewMintDistribution("unknown", math.LegacyNewDecWithPrec(1, 1))}
+	_, err := msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().ErrorContains(err, "module account unknown does not exist")
+
+	params.MintDistributions = []types.MintDistribution{types.NewMintDistribution(tieredrewardstypes.RewardsPoolName, math.LegacyNewDecWithPrec(1, 1))}
+	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+
+	stored, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.MintDistributions, stored.MintDistributions)
+}
@@ -9,11 +9,15 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "chainmain/inflation/Params", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "chainmain/inflation/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgBurn{}, "chainmain/inflation/MsgBurn", nil)
+	cdc.RegisterConcrete(&MsgSweepBurnedAddresses{}, "chainmain/inflation/MsgSweepBurnedAddresses", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgBurn{},
+		&MsgSweepBurnedAddresses{},
 	)
 }
 
@@ -24,6 +28,5 @@ var (
 
 func init() {
 	RegisterLegacyAminoCodec(Amino)
-	RegisterInterfaces(ModuleCdc.InterfaceRegistry())
 	Amino.Seal()
 }
@@ -0,0 +1,15 @@
+package types
+
+// inflation module event types
+const (
+	EventTypeMintClamped  = "mint_clamped"
+	EventTypeBurn         = "coins_burned"
+	EventTypeMintDiverted = "mint_diverted"
+
+	AttributeKeyRequested = "requested"
+	AttributeKeyMinted    = "minted"
+	AttributeKeySupply    = "supply"
+	AttributeKeyMaxSupply = "max_supply"
+	AttributeKeyBurner    = "burner"
+	AttributeKeyModule    = "module"
+)
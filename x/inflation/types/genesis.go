@@ -20,5 +20,9 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("decay_epoch_start must be zero when decay is disabled")
 	}
 
+	if err := gs.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned totals: %w", err)
+	}
+
 	return nil
 }
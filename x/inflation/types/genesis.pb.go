@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +31,8 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// decay_epoch_start is the block height at which decay began (set when decay is first enabled).
 	DecayEpochStart uint64 `protobuf:"varint,2,opt,name=decay_epoch_start,json=decayEpochStart,proto3" json:"decay_epoch_start,omitempty"`
+	// burned is the cumulative total of the coins burned by the module, per denom.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +82,13 @@ func (m *GenesisState) GetDecayEpochStart() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "chainmain.inflation.v1.GenesisState")
 }
@@ -87,23 +98,28 @@ func init() {
 }
 
 var fileDescriptor_12112b07612198aa = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0x4d, 0xcc, 0xcc, 0xd3, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc6, 0xa1, 0xbb, 0x20,
-	0xb1, 0x28, 0x31, 0x17, 0xaa, 0x59, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1,
-	0x20, 0xa2, 0x4a, 0x15, 0x5c, 0x3c, 0xee, 0x10, 0x3b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c,
-	0xb8, 0xd8, 0x20, 0xba, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0xdb, 0xa9,
-	0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x16, 0x97,
-	0x60, 0x4a, 0x6a, 0x72, 0x62, 0x65, 0x7c, 0x6a, 0x41, 0x7e, 0x72, 0x46, 0x7c, 0x71, 0x49, 0x62,
-	0x51, 0x89, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x3f, 0x58, 0xc2, 0x15, 0x24, 0x1e, 0x0c,
-	0x12, 0x76, 0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xa2, 0xca, 0x82, 0x92, 0x7c,
-	0xdd, 0xfc, 0xa2, 0x74, 0x5d, 0xb0, 0x43, 0xf4, 0xc1, 0xa4, 0x2e, 0xd8, 0xaf, 0x15, 0x48, 0xbe,
-	0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xca, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x0b, 0x70, 0x59, 0x9f, 0x4f, 0x01, 0x00, 0x00,
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x50, 0x3d, 0x4f, 0xc3, 0x30,
+	0x10, 0x8d, 0x69, 0xd5, 0x21, 0x45, 0x42, 0x44, 0x08, 0x95, 0x0e, 0x6e, 0x05, 0x0c, 0x11, 0x52,
+	0x6c, 0x52, 0x16, 0x06, 0xa6, 0x22, 0xc4, 0x8a, 0xd2, 0x8d, 0xa5, 0x72, 0x5c, 0x93, 0x5a, 0x10,
+	0x5f, 0x14, 0xbb, 0x15, 0xfd, 0x17, 0xfc, 0x0e, 0x7e, 0x49, 0xc7, 0x8e, 0x2c, 0x7c, 0xa8, 0xfd,
+	0x23, 0x28, 0x8e, 0x55, 0x75, 0x80, 0xc5, 0x3e, 0xdd, 0xbd, 0xf7, 0xee, 0xde, 0xf3, 0xcf, 0xf9,
+	0x94, 0x49, 0x95, 0x33, 0xa9, 0xa8, 0x54, 0x4f, 0x2f, 0xcc, 0x48, 0x50, 0x74, 0x1e, 0xd3, 0x4c,
+	0x28, 0xa1, 0xa5, 0x26, 0x45, 0x09, 0x06, 0x82, 0xe3, 0x2d, 0x8a, 0x6c, 0x51, 0x64, 0x1e, 0x77,
+	0xcf, 0xfe, 0x61, 0x17, 0xac, 0x64, 0xb9, 0x23, 0x77, 0x31, 0x07, 0x9d, 0x83, 0xa6, 0x29, 0xd3,
+	0x82, 0xce, 0xe3, 0x54, 0x18, 0x16, 0x53, 0x0e, 0x52, 0xb9, 0xf9, 0x51, 0x06, 0x19, 0xd8, 0x92,
+	0x56, 0x55, 0xdd, 0x3d, 0xfd, 0x44, 0xfe, 0xfe, 0x7d, 0x7d, 0xc4, 0xc8, 0x30, 0x23, 0x82, 0x1b,
+	0xbf, 0x55, 0xcb, 0x76, 0x50, 0x1f, 0x85, 0xed, 0x01, 0x26, 0x7f, 0x1f, 0x45, 0x1e, 0x2c, 0x6a,
+	0xd8, 0x5c, 0x7e, 0xf5, 0xbc, 0xc4, 0x71, 0x82, 0x0b, 0xff, 0x70, 0x22, 0x38, 0x5b, 0x8c, 0x45,
+	0x01, 0x7c, 0x3a, 0xd6, 0x86, 0x95, 0xa6, 0xb3, 0xd7, 0x47, 0x61, 0x33, 0x39, 0xb0, 0x83, 0xbb,
+	0xaa, 0x3f, 0xaa, 0xda, 0x01, 0xf7, 0x5b, 0xe9, 0xac, 0x54, 0x62, 0xd2, 0x69, 0xf4, 0x1b, 0x61,
+	0x7b, 0x70, 0x42, 0x6a, 0x07, 0xa4, 0x72, 0x40, 0x9c, 0x03, 0x72, 0x0b, 0x52, 0x0d, 0x2f, 0xab,
+	0x25, 0xef, 0xdf, 0xbd, 0x30, 0x93, 0x66, 0x3a, 0x4b, 0x09, 0x87, 0x9c, 0x3a, 0xbb, 0xf5, 0x17,
+	0xe9, 0xc9, 0x33, 0x35, 0x8b, 0x42, 0x68, 0x4b, 0xd0, 0x89, 0x93, 0x1e, 0x26, 0xcb, 0x35, 0x46,
+	0xab, 0x35, 0x46, 0x3f, 0x6b, 0x8c, 0xde, 0x36, 0xd8, 0x5b, 0x6d, 0xb0, 0xf7, 0xb1, 0xc1, 0xde,
+	0xe3, 0xf5, 0xae, 0x56, 0xb9, 0x28, 0x0c, 0x44, 0x50, 0x66, 0x91, 0x75, 0x4b, 0xed, 0x1b, 0xd9,
+	0xc4, 0x5f, 0x77, 0x32, 0xb7, 0x1b, 0xd2, 0x96, 0x8d, 0xee, 0xea, 0x77, 0x00, 0xbd, 0x1a, 0x9b,
+	0x6c, 0xd5, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +142,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DecayEpochStart != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.DecayEpochStart))
 		i--
@@ -166,6 +196,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.DecayEpochStart != 0 {
 		n += 1 + sovGenesis(uint64(m.DecayEpochStart))
 	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -256,6 +292,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
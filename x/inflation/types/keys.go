@@ -13,4 +13,15 @@ const (
 
 	// DecayEpochStartKey stores the block height when decay begins (big-endian uint64), set by the upgrade handler or genesis.
 	DecayEpochStartKey = "decay_epoch_start"
+
+	// BlockDivertedKey stores the coin diverted by the mint distributions in the last minted block.
+	BlockDivertedKey = "block_diverted"
+
+	// BurnedKeyPrefix prefixes the cumulative burned amount of each denom.
+	BurnedKeyPrefix = "burned/"
 )
+
+// BurnedKey returns the key of the cumulative burned amount of a denom.
+func BurnedKey(denom string) []byte {
+	return []byte(BurnedKeyPrefix + denom)
+}
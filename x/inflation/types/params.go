@@ -2,24 +2,60 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
 	UNLIMITED_SUPPLY = 0
 )
 
-// NewParams creates a new Params instance
+// reservedMintRecipients are the module accounts minted provisions cannot be diverted to:
+// the staking pools must match the bonded and unbonding tokens, the minted provisions
+// reach the fee collector anyway, and the mint module would hold them forever.
+var reservedMintRecipients = []string{
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	minttypes.ModuleName,
+	authtypes.FeeCollectorName,
+}
+
+// NewParams creates a new Params instance with the continuous block decay mode
 func NewParams(maxSupply sdkmath.Int, burnedAddresses []string, decayRate sdkmath.LegacyDec) Params {
 	return Params{
-		MaxSupply:       maxSupply,
-		BurnedAddresses: burnedAddresses,
-		DecayRate:       decayRate,
+		MaxSupply:         maxSupply,
+		BurnedAddresses:   burnedAddresses,
+		DecayRate:         decayRate,
+		DecayMode:         DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS,
+		InflationSchedule: []InflationStep{},
+		MintDistributions: []MintDistribution{},
+	}
+}
+
+// NewInflationStep creates a new InflationStep instance
+func NewInflationStep(effectiveTime time.Time, inflationMax, inflationMin sdkmath.LegacyDec) InflationStep {
+	return InflationStep{
+		EffectiveTime: effectiveTime,
+		InflationMax:  inflationMax,
+		InflationMin:  inflationMin,
+	}
+}
+
+// NewMintDistribution creates a new MintDistribution instance
+func NewMintDistribution(moduleName string, share sdkmath.LegacyDec) MintDistribution {
+	return MintDistribution{
+		ModuleName: moduleName,
+		Share:      share,
 	}
 }
 
@@ -46,6 +82,29 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+
+	if err := validateMintDistributions(p.MintDistributions); err != nil {
+		return err
+	}
+
+	switch p.DecayMode {
+	// the unspecified decay mode of params predating decay modes is the continuous block decay
+	case DecayMode_DECAY_MODE_UNSPECIFIED, DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS:
+	case DecayMode_DECAY_MODE_CONTINUOUS_TIME:
+		if p.DecayRate.IsPositive() && !p.DecayStartTime.After(time.Unix(0, 0)) {
+			return fmt.Errorf("decay start time must be set in the continuous time decay mode")
+		}
+	case DecayMode_DECAY_MODE_STEP_SCHEDULE:
+		if len(p.InflationSchedule) == 0 {
+			return fmt.Errorf("inflation schedule cannot be empty in the step schedule decay mode")
+		}
+	default:
+		return fmt.Errorf("invalid decay mode: %s", p.DecayMode)
+	}
+
 	return nil
 }
 
@@ -117,3 +176,57 @@ func validateDecayRate(i any) error {
 
 	return nil
 }
+
+// validateInflationSchedule validates the InflationSchedule param
+func validateInflationSchedule(schedule []InflationStep) error {
+	for i, step := range schedule {
+		if !step.EffectiveTime.After(time.Unix(0, 0)) {
+			return fmt.Errorf("inflation step %d: effective time must be set", i)
+		}
+		if i > 0 && !step.EffectiveTime.After(schedule[i-1].EffectiveTime) {
+			return fmt.Errorf("inflation step %d: effective times must be strictly increasing", i)
+		}
+		if step.InflationMax.IsNil() || step.InflationMin.IsNil() {
+			return fmt.Errorf("inflation step %d: inflation bounds cannot be nil", i)
+		}
+		if step.InflationMin.IsNegative() {
+			return fmt.Errorf("inflation step %d: inflation min cannot be negative: %s", i, step.InflationMin)
+		}
+		if step.InflationMax.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("inflation step %d: inflation max too large: %s", i, step.InflationMax)
+		}
+		if step.InflationMin.GT(step.InflationMax) {
+			return fmt.Errorf("inflation step %d: inflation min %s is greater than inflation max %s", i, step.InflationMin, step.InflationMax)
+		}
+	}
+
+	return nil
+}
+
+// validateMintDistributions validates the MintDistributions param
+func validateMintDistributions(distributions []MintDistribution) error {
+	total := sdkmath.LegacyZeroDec()
+	for i, distribution := range distributions {
+		if strings.TrimSpace(distribution.ModuleName) == "" {
+			return fmt.Errorf("mint distribution %d: module name cannot be empty", i)
+		}
+		if slices.Contains(reservedMintRecipients, distribution.ModuleName) {
+			return fmt.Errorf("mint distribution %d: minted provisions cannot be diverted to %s", i, distribution.ModuleName)
+		}
+		for _, other := range distributions[:i] {
+			if other.ModuleName == distribution.ModuleName {
+				return fmt.Errorf("duplicate mint distribution module: %s", distribution.ModuleName)
+			}
+		}
+		if distribution.Share.IsNil() || !distribution.Share.IsPositive() {
+			return fmt.Errorf("mint distribution %d: share must be positive", i)
+		}
+		total = total.Add(distribution.Share)
+	}
+
+	if total.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("mint distribution shares too large (total must be at most 1, got: %s)", total)
+	}
+
+	return nil
+}
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +30,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DecayMode enumerates the ways inflation decays over time.
+type DecayMode int32
+
+const (
+	// DECAY_MODE_UNSPECIFIED applies the continuous block decay, as params predating
+	// decay modes and params leaving out the decay mode
+	DecayMode_DECAY_MODE_UNSPECIFIED DecayMode = 0
+	// DECAY_MODE_CONTINUOUS_BLOCKS applies continuous exponential decay with decay_rate
+	// per month of blocks, from the decay epoch start height
+	DecayMode_DECAY_MODE_CONTINUOUS_BLOCKS DecayMode = 1
+	// DECAY_MODE_CONTINUOUS_TIME applies continuous exponential decay with decay_rate
+	// per month of block time, from decay_start_time
+	DecayMode_DECAY_MODE_CONTINUOUS_TIME DecayMode = 2
+	// DECAY_MODE_STEP_SCHEDULE bounds inflation with the latest effective step of
+	// inflation_schedule, decay_rate is not applied
+	DecayMode_DECAY_MODE_STEP_SCHEDULE DecayMode = 3
+)
+
+var DecayMode_name = map[int32]string{
+	0: "DECAY_MODE_UNSPECIFIED",
+	1: "DECAY_MODE_CONTINUOUS_BLOCKS",
+	2: "DECAY_MODE_CONTINUOUS_TIME",
+	3: "DECAY_MODE_STEP_SCHEDULE",
+}
+
+var DecayMode_value = map[string]int32{
+	"DECAY_MODE_UNSPECIFIED":       0,
+	"DECAY_MODE_CONTINUOUS_BLOCKS": 1,
+	"DECAY_MODE_CONTINUOUS_TIME":   2,
+	"DECAY_MODE_STEP_SCHEDULE":     3,
+}
+
+func (x DecayMode) String() string {
+	return proto.EnumName(DecayMode_name, int32(x))
+}
+
+func (DecayMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb680dab3d7300b0, []int{0}
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	// max_supply is the maximum supply of tokens that can be minted
@@ -34,6 +78,17 @@ type Params struct {
 	BurnedAddresses []string `protobuf:"bytes,2,rep,name=burned_addresses,json=burnedAddresses,proto3" json:"burned_addresses,omitempty"`
 	// monthly decay rate [0, 1]; 0 = no decay; 1 = no minting
 	DecayRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=decay_rate,json=decayRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_rate"`
+	// decay_mode selects how the inflation decays over time
+	DecayMode DecayMode `protobuf:"varint,4,opt,name=decay_mode,json=decayMode,proto3,enum=chainmain.inflation.v1.DecayMode" json:"decay_mode,omitempty"`
+	// decay_start_time is the block time from which inflation decays in the
+	// continuous time decay mode
+	DecayStartTime time.Time `protobuf:"bytes,5,opt,name=decay_start_time,json=decayStartTime,proto3,stdtime" json:"decay_start_time"`
+	// inflation_schedule is the governance-set schedule of inflation bounds, sorted by
+	// effective time, applied in the step schedule decay mode
+	InflationSchedule []InflationStep `protobuf:"bytes,6,rep,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule"`
+	// mint_distributions divert shares of the provisions minted in each block to module
+	// accounts, the remainder going to the fee collector
+	MintDistributions []MintDistribution `protobuf:"bytes,7,rep,name=mint_distributions,json=mintDistributions,proto3" json:"mint_distributions"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,8 +130,140 @@ func (m *Params) GetBurnedAddresses() []string {
 	return nil
 }
 
+func (m *Params) GetDecayMode() DecayMode {
+	if m != nil {
+		return m.DecayMode
+	}
+	return DecayMode_DECAY_MODE_UNSPECIFIED
+}
+
+func (m *Params) GetDecayStartTime() time.Time {
+	if m != nil {
+		return m.DecayStartTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetInflationSchedule() []InflationStep {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetMintDistributions() []MintDistribution {
+	if m != nil {
+		return m.MintDistributions
+	}
+	return nil
+}
+
+// InflationStep overrides the inflation bounds of the mint module from its effective
+// time, until the next step.
+type InflationStep struct {
+	// effective_time is the block time from which the step applies
+	EffectiveTime time.Time `protobuf:"bytes,1,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+	// inflation_max is the maximum annual inflation rate
+	InflationMax cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=inflation_max,json=inflationMax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_max"`
+	// inflation_min is the minimum annual inflation rate
+	InflationMin cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=inflation_min,json=inflationMin,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_min"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb680dab3d7300b0, []int{1}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// MintDistribution diverts a share of the minted provisions to a module account.
+type MintDistribution struct {
+	// module_name is the name of the recipient module account, the provisions diverted
+	// to the distribution module fund the community pool. The staking pools, the mint
+	// module and the fee collector cannot be recipients.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// share is the share of the minted provisions diverted to the module account
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *MintDistribution) Reset()         { *m = MintDistribution{} }
+func (m *MintDistribution) String() string { return proto.CompactTextString(m) }
+func (*MintDistribution) ProtoMessage()    {}
+func (*MintDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb680dab3d7300b0, []int{2}
+}
+func (m *MintDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDistribution.Merge(m, src)
+}
+func (m *MintDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDistribution proto.InternalMessageInfo
+
+func (m *MintDistribution) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("chainmain.inflation.v1.DecayMode", DecayMode_name, DecayMode_value)
 	proto.RegisterType((*Params)(nil), "chainmain.inflation.v1.Params")
+	proto.RegisterType((*InflationStep)(nil), "chainmain.inflation.v1.InflationStep")
+	proto.RegisterType((*MintDistribution)(nil), "chainmain.inflation.v1.MintDistribution")
 }
 
 func init() {
@@ -84,30 +271,52 @@ func init() {
 }
 
 var fileDescriptor_eb680dab3d7300b0 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0x4d, 0xcc, 0xcc, 0xd3, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2b, 0xd2, 0x83, 0x2b, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0xa5, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x9e,
-	0x3e, 0x84, 0x03, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x88, 0x83, 0x58, 0x10, 0x51, 0xa5,
-	0x6f, 0x8c, 0x5c, 0x6c, 0x01, 0x60, 0xcb, 0x84, 0xbc, 0xb8, 0xb8, 0x72, 0x13, 0x2b, 0xe2, 0x8b,
-	0x4b, 0x0b, 0x0a, 0x72, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0xb4, 0x4f, 0xdc, 0x93,
-	0x67, 0xb8, 0x75, 0x4f, 0x5e, 0x14, 0x62, 0x54, 0x71, 0x4a, 0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x6e,
-	0x62, 0x49, 0x86, 0x9e, 0x67, 0x5e, 0xc9, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0x3b, 0x3c, 0xf3, 0x4a,
-	0x82, 0x38, 0x73, 0x13, 0x2b, 0x82, 0xc1, 0xba, 0x85, 0x9c, 0xb9, 0x04, 0x92, 0x4a, 0x8b, 0xf2,
-	0x52, 0x53, 0xe2, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x53, 0x8b, 0x25, 0x98, 0x14, 0x98,
-	0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x6a, 0x72, 0x84, 0xc8, 0x05, 0x97, 0x14,
-	0x65, 0xe6, 0xa5, 0x07, 0xf1, 0x43, 0x74, 0x38, 0xc2, 0x34, 0x08, 0x85, 0x72, 0x71, 0xa5, 0xa4,
-	0x26, 0x27, 0x56, 0xc6, 0x17, 0x25, 0x96, 0xa4, 0x4a, 0x30, 0x83, 0x1d, 0x64, 0x06, 0x75, 0x90,
-	0x34, 0xa6, 0x83, 0x7c, 0x52, 0xd3, 0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x91, 0x9c, 0xe5, 0x92,
-	0x9a, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0x4e, 0xb0, 0x49, 0x41, 0x89, 0x25, 0xa9, 0x56,
-	0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x05, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x72, 0x51,
-	0x65, 0x41, 0x49, 0xbe, 0x6e, 0x7e, 0x51, 0xba, 0x2e, 0x38, 0x12, 0xf4, 0xc1, 0xa4, 0x2e, 0x38,
-	0xc2, 0x2a, 0x90, 0xa2, 0xac, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xa6, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x8c, 0x25, 0xae, 0xd6, 0x01, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0xa4, 0x2d, 0xca, 0x95, 0x94, 0xf4, 0x54, 0x2a, 0x13, 0xaa, 0x24, 0x14, 0x21,
+	0x45, 0x45, 0xb1, 0xd5, 0x22, 0x21, 0xc4, 0x44, 0x93, 0x18, 0x11, 0xc8, 0x2f, 0xc5, 0xc9, 0x00,
+	0x0c, 0xd6, 0xc5, 0xbe, 0x38, 0x27, 0x72, 0x3e, 0xcb, 0x77, 0xa9, 0x92, 0x91, 0x89, 0xb5, 0x23,
+	0x12, 0x0b, 0x23, 0x23, 0x43, 0xff, 0x88, 0x8e, 0x55, 0x27, 0xc4, 0x50, 0x50, 0x3b, 0xf0, 0x6f,
+	0x20, 0xff, 0x48, 0x48, 0x4b, 0x3b, 0xa0, 0xb2, 0x58, 0x7e, 0xf7, 0xde, 0xfb, 0xbc, 0xaf, 0xbf,
+	0x7e, 0x3a, 0x70, 0xdf, 0x1c, 0x20, 0xe2, 0x50, 0x44, 0x1c, 0x95, 0x38, 0xfd, 0x21, 0x12, 0x84,
+	0x39, 0xea, 0xde, 0xb6, 0xea, 0x22, 0x0f, 0x51, 0xae, 0xb8, 0x1e, 0x13, 0x0c, 0xae, 0xcf, 0x8a,
+	0x94, 0x59, 0x91, 0xb2, 0xb7, 0x9d, 0x59, 0x45, 0x94, 0x38, 0x4c, 0x0d, 0x9e, 0x61, 0x69, 0xe6,
+	0x8e, 0xc9, 0x38, 0x65, 0xdc, 0x08, 0x22, 0x35, 0x0c, 0xa2, 0xd4, 0x9a, 0xcd, 0x6c, 0x16, 0x9e,
+	0xfb, 0x6f, 0xd1, 0x69, 0xce, 0x66, 0xcc, 0x1e, 0x62, 0x35, 0x88, 0x7a, 0xa3, 0xbe, 0x2a, 0x08,
+	0xc5, 0x5c, 0x20, 0xea, 0x86, 0x05, 0x9b, 0xc7, 0x0b, 0x60, 0xa9, 0x15, 0xa8, 0x81, 0x2f, 0x01,
+	0xa0, 0x68, 0x6c, 0xf0, 0x91, 0xeb, 0x0e, 0x27, 0xb2, 0x94, 0x97, 0x0a, 0xc9, 0xd2, 0xc3, 0xc3,
+	0x93, 0x5c, 0xec, 0xfb, 0x49, 0xee, 0x76, 0x38, 0x8b, 0x5b, 0xef, 0x14, 0xc2, 0x54, 0x8a, 0xc4,
+	0x40, 0xa9, 0x3a, 0xe2, 0xf8, 0xa0, 0x08, 0x22, 0x11, 0x55, 0x47, 0xb4, 0x93, 0x14, 0x8d, 0xf5,
+	0xa0, 0x1b, 0x96, 0x41, 0xba, 0x37, 0xf2, 0x1c, 0x6c, 0x19, 0xc8, 0xb2, 0x3c, 0xcc, 0x39, 0xe6,
+	0x72, 0x3c, 0x9f, 0x28, 0x24, 0x4b, 0xf2, 0xf1, 0x41, 0x71, 0x2d, 0x6a, 0xda, 0x0d, 0x73, 0xba,
+	0xf0, 0x88, 0x63, 0xb7, 0x6f, 0x85, 0x1d, 0xbb, 0xd3, 0x06, 0xd8, 0x05, 0xc0, 0xc2, 0x26, 0x9a,
+	0x18, 0x1e, 0x12, 0x58, 0x4e, 0x04, 0x82, 0x1e, 0x47, 0x82, 0xee, 0xfe, 0x2d, 0xa8, 0x86, 0x6d,
+	0x64, 0x4e, 0x2a, 0xd8, 0x9c, 0x93, 0x55, 0xc1, 0xe6, 0x97, 0x5f, 0x5f, 0xb7, 0xa4, 0x76, 0x32,
+	0x20, 0xb5, 0x91, 0xc0, 0xf0, 0xd9, 0x14, 0x4b, 0x99, 0x85, 0xe5, 0x85, 0xbc, 0x54, 0x58, 0xd9,
+	0xb9, 0xa7, 0x5c, 0xfe, 0x13, 0x7c, 0x00, 0x9a, 0xd4, 0x99, 0x85, 0x23, 0x82, 0xff, 0x0a, 0x75,
+	0x90, 0x0e, 0x09, 0x5c, 0x20, 0x4f, 0x18, 0xbe, 0xa7, 0xf2, 0x62, 0x5e, 0x2a, 0x2c, 0xef, 0x64,
+	0x94, 0xd0, 0x70, 0x65, 0x6a, 0xb8, 0xd2, 0x99, 0x1a, 0x5e, 0x4a, 0xf9, 0xd2, 0xf7, 0x7f, 0xe4,
+	0xa4, 0x50, 0xd1, 0x4a, 0x80, 0xd0, 0x7d, 0x82, 0x5f, 0x03, 0x0d, 0x00, 0x67, 0x93, 0x0d, 0x6e,
+	0x0e, 0xb0, 0x35, 0x1a, 0x62, 0x79, 0x29, 0x9f, 0x28, 0x2c, 0xef, 0x3c, 0xb8, 0x4a, 0x5e, 0x75,
+	0x1a, 0xe8, 0x02, 0xbb, 0xa5, 0xa4, 0x3f, 0x21, 0xa4, 0xaf, 0xce, 0xca, 0xf4, 0x08, 0x05, 0x7b,
+	0x00, 0x52, 0xe2, 0x08, 0xc3, 0x22, 0x5c, 0x78, 0xa4, 0x37, 0xf2, 0x93, 0x5c, 0xbe, 0x11, 0x0c,
+	0x28, 0x5c, 0x35, 0xa0, 0x4e, 0x1c, 0x51, 0x99, 0x6b, 0x38, 0x37, 0x83, 0x5e, 0x48, 0xf2, 0xa7,
+	0x0b, 0x1f, 0x3f, 0xe7, 0x62, 0x9b, 0x9f, 0xe2, 0x20, 0x75, 0x4e, 0x19, 0x6c, 0x81, 0x15, 0xdc,
+	0xef, 0x63, 0x53, 0x90, 0x3d, 0x1c, 0xfa, 0x25, 0xfd, 0xab, 0x5f, 0xa9, 0x19, 0x20, 0xb0, 0xeb,
+	0x2d, 0x48, 0xfd, 0xb1, 0x8b, 0xa2, 0xb1, 0x1c, 0xbf, 0xd6, 0x7e, 0xdc, 0x9c, 0xc1, 0xea, 0x68,
+	0x7c, 0x01, 0x4e, 0x1c, 0x39, 0xf1, 0xbf, 0xe0, 0xc4, 0xd9, 0x7c, 0x2f, 0x81, 0xf4, 0x45, 0x5b,
+	0x61, 0x0e, 0x2c, 0x53, 0xe6, 0xff, 0x26, 0xc3, 0x41, 0x91, 0x3b, 0xc9, 0x36, 0x08, 0x8f, 0x1a,
+	0x88, 0x62, 0x58, 0x03, 0x8b, 0x7c, 0x80, 0x3c, 0x7c, 0xcd, 0xef, 0x0c, 0x21, 0x5b, 0x1f, 0x24,
+	0x90, 0x9c, 0xad, 0x36, 0xcc, 0x80, 0xf5, 0x8a, 0x56, 0xde, 0x7d, 0x6d, 0xd4, 0x9b, 0x15, 0xcd,
+	0xe8, 0x36, 0xf4, 0x96, 0x56, 0xae, 0x3e, 0xaf, 0x6a, 0x95, 0x74, 0x0c, 0xe6, 0xc1, 0xc6, 0x5c,
+	0xae, 0xdc, 0x6c, 0x74, 0xaa, 0x8d, 0x6e, 0xb3, 0xab, 0x1b, 0xa5, 0x5a, 0xb3, 0xfc, 0x4a, 0x4f,
+	0x4b, 0x30, 0x0b, 0x32, 0x97, 0x57, 0x74, 0xaa, 0x75, 0x2d, 0x1d, 0x87, 0x1b, 0x40, 0x9e, 0xcb,
+	0xeb, 0x1d, 0xad, 0x65, 0xe8, 0xe5, 0x17, 0x5a, 0xa5, 0x5b, 0xd3, 0xd2, 0x89, 0x52, 0xfb, 0xf0,
+	0x34, 0x2b, 0x1d, 0x9d, 0x66, 0xa5, 0x9f, 0xa7, 0x59, 0x69, 0xff, 0x2c, 0x1b, 0x3b, 0x3a, 0xcb,
+	0xc6, 0xbe, 0x9d, 0x65, 0x63, 0x6f, 0x9e, 0xd8, 0x44, 0x0c, 0x46, 0x3d, 0xc5, 0x64, 0x54, 0x35,
+	0xbd, 0x89, 0x2b, 0x58, 0x91, 0x79, 0x76, 0x31, 0x58, 0x54, 0x35, 0x78, 0x16, 0x83, 0x9b, 0x75,
+	0x3c, 0x77, 0xb7, 0x8a, 0x89, 0x8b, 0x79, 0x6f, 0x29, 0xd8, 0xa6, 0x47, 0xbf, 0x07, 0x00, 0x8c,
+	0x4b, 0x7c, 0x3b, 0x7f, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +339,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintDistributions) > 0 {
+		for iNdEx := len(m.MintDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.InflationSchedule) > 0 {
+		for iNdEx := len(m.InflationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DecayStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecayStartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.DecayMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.DecayRate.Size()
 		i -= size
@@ -162,6 +412,97 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -189,6 +530,53 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.DecayRate.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DecayMode != 0 {
+		n += 1 + sovParams(uint64(m.DecayMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DecayStartTime)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.InflationSchedule) > 0 {
+		for _, e := range m.InflationSchedule {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintDistributions) > 0 {
+		for _, e := range m.MintDistributions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *MintDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -327,6 +715,393 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayMode", wireType)
+			}
+			m.DecayMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayMode |= DecayMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DecayStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = append(m.InflationSchedule, InflationStep{})
+			if err := m.InflationSchedule[len(m.InflationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDistributions = append(m.MintDistributions, MintDistribution{})
+			if err := m.MintDistributions[len(m.MintDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
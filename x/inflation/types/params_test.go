@@ -2,10 +2,14 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
 func TestNewParams_Validate(t *testing.T) {
@@ -277,3 +281,208 @@ func TestParams_ValidateDecayRate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateDecayMode(t *testing.T) {
+	start := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	step := func(effectiveTime time.Time, inflationMax, inflationMin string) InflationStep {
+		return NewInflationStep(effectiveTime, sdkmath.LegacyMustNewDecFromStr(inflationMax), sdkmath.LegacyMustNewDecFromStr(inflationMin))
+	}
+
+	tests := []struct {
+		name        string
+		malleate    func(params *Params)
+		errContains string
+	}{
+		{
+			name:     "continuous blocks",
+			malleate: func(params *Params) {},
+		},
+		{
+			name: "continuous time",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_CONTINUOUS_TIME
+				params.DecayStartTime = start
+			},
+		},
+		{
+			name: "continuous time without decay start time",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_CONTINUOUS_TIME
+			},
+			errContains: "decay start time must be set",
+		},
+		{
+			name: "continuous time without decay start time nor decay",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_CONTINUOUS_TIME
+				params.DecayRate = sdkmath.LegacyZeroDec()
+			},
+		},
+		{
+			name: "step schedule",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_STEP_SCHEDULE
+				params.InflationSchedule = []InflationStep{
+					step(start, "0.1", "0.05"),
+					step(start.AddDate(4, 0, 0), "0.05", "0.025"),
+				}
+			},
+		},
+		{
+			name: "step schedule without steps",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_STEP_SCHEDULE
+			},
+			errContains: "inflation schedule cannot be empty",
+		},
+		{
+			name: "steps not strictly increasing",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_STEP_SCHEDULE
+				params.InflationSchedule = []InflationStep{step(start, "0.1", "0.05"), step(start, "0.05", "0.025")}
+			},
+			errContains: "strictly increasing",
+		},
+		{
+			name: "step without effective time",
+			malleate: func(params *Params) {
+				params.InflationSchedule = []InflationStep{step(time.Time{}, "0.1", "0.05")}
+			},
+			errContains: "effective time must be set",
+		},
+		{
+			name: "step inflation min greater than max",
+			malleate: func(params *Params) {
+				params.InflationSchedule = []InflationStep{step(start, "0.05", "0.1")}
+			},
+			errContains: "is greater than inflation max",
+		},
+		{
+			name: "step inflation max greater than one",
+			malleate: func(params *Params) {
+				params.InflationSchedule = []InflationStep{step(start, "1.1", "0.1")}
+			},
+			errContains: "inflation max too large",
+		},
+		{
+			name: "unspecified decay mode is the continuous block decay",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode_DECAY_MODE_UNSPECIFIED
+			},
+		},
+		{
+			name: "unknown decay mode",
+			malleate: func(params *Params) {
+				params.DecayMode = DecayMode(99)
+			},
+			errContains: "invalid decay mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DecayRate = sdkmath.LegacyNewDecWithPrec(68, 3)
+			tt.malleate(&params)
+
+			err := params.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_ValidateMintDistributions(t *testing.T) {
+	tests := []struct {
+		name          string
+		distributions []MintDistribution
+		errContains   string
+	}{
+		{
+			name: "valid",
+			distributions: []MintDistribution{
+				NewMintDistribution("rewards_pool", sdkmath.LegacyNewDecWithPrec(25, 2)),
+				NewMintDistribution("distribution", sdkmath.LegacyNewDecWithPrec(75, 2)),
+			},
+		},
+		{
+			name:          "empty module name",
+			distributions: []MintDistribution{NewMintDistribution(" ", sdkmath.LegacyNewDecWithPrec(1, 1))},
+			errContains:   "module name cannot be empty",
+		},
+		{
+			name: "duplicate module",
+			distributions: []MintDistribution{
+				NewMintDistribution("rewards_pool", sdkmath.LegacyNewDecWithPrec(1, 1)),
+				NewMintDistribution("rewards_pool", sdkmath.LegacyNewDecWithPrec(1, 1)),
+			},
+			errContains: "duplicate mint distribution module",
+		},
+		{
+			name:          "bonded tokens pool",
+			distributions: []MintDistribution{NewMintDistribution("bonded_tokens_pool", sdkmath.LegacyNewDecWithPrec(1, 1))},
+			errContains:   "cannot be diverted to bonded_tokens_pool",
+		},
+		{
+			name:          "not bonded tokens pool",
+			distributions: []MintDistribution{NewMintDistribution("not_bonded_tokens_pool", sdkmath.LegacyNewDecWithPrec(1, 1))},
+			errContains:   "cannot be diverted to not_bonded_tokens_pool",
+		},
+		{
+			name:          "mint module",
+			distributions: []MintDistribution{NewMintDistribution("mint", sdkmath.LegacyNewDecWithPrec(1, 1))},
+			errContains:   "cannot be diverted to mint",
+		},
+		{
+			name:          "fee collector",
+			distributions: []MintDistribution{NewMintDistribution("fee_collector", sdkmath.LegacyNewDecWithPrec(1, 1))},
+			errContains:   "cannot be diverted to fee_collector",
+		},
+		{
+			name:          "zero share",
+			distributions: []MintDistribution{NewMintDistribution("rewards_pool", sdkmath.LegacyZeroDec())},
+			errContains:   "share must be positive",
+		},
+		{
+			name: "total share greater than one",
+			distributions: []MintDistribution{
+				NewMintDistribution("rewards_pool", sdkmath.LegacyNewDecWithPrec(6, 1)),
+				NewMintDistribution("distribution", sdkmath.LegacyNewDecWithPrec(5, 1)),
+			},
+			errContains: "mint distribution shares too large",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.MintDistributions = tt.distributions
+
+			err := params.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestParams_ValidateWithoutDecayMode tests that params leaving out the decay mode,
+// as in genesis files predating decay modes, are valid.
+func TestParams_ValidateWithoutDecayMode(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz := []byte(`{
+		"max_supply": "10000000000000000000",
+		"burned_addresses": [],
+		"decay_rate": "0.068000000000000000"
+	}`)
+
+	var params Params
+	require.NoError(t, cdc.UnmarshalJSON(bz, &params))
+	require.Equal(t, DecayMode_DECAY_MODE_UNSPECIFIED, params.DecayMode)
+	require.NoError(t, params.Validate())
+}
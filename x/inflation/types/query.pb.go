@@ -5,24 +5,30 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,9 +119,360 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryCurrentInflationRequest is the request type for the Query/CurrentInflation RPC method.
+type QueryCurrentInflationRequest struct {
+}
+
+func (m *QueryCurrentInflationRequest) Reset()         { *m = QueryCurrentInflationRequest{} }
+func (m *QueryCurrentInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentInflationRequest) ProtoMessage()    {}
+func (*QueryCurrentInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{2}
+}
+func (m *QueryCurrentInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentInflationRequest.Merge(m, src)
+}
+func (m *QueryCurrentInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentInflationRequest proto.InternalMessageInfo
+
+// QueryCurrentInflationResponse is the response type for the Query/CurrentInflation RPC method.
+type QueryCurrentInflationResponse struct {
+	// inflation is the annual inflation rate with the decay applied
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// base_inflation is the annual inflation rate calculated by the mint module,
+	// before the decay is applied
+	BaseInflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_inflation,json=baseInflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_inflation"`
+	// decay_factor is the factor applied to the base inflation rate, one when
+	// inflation doesn't decay
+	DecayFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=decay_factor,json=decayFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_factor"`
+	// months_elapsed is the number of months, as a decimal, elapsed since the decay
+	// epoch start, or since the decay start time in the continuous time decay mode
+	MonthsElapsed cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=months_elapsed,json=monthsElapsed,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"months_elapsed"`
+	// decay_mode is the decay mode of the params
+	DecayMode DecayMode `protobuf:"varint,5,opt,name=decay_mode,json=decayMode,proto3,enum=chainmain.inflation.v1.DecayMode" json:"decay_mode,omitempty"`
+}
+
+func (m *QueryCurrentInflationResponse) Reset()         { *m = QueryCurrentInflationResponse{} }
+func (m *QueryCurrentInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCurrentInflationResponse) ProtoMessage()    {}
+func (*QueryCurrentInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{3}
+}
+func (m *QueryCurrentInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCurrentInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCurrentInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCurrentInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCurrentInflationResponse.Merge(m, src)
+}
+func (m *QueryCurrentInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCurrentInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCurrentInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCurrentInflationResponse proto.InternalMessageInfo
+
+func (m *QueryCurrentInflationResponse) GetDecayMode() DecayMode {
+	if m != nil {
+		return m.DecayMode
+	}
+	return DecayMode_DECAY_MODE_UNSPECIFIED
+}
+
+// QueryDecayEpochStartRequest is the request type for the Query/DecayEpochStart RPC method.
+type QueryDecayEpochStartRequest struct {
+}
+
+func (m *QueryDecayEpochStartRequest) Reset()         { *m = QueryDecayEpochStartRequest{} }
+func (m *QueryDecayEpochStartRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDecayEpochStartRequest) ProtoMessage()    {}
+func (*QueryDecayEpochStartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{4}
+}
+func (m *QueryDecayEpochStartRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecayEpochStartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecayEpochStartRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecayEpochStartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecayEpochStartRequest.Merge(m, src)
+}
+func (m *QueryDecayEpochStartRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecayEpochStartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecayEpochStartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecayEpochStartRequest proto.InternalMessageInfo
+
+// QueryDecayEpochStartResponse is the response type for the Query/DecayEpochStart RPC method.
+type QueryDecayEpochStartResponse struct {
+	// height is the block height from which inflation decays
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryDecayEpochStartResponse) Reset()         { *m = QueryDecayEpochStartResponse{} }
+func (m *QueryDecayEpochStartResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDecayEpochStartResponse) ProtoMessage()    {}
+func (*QueryDecayEpochStartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{5}
+}
+func (m *QueryDecayEpochStartResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDecayEpochStartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDecayEpochStartResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDecayEpochStartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDecayEpochStartResponse.Merge(m, src)
+}
+func (m *QueryDecayEpochStartResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDecayEpochStartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDecayEpochStartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDecayEpochStartResponse proto.InternalMessageInfo
+
+func (m *QueryDecayEpochStartResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryInflationProjectionRequest is the request type for the Query/InflationProjection RPC method.
+type QueryInflationProjectionRequest struct {
+	// months is the number of months to project, at most 600
+	Months uint32 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+}
+
+func (m *QueryInflationProjectionRequest) Reset()         { *m = QueryInflationProjectionRequest{} }
+func (m *QueryInflationProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionRequest) ProtoMessage()    {}
+func (*QueryInflationProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{6}
+}
+func (m *QueryInflationProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionRequest.Merge(m, src)
+}
+func (m *QueryInflationProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionRequest) GetMonths() uint32 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+// QueryInflationProjectionResponse is the response type for the Query/InflationProjection RPC method.
+type QueryInflationProjectionResponse struct {
+	// bonded_ratio is the bonded ratio assumed by the projection
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+	// projections are the projections at the end of each month
+	Projections []InflationProjection `protobuf:"bytes,2,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryInflationProjectionResponse) Reset()         { *m = QueryInflationProjectionResponse{} }
+func (m *QueryInflationProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionResponse) ProtoMessage()    {}
+func (*QueryInflationProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{7}
+}
+func (m *QueryInflationProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionResponse.Merge(m, src)
+}
+func (m *QueryInflationProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionResponse) GetProjections() []InflationProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// InflationProjection is the projected inflation and supply at the end of a month.
+type InflationProjection struct {
+	// month is the number of months from the current block
+	Month uint32 `protobuf:"varint,1,opt,name=month,proto3" json:"month,omitempty"`
+	// height is the projected block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the projected block time
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// inflation is the projected annual inflation rate with the decay applied
+	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
+	// annual_provisions is the projected annual provisions of the month
+	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// total_supply is the projected total supply of the bond denom
+	TotalSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=total_supply,json=totalSupply,proto3,customtype=cosmossdk.io/math.Int" json:"total_supply"`
+	// supply_net_of_burned is the projected supply net of the burned addresses
+	// balances, which is capped by the max supply
+	SupplyNetOfBurned cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=supply_net_of_burned,json=supplyNetOfBurned,proto3,customtype=cosmossdk.io/math.Int" json:"supply_net_of_burned"`
+}
+
+func (m *InflationProjection) Reset()         { *m = InflationProjection{} }
+func (m *InflationProjection) String() string { return proto.CompactTextString(m) }
+func (*InflationProjection) ProtoMessage()    {}
+func (*InflationProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdb7e30239d3abce, []int{8}
+}
+func (m *InflationProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationProjection.Merge(m, src)
+}
+func (m *InflationProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationProjection proto.InternalMessageInfo
+
+func (m *InflationProjection) GetMonth() uint32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *InflationProjection) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InflationProjection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "chainmain.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "chainmain.inflation.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCurrentInflationRequest)(nil), "chainmain.inflation.v1.QueryCurrentInflationRequest")
+	proto.RegisterType((*QueryCurrentInflationResponse)(nil), "chainmain.inflation.v1.QueryCurrentInflationResponse")
+	proto.RegisterType((*QueryDecayEpochStartRequest)(nil), "chainmain.inflation.v1.QueryDecayEpochStartRequest")
+	proto.RegisterType((*QueryDecayEpochStartResponse)(nil), "chainmain.inflation.v1.QueryDecayEpochStartResponse")
+	proto.RegisterType((*QueryInflationProjectionRequest)(nil), "chainmain.inflation.v1.QueryInflationProjectionRequest")
+	proto.RegisterType((*QueryInflationProjectionResponse)(nil), "chainmain.inflation.v1.QueryInflationProjectionResponse")
+	proto.RegisterType((*InflationProjection)(nil), "chainmain.inflation.v1.InflationProjection")
 }
 
 func init() {
@@ -123,27 +480,63 @@ func init() {
 }
 
 var fileDescriptor_bdb7e30239d3abce = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0x4d, 0xcc, 0xcc, 0xd3, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x83, 0xab, 0xd1, 0x83, 0xab, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
-	0x07, 0x93, 0x10, 0xa5, 0x52, 0xca, 0x38, 0x8c, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x2a,
-	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x4c, 0x7a, 0x7e, 0x7e,
-	0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x09, 0x58, 0x33, 0x54,
-	0x8f, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0x49, 0x01, 0x60, 0x83, 0x82, 0x52, 0x0b, 0x4b, 0x53,
-	0x8b, 0x4b, 0x94, 0x22, 0xb8, 0x84, 0x51, 0x44, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x1c,
-	0xb9, 0xd8, 0x20, 0x16, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xe9, 0x61, 0xf7, 0x81,
-	0x1e, 0x44, 0x9f, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82,
-	0x6a, 0x34, 0x9a, 0xcc, 0xc8, 0xc5, 0x0a, 0x36, 0x5a, 0xa8, 0x93, 0x91, 0x8b, 0x0d, 0xa2, 0x4e,
-	0x48, 0x0b, 0x97, 0x39, 0x98, 0x4e, 0x93, 0xd2, 0x26, 0x4a, 0x2d, 0xc4, 0xc1, 0x4a, 0x6a, 0x4d,
-	0x97, 0x9f, 0x4c, 0x66, 0x52, 0x10, 0x92, 0xd3, 0xc7, 0x1b, 0x7e, 0x4e, 0x41, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x9f, 0x5c, 0x54, 0x59, 0x50, 0x92, 0xaf, 0x9b, 0x5f, 0x94, 0xae, 0x0b, 0x36,
-	0x0e, 0x62, 0xa8, 0x2e, 0xd8, 0xd4, 0x0a, 0x24, 0x73, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x01, 0x6c, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xef, 0xab, 0x3a, 0x3b, 0x0a, 0x02, 0x00,
-	0x00,
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xc7, 0xe3, 0xe6, 0x05, 0x65, 0xd2, 0x2e, 0xdb, 0xd9, 0xb0, 0x0a, 0xd9, 0xae, 0x13, 0x8c,
+	0x84, 0xca, 0x56, 0xb1, 0x69, 0xf6, 0x95, 0xc3, 0x4a, 0x10, 0xba, 0x48, 0x95, 0x78, 0x29, 0xe9,
+	0x1e, 0x76, 0x91, 0x90, 0x35, 0xb1, 0x27, 0x8e, 0x21, 0x9e, 0xf1, 0x7a, 0xc6, 0x15, 0x11, 0xe2,
+	0xc2, 0x8d, 0xdb, 0x4a, 0x7c, 0x09, 0x6e, 0x20, 0xc4, 0x47, 0x40, 0x62, 0x8f, 0x15, 0x1c, 0x40,
+	0x1c, 0x16, 0xd4, 0x22, 0xf1, 0x09, 0xb8, 0x23, 0xcf, 0x8c, 0x9d, 0x6c, 0x1b, 0x47, 0x2d, 0xd9,
+	0x4b, 0x94, 0xc7, 0x7e, 0x9e, 0xdf, 0xf3, 0x9f, 0x67, 0x66, 0xfe, 0x06, 0x86, 0x33, 0x42, 0x3e,
+	0x09, 0x90, 0x4f, 0x2c, 0x9f, 0x0c, 0xc7, 0x88, 0xfb, 0x94, 0x58, 0x07, 0xdb, 0xd6, 0xa3, 0x18,
+	0x47, 0x13, 0x33, 0x8c, 0x28, 0xa7, 0xf0, 0x72, 0x96, 0x63, 0x66, 0x39, 0xe6, 0xc1, 0x76, 0x73,
+	0x1d, 0x05, 0x3e, 0xa1, 0x96, 0xf8, 0x95, 0xa9, 0xcd, 0x57, 0x73, 0x70, 0x21, 0x8a, 0x50, 0xc0,
+	0x54, 0xd2, 0xcb, 0x0e, 0x65, 0x01, 0x65, 0xb6, 0x88, 0x2c, 0x19, 0xa8, 0x57, 0x75, 0x8f, 0x7a,
+	0x54, 0x3e, 0x4f, 0xfe, 0xa9, 0xa7, 0x1b, 0x1e, 0xa5, 0xde, 0x18, 0x5b, 0x28, 0xf4, 0x2d, 0x44,
+	0x08, 0xe5, 0x82, 0x9b, 0xd6, 0xb4, 0xd4, 0x5b, 0x11, 0x0d, 0xe2, 0xa1, 0xc5, 0xfd, 0x00, 0x33,
+	0x8e, 0x82, 0x50, 0x26, 0x18, 0x75, 0x00, 0x3f, 0x4a, 0x96, 0xb3, 0x27, 0x44, 0xf4, 0xf1, 0xa3,
+	0x18, 0x33, 0x6e, 0x3c, 0x00, 0x97, 0x9e, 0x79, 0xca, 0x42, 0x4a, 0x18, 0x86, 0x6f, 0x83, 0x8a,
+	0x14, 0xdb, 0xd0, 0xda, 0xda, 0x66, 0xad, 0xab, 0x9b, 0xf3, 0x57, 0x6f, 0xca, 0xba, 0x5e, 0xf5,
+	0xc9, 0xd3, 0x56, 0xe1, 0xdb, 0x7f, 0xbe, 0xbf, 0xa6, 0xf5, 0x55, 0xa1, 0xa1, 0x83, 0x0d, 0x41,
+	0x7e, 0x27, 0x8e, 0x22, 0x4c, 0xf8, 0x6e, 0x5a, 0x95, 0x76, 0x3e, 0x2c, 0x82, 0xab, 0x39, 0x09,
+	0x4a, 0xc4, 0x7d, 0x50, 0xcd, 0x7a, 0x09, 0x1d, 0xd5, 0xde, 0xad, 0xa4, 0xcf, 0x1f, 0x4f, 0x5b,
+	0x57, 0xe4, 0xbc, 0x98, 0xfb, 0x99, 0xe9, 0x53, 0x2b, 0x40, 0x7c, 0x64, 0xbe, 0x87, 0x3d, 0xe4,
+	0x4c, 0x76, 0xb0, 0xf3, 0xcb, 0x8f, 0x1d, 0xa0, 0xc6, 0xb9, 0x83, 0x1d, 0x29, 0x6a, 0x0a, 0x82,
+	0x9f, 0x80, 0x0b, 0x03, 0xc4, 0xb0, 0x3d, 0x45, 0xaf, 0x2c, 0x85, 0x5e, 0x4b, 0x68, 0x99, 0x78,
+	0xf8, 0x10, 0xac, 0xba, 0xd8, 0x41, 0x13, 0x7b, 0x88, 0x1c, 0x4e, 0xa3, 0x46, 0x71, 0x29, 0x78,
+	0x4d, 0xb0, 0xde, 0x15, 0xa8, 0x44, 0x79, 0x40, 0x09, 0x1f, 0x31, 0x1b, 0x8f, 0x51, 0xc8, 0xb0,
+	0xdb, 0x28, 0x2d, 0xa7, 0x5c, 0xd2, 0xee, 0x49, 0x18, 0x7c, 0x0b, 0x00, 0xa9, 0x3c, 0xa0, 0x2e,
+	0x6e, 0x94, 0xdb, 0xda, 0xe6, 0x85, 0xee, 0x2b, 0x79, 0xfb, 0xbe, 0x93, 0x64, 0xbe, 0x4f, 0x5d,
+	0xdc, 0xaf, 0xba, 0xe9, 0x5f, 0xe3, 0x2a, 0xb8, 0x22, 0x76, 0x54, 0xbc, 0xbc, 0x17, 0x52, 0x67,
+	0xb4, 0xcf, 0x51, 0xc4, 0xd3, 0x1d, 0xbf, 0x05, 0x36, 0xe6, 0xbf, 0x56, 0xfb, 0x7d, 0x19, 0x54,
+	0x46, 0xd8, 0xf7, 0x46, 0x5c, 0x6c, 0x76, 0xa9, 0xaf, 0x22, 0xe3, 0x4d, 0xd0, 0x12, 0x75, 0xd9,
+	0x90, 0xf7, 0x22, 0xfa, 0x29, 0x76, 0x66, 0x0e, 0x53, 0x52, 0x2a, 0x17, 0x23, 0x4a, 0xd7, 0xfa,
+	0x2a, 0x32, 0x7e, 0xd3, 0x40, 0x3b, 0xbf, 0x56, 0xf5, 0x7d, 0x08, 0x56, 0x07, 0x94, 0xb8, 0xd8,
+	0xb5, 0xa3, 0x24, 0x65, 0xc9, 0xa3, 0x56, 0x93, 0xac, 0x7e, 0x82, 0x82, 0x0f, 0x40, 0x2d, 0xcc,
+	0x1a, 0xb2, 0xc6, 0x4a, 0xbb, 0xb8, 0x59, 0xeb, 0x6e, 0xe5, 0x0d, 0x75, 0x8e, 0xc8, 0xd9, 0x9b,
+	0x35, 0x8b, 0x32, 0xfe, 0x2d, 0x82, 0x4b, 0x73, 0xf2, 0x61, 0x1d, 0x94, 0xc5, 0xda, 0xd5, 0x20,
+	0x64, 0x30, 0x33, 0xda, 0xe4, 0xb0, 0x17, 0xd3, 0xd1, 0xc2, 0xbb, 0xa0, 0x94, 0xf8, 0x84, 0x38,
+	0xa5, 0xb5, 0x6e, 0xd3, 0x94, 0x26, 0x62, 0xa6, 0x26, 0x62, 0xde, 0x4f, 0x4d, 0xa4, 0xb7, 0x96,
+	0xe8, 0x78, 0xfc, 0x67, 0x4b, 0x93, 0x5a, 0x44, 0xd9, 0xb3, 0x37, 0xb4, 0xf4, 0xbc, 0x6e, 0xa8,
+	0x03, 0xd6, 0x11, 0x21, 0x31, 0x1a, 0x27, 0xde, 0x78, 0xe0, 0x33, 0x31, 0xba, 0xf2, 0x52, 0xf4,
+	0x8b, 0x12, 0xb8, 0x97, 0xf1, 0xe0, 0x3e, 0x58, 0xe5, 0x94, 0xa3, 0xb1, 0xcd, 0xe2, 0x30, 0x1c,
+	0x4f, 0x1a, 0x15, 0xc1, 0x7f, 0x43, 0xf1, 0x5f, 0x3a, 0xcd, 0xdf, 0x25, 0x7c, 0x86, 0xbc, 0x4b,
+	0xb8, 0xda, 0x14, 0x41, 0xd9, 0x17, 0x10, 0x88, 0x40, 0x5d, 0xe2, 0x6c, 0x82, 0xb9, 0x4d, 0x87,
+	0xf6, 0x20, 0x8e, 0x08, 0x76, 0x1b, 0x2f, 0xfc, 0x4f, 0xf8, 0xba, 0xa4, 0x7d, 0x80, 0xf9, 0x87,
+	0xc3, 0x9e, 0x40, 0x75, 0x7f, 0x2a, 0x83, 0xb2, 0x38, 0xd1, 0xf0, 0x6b, 0x0d, 0x54, 0xa4, 0xfd,
+	0xc2, 0x6b, 0x79, 0x27, 0xea, 0xb4, 0xe3, 0x37, 0xb7, 0xce, 0x94, 0x2b, 0xaf, 0x86, 0xf1, 0xda,
+	0x57, 0xbf, 0xfe, 0xfd, 0xcd, 0x4a, 0x1b, 0xea, 0xd6, 0xc2, 0x4f, 0x1a, 0xfc, 0x41, 0x03, 0x17,
+	0x4f, 0xfa, 0x38, 0xbc, 0xb1, 0xb0, 0x53, 0xce, 0x77, 0xa1, 0x79, 0xf3, 0x9c, 0x55, 0x4a, 0xe9,
+	0xb6, 0x50, 0xba, 0x05, 0x5f, 0xcf, 0x53, 0xea, 0xc8, 0xca, 0xa9, 0xef, 0xc3, 0xef, 0x34, 0xf0,
+	0xe2, 0x09, 0x2f, 0x82, 0xd7, 0x17, 0x76, 0x9f, 0x6f, 0x6c, 0xcd, 0x1b, 0xe7, 0x2b, 0x3a, 0xab,
+	0x62, 0xe9, 0xc6, 0x38, 0xa9, 0xb4, 0x99, 0x50, 0xf7, 0xb3, 0x36, 0xff, 0xd2, 0xdf, 0x5e, 0x28,
+	0x20, 0xdf, 0x37, 0x9b, 0x77, 0xce, 0x5f, 0xa8, 0xd4, 0xdf, 0x15, 0xea, 0x6f, 0xc3, 0x9b, 0x79,
+	0xea, 0xb3, 0xc0, 0x9e, 0xda, 0x96, 0xf5, 0x85, 0xf4, 0xe5, 0x2f, 0x7b, 0xfd, 0x27, 0x47, 0xba,
+	0x76, 0x78, 0xa4, 0x6b, 0x7f, 0x1d, 0xe9, 0xda, 0xe3, 0x63, 0xbd, 0x70, 0x78, 0xac, 0x17, 0x7e,
+	0x3f, 0xd6, 0x0b, 0x1f, 0xdf, 0xf1, 0x7c, 0x3e, 0x8a, 0x07, 0xa6, 0x43, 0x03, 0xcb, 0x89, 0x26,
+	0x21, 0xa7, 0x1d, 0x1a, 0x79, 0x1d, 0xd1, 0x45, 0xf6, 0xea, 0x88, 0x66, 0x9f, 0xcf, 0xb4, 0xe3,
+	0x93, 0x10, 0xb3, 0x41, 0x45, 0xd8, 0xd6, 0xf5, 0xff, 0x06, 0x00, 0x9f, 0xf7, 0x14, 0x8e, 0xce,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -160,6 +553,15 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params returns the inflation module parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CurrentInflation returns the inflation rate of the current block, with the
+	// decay applied.
+	CurrentInflation(ctx context.Context, in *QueryCurrentInflationRequest, opts ...grpc.CallOption) (*QueryCurrentInflationResponse, error)
+	// DecayEpochStart returns the block height from which inflation decays in the
+	// continuous block decay mode.
+	DecayEpochStart(ctx context.Context, in *QueryDecayEpochStartRequest, opts ...grpc.CallOption) (*QueryDecayEpochStartResponse, error)
+	// InflationProjection returns a projection of the inflation and supply for the
+	// next months, under the current bonded ratio and max supply.
+	InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -179,10 +581,46 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) CurrentInflation(ctx context.Context, in *QueryCurrentInflationRequest, opts ...grpc.CallOption) (*QueryCurrentInflationResponse, error) {
+	out := new(QueryCurrentInflationResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.inflation.v1.Query/CurrentInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DecayEpochStart(ctx context.Context, in *QueryDecayEpochStartRequest, opts ...grpc.CallOption) (*QueryDecayEpochStartResponse, error) {
+	out := new(QueryDecayEpochStartResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.inflation.v1.Query/DecayEpochStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error) {
+	out := new(QueryInflationProjectionResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.inflation.v1.Query/InflationProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the inflation module parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CurrentInflation returns the inflation rate of the current block, with the
+	// decay applied.
+	CurrentInflation(context.Context, *QueryCurrentInflationRequest) (*QueryCurrentInflationResponse, error)
+	// DecayEpochStart returns the block height from which inflation decays in the
+	// continuous block decay mode.
+	DecayEpochStart(context.Context, *QueryDecayEpochStartRequest) (*QueryDecayEpochStartResponse, error)
+	// InflationProjection returns a projection of the inflation and supply for the
+	// next months, under the current bonded ratio and max supply.
+	InflationProjection(context.Context, *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -192,6 +630,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) CurrentInflation(ctx context.Context, req *QueryCurrentInflationRequest) (*QueryCurrentInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentInflation not implemented")
+}
+func (*UnimplementedQueryServer) DecayEpochStart(ctx context.Context, req *QueryDecayEpochStartRequest) (*QueryDecayEpochStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecayEpochStart not implemented")
+}
+func (*UnimplementedQueryServer) InflationProjection(ctx context.Context, req *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -215,6 +662,61 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CurrentInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.inflation.v1.Query/CurrentInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentInflation(ctx, req.(*QueryCurrentInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DecayEpochStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDecayEpochStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DecayEpochStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.inflation.v1.Query/DecayEpochStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DecayEpochStart(ctx, req.(*QueryDecayEpochStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.inflation.v1.Query/InflationProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjection(ctx, req.(*QueryInflationProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chainmain.inflation.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -223,6 +725,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "CurrentInflation",
+			Handler:    _Query_CurrentInflation_Handler,
+		},
+		{
+			MethodName: "DecayEpochStart",
+			Handler:    _Query_DecayEpochStart_Handler,
+		},
+		{
+			MethodName: "InflationProjection",
+			Handler:    _Query_InflationProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chainmain/inflation/v1/query.proto",
@@ -284,44 +798,1022 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryCurrentInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCurrentInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCurrentInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCurrentInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DecayMode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DecayMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MonthsElapsed.Size()
+		i -= size
+		if _, err := m.MonthsElapsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseInflation.Size()
+		i -= size
+		if _, err := m.BaseInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecayEpochStartRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecayEpochStartRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecayEpochStartRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDecayEpochStartResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDecayEpochStartResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDecayEpochStartResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Months != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Months))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SupplyNetOfBurned.Size()
+		i -= size
+		if _, err := m.SupplyNetOfBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Month != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Month))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseInflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MonthsElapsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DecayMode != 0 {
+		n += 1 + sovQuery(uint64(m.DecayMode))
+	}
+	return n
+}
+
+func (m *QueryDecayEpochStartRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDecayEpochStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Months != 0 {
+		n += 1 + sovQuery(uint64(m.Months))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Month != 0 {
+		n += 1 + sovQuery(uint64(m.Month))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SupplyNetOfBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsElapsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MonthsElapsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayMode", wireType)
+			}
+			m.DecayMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayMode |= DecayMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecayEpochStartRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecayEpochStartRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecayEpochStartRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDecayEpochStartResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDecayEpochStartResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDecayEpochStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,12 +1836,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, InflationProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -371,7 +1931,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *InflationProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,15 +1954,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: InflationProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InflationProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Month", wireType)
+			}
+			m.Month = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Month |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +2027,143 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyNetOfBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyNetOfBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
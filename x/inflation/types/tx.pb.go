@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,38 +128,240 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgBurn is the Msg/Burn request type.
+type MsgBurn struct {
+	// from_address is the address of the owner of the coins to burn
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	// amount is the coins to burn
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgBurn) Reset()         { *m = MsgBurn{} }
+func (m *MsgBurn) String() string { return proto.CompactTextString(m) }
+func (*MsgBurn) ProtoMessage()    {}
+func (*MsgBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7cf7f2834874f66, []int{2}
+}
+func (m *MsgBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurn.Merge(m, src)
+}
+func (m *MsgBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurn proto.InternalMessageInfo
+
+func (m *MsgBurn) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgBurn) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgBurnResponse defines the response structure for executing a MsgBurn message.
+type MsgBurnResponse struct {
+}
+
+func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
+func (m *MsgBurnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBurnResponse) ProtoMessage()    {}
+func (*MsgBurnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7cf7f2834874f66, []int{3}
+}
+func (m *MsgBurnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBurnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBurnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBurnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBurnResponse.Merge(m, src)
+}
+func (m *MsgBurnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBurnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBurnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
+
+// MsgSweepBurnedAddresses is the Msg/SweepBurnedAddresses request type.
+type MsgSweepBurnedAddresses struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSweepBurnedAddresses) Reset()         { *m = MsgSweepBurnedAddresses{} }
+func (m *MsgSweepBurnedAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepBurnedAddresses) ProtoMessage()    {}
+func (*MsgSweepBurnedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7cf7f2834874f66, []int{4}
+}
+func (m *MsgSweepBurnedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepBurnedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepBurnedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepBurnedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepBurnedAddresses.Merge(m, src)
+}
+func (m *MsgSweepBurnedAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepBurnedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepBurnedAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepBurnedAddresses proto.InternalMessageInfo
+
+func (m *MsgSweepBurnedAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSweepBurnedAddressesResponse defines the response structure for executing a
+// MsgSweepBurnedAddresses message.
+type MsgSweepBurnedAddressesResponse struct {
+	// burned is the coins swept from the burned addresses and burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+}
+
+func (m *MsgSweepBurnedAddressesResponse) Reset()         { *m = MsgSweepBurnedAddressesResponse{} }
+func (m *MsgSweepBurnedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepBurnedAddressesResponse) ProtoMessage()    {}
+func (*MsgSweepBurnedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7cf7f2834874f66, []int{5}
+}
+func (m *MsgSweepBurnedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepBurnedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepBurnedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepBurnedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepBurnedAddressesResponse.Merge(m, src)
+}
+func (m *MsgSweepBurnedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepBurnedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepBurnedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepBurnedAddressesResponse proto.InternalMessageInfo
+
+func (m *MsgSweepBurnedAddressesResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "chainmain.inflation.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "chainmain.inflation.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgBurn)(nil), "chainmain.inflation.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "chainmain.inflation.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgSweepBurnedAddresses)(nil), "chainmain.inflation.v1.MsgSweepBurnedAddresses")
+	proto.RegisterType((*MsgSweepBurnedAddressesResponse)(nil), "chainmain.inflation.v1.MsgSweepBurnedAddressesResponse")
 }
 
 func init() { proto.RegisterFile("chainmain/inflation/v1/tx.proto", fileDescriptor_e7cf7f2834874f66) }
 
 var fileDescriptor_e7cf7f2834874f66 = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0x4d, 0xcc, 0xcc, 0xd3, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x2b, 0xd0,
-	0x83, 0x2b, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0xa5, 0x52, 0xca, 0x38, 0xcc, 0x2a, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x86, 0x2a, 0x12, 0x4f, 0xce,
-	0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x2d, 0x4e, 0x07, 0xc9, 0xe5, 0x16, 0xa7, 0x43, 0x25, 0x24,
-	0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x88,
-	0x83, 0x58, 0x10, 0x51, 0xa5, 0x23, 0x8c, 0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89,
-	0x25, 0xa9, 0x01, 0x60, 0x3b, 0x84, 0xcc, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32,
-	0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x1a,
-	0xe7, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x84, 0x50,
-	0x2a, 0xe4, 0xc8, 0xc5, 0x06, 0x71, 0xa5, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x9c, 0x1e,
-	0x76, 0x6f, 0xeb, 0x41, 0xec, 0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a,
-	0x8c, 0x41, 0x50, 0x8d, 0x56, 0x66, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x8c, 0xec, 0x7a, 0xbe, 0x41,
-	0x0b, 0x6b, 0x80, 0xa0, 0x39, 0x59, 0x49, 0x92, 0x4b, 0x1c, 0x4d, 0x28, 0x28, 0xb5, 0xb8, 0x20,
-	0x3f, 0xaf, 0x38, 0xd5, 0xa8, 0x8c, 0x8b, 0xd9, 0xb7, 0x38, 0x5d, 0x28, 0x83, 0x8b, 0x07, 0xc5,
-	0x93, 0xea, 0xb8, 0x1c, 0x87, 0x66, 0x8e, 0x94, 0x3e, 0x91, 0x0a, 0x61, 0x16, 0x4a, 0xb1, 0x36,
-	0x80, 0xbc, 0xe4, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0x45, 0x95, 0x05, 0x25,
-	0xf9, 0xba, 0xf9, 0x45, 0xe9, 0xba, 0x60, 0x6b, 0xf4, 0xc1, 0xa4, 0x2e, 0xd8, 0xbb, 0x15, 0x48,
-	0x1e, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x47, 0x9a, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0xde, 0x5c, 0xee, 0x71, 0x02, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0xa8, 0x62, 0x18, 0x9a, 0x34, 0xdd, 0x10, 0x0b, 0x6b, 0xb2, 0x10, 0x3c, 0x94, 0x60,
+	0xd8, 0x09, 0x18, 0xab, 0xc1, 0xc4, 0xa4, 0x78, 0x26, 0x69, 0x68, 0xbc, 0x78, 0x69, 0x06, 0x98,
+	0x2e, 0x13, 0xdd, 0x99, 0xcd, 0xce, 0x80, 0xe5, 0xd6, 0x78, 0xd4, 0x8b, 0xfe, 0x17, 0xc6, 0x13,
+	0x07, 0xff, 0x04, 0x0f, 0x4d, 0xbc, 0x34, 0x9e, 0x3c, 0xa9, 0x81, 0x03, 0xff, 0x86, 0x99, 0x1f,
+	0xa5, 0x2d, 0x42, 0xab, 0x26, 0x5e, 0x96, 0x9d, 0xf7, 0xbe, 0xf7, 0xe6, 0x7d, 0xdf, 0xf7, 0x58,
+	0x58, 0xe8, 0xf6, 0x31, 0x65, 0x21, 0xa6, 0x0c, 0x51, 0x76, 0xf8, 0x12, 0x4b, 0xca, 0x19, 0x1a,
+	0xd6, 0x90, 0x3c, 0xf2, 0xa3, 0x98, 0x4b, 0xee, 0xdc, 0x9e, 0x03, 0xfc, 0x39, 0xc0, 0x1f, 0xd6,
+	0xdc, 0x4d, 0x1c, 0x52, 0xc6, 0x91, 0x7e, 0x1a, 0xa8, 0x7b, 0x77, 0x45, 0xaf, 0x08, 0xc7, 0x38,
+	0x14, 0x16, 0xe4, 0x75, 0xb9, 0x08, 0xb9, 0x40, 0x1d, 0x2c, 0x08, 0x1a, 0xd6, 0x3a, 0x44, 0xe2,
+	0x1a, 0xea, 0x72, 0xca, 0x6c, 0x7e, 0xcb, 0xe6, 0x43, 0x11, 0xa8, 0xda, 0x50, 0x04, 0x36, 0x91,
+	0x37, 0x89, 0x03, 0x7d, 0x42, 0xe6, 0x60, 0x53, 0xd9, 0x80, 0x07, 0xdc, 0xc4, 0xd5, 0x9b, 0x89,
+	0x96, 0x3e, 0x03, 0xb8, 0xd1, 0x12, 0xc1, 0xb3, 0xa8, 0x87, 0x25, 0xd9, 0xd3, 0x33, 0x38, 0x3b,
+	0x30, 0x8d, 0x07, 0xb2, 0xcf, 0x63, 0x2a, 0x47, 0x39, 0x50, 0x04, 0xe5, 0x74, 0x33, 0xf7, 0xf5,
+	0x53, 0x35, 0x6b, 0xdb, 0xed, 0xf6, 0x7a, 0x31, 0x11, 0x62, 0x5f, 0xc6, 0x94, 0x05, 0xed, 0x73,
+	0xa8, 0xb3, 0x0b, 0x53, 0x86, 0x45, 0x2e, 0x59, 0x04, 0xe5, 0x4c, 0xdd, 0xf3, 0x97, 0xcb, 0xe2,
+	0x9b, 0x7b, 0x9a, 0xe9, 0x93, 0xef, 0x85, 0xc4, 0x87, 0xd9, 0xb8, 0x02, 0xda, 0xb6, 0xb0, 0xb1,
+	0xf3, 0x7a, 0x36, 0xae, 0x9c, 0xb7, 0x7c, 0x33, 0x1b, 0x57, 0x96, 0x0a, 0xb6, 0x30, 0x72, 0x29,
+	0x0f, 0xb7, 0x16, 0x42, 0x6d, 0x22, 0x22, 0xce, 0x04, 0x29, 0x4d, 0x01, 0xbc, 0xd5, 0x12, 0x41,
+	0x73, 0x10, 0x33, 0xe7, 0x31, 0x5c, 0x3f, 0x8c, 0x79, 0x78, 0x80, 0x0d, 0x85, 0x6b, 0xc9, 0x65,
+	0x14, 0xda, 0x86, 0x9c, 0x3e, 0x4c, 0xe1, 0x90, 0x0f, 0x98, 0xcc, 0x25, 0x8b, 0x6b, 0xe5, 0x4c,
+	0x3d, 0xef, 0xdb, 0x1a, 0xe5, 0x92, 0x6f, 0x5d, 0xf2, 0x9f, 0x72, 0xca, 0x9a, 0x0f, 0x14, 0xb3,
+	0x8f, 0x3f, 0x0a, 0xe5, 0x80, 0xca, 0xfe, 0xa0, 0xe3, 0x77, 0x79, 0x68, 0xcd, 0xb0, 0x3f, 0x55,
+	0xd1, 0x7b, 0x81, 0xe4, 0x28, 0x22, 0x42, 0x17, 0x08, 0xab, 0x82, 0xe9, 0xdf, 0xa8, 0x29, 0x15,
+	0x2e, 0x4d, 0xaa, 0x84, 0xb8, 0xb3, 0x42, 0x08, 0xc5, 0xac, 0xb4, 0x09, 0x37, 0xec, 0xeb, 0x9c,
+	0xf8, 0x7b, 0xa0, 0x45, 0xd9, 0x7f, 0x45, 0x48, 0xa4, 0x12, 0xa4, 0x67, 0x99, 0x90, 0x7f, 0xb6,
+	0xb8, 0xf1, 0xe4, 0x77, 0x7f, 0xee, 0xad, 0x18, 0x6b, 0xd9, 0xbd, 0xa5, 0xb7, 0x00, 0x16, 0x56,
+	0xe4, 0xce, 0xe6, 0x56, 0x3a, 0x77, 0x74, 0x2a, 0x07, 0xfe, 0x97, 0xce, 0xa6, 0x7f, 0xfd, 0x4b,
+	0x12, 0xae, 0xb5, 0x44, 0xe0, 0xf4, 0xe1, 0xfa, 0xa5, 0x3f, 0xc0, 0xf6, 0xaa, 0xc5, 0x5d, 0xd8,
+	0x31, 0x17, 0xfd, 0x21, 0x70, 0xce, 0x6d, 0x0f, 0xde, 0xd0, 0x8b, 0x58, 0xb8, 0xa2, 0x50, 0x01,
+	0xdc, 0xed, 0x6b, 0x00, 0xf3, 0x8e, 0xc7, 0x00, 0x66, 0x97, 0x5a, 0x7c, 0xd5, 0x6c, 0xcb, 0x0a,
+	0xdc, 0x87, 0x7f, 0x59, 0x70, 0x36, 0x82, 0x7b, 0xf3, 0x58, 0xa9, 0xda, 0x6c, 0x9f, 0x4c, 0x3c,
+	0x70, 0x3a, 0xf1, 0xc0, 0xcf, 0x89, 0x07, 0xde, 0x4d, 0xbd, 0xc4, 0xe9, 0xd4, 0x4b, 0x7c, 0x9b,
+	0x7a, 0x89, 0xe7, 0x8f, 0x2e, 0xda, 0x13, 0x8f, 0x22, 0xc9, 0xab, 0x3c, 0x0e, 0xaa, 0xfa, 0x3a,
+	0xa4, 0x9f, 0x55, 0xbd, 0x3f, 0x47, 0x17, 0x36, 0x48, 0x9b, 0xd6, 0x49, 0xe9, 0xaf, 0xd4, 0xfd,
+	0x5f, 0x03, 0x00, 0xaf, 0xd3, 0x8a, 0xce, 0x82, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -175,6 +379,13 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/inflation module
 	// parameters. The authority is hard-coded to the x/inflation module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Burn destroys coins of the sender, through the x/inflation module account, and
+	// adds them to the burned totals.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	// SweepBurnedAddresses defines a governance operation burning the spendable bond
+	// denom balances of the legacy burned addresses of the params, which are then
+	// cleared.
+	SweepBurnedAddresses(ctx context.Context, in *MsgSweepBurnedAddresses, opts ...grpc.CallOption) (*MsgSweepBurnedAddressesResponse, error)
 }
 
 type msgClient struct {
@@ -194,11 +405,36 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.inflation.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepBurnedAddresses(ctx context.Context, in *MsgSweepBurnedAddresses, opts ...grpc.CallOption) (*MsgSweepBurnedAddressesResponse, error) {
+	out := new(MsgSweepBurnedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.inflation.v1.Msg/SweepBurnedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/inflation module
 	// parameters. The authority is hard-coded to the x/inflation module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Burn destroys coins of the sender, through the x/inflation module account, and
+	// adds them to the burned totals.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	// SweepBurnedAddresses defines a governance operation burning the spendable bond
+	// denom balances of the legacy burned addresses of the params, which are then
+	// cleared.
+	SweepBurnedAddresses(context.Context, *MsgSweepBurnedAddresses) (*MsgSweepBurnedAddressesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +444,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) SweepBurnedAddresses(ctx context.Context, req *MsgSweepBurnedAddresses) (*MsgSweepBurnedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepBurnedAddresses not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +473,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.inflation.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepBurnedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepBurnedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepBurnedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.inflation.v1.Msg/SweepBurnedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepBurnedAddresses(ctx, req.(*MsgSweepBurnedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chainmain.inflation.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +518,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "SweepBurnedAddresses",
+			Handler:    _Msg_SweepBurnedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chainmain/inflation/v1/tx.proto",
@@ -307,6 +594,140 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepBurnedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepBurnedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepBurnedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepBurnedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepBurnedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepBurnedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -342,14 +763,70 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepBurnedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepBurnedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -513,6 +990,338 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepBurnedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepBurnedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepBurnedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepBurnedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepBurnedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepBurnedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -20,6 +20,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClassTraces(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryClassHash(),
+		GetCmdParams(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryRateLimit(),
+		GetCmdQueryEscrowedNFTs(),
+		GetCmdQueryTotalEscrow(),
+		GetCmdQueryEscrowConsistency(),
+		GetCmdQueryInFlightTransfersBySender(),
+		GetCmdQueryInFlightTransfersByClass(),
 	)
 
 	return queryCmd
@@ -37,6 +45,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewTransferV2TxCmd(),
+		NewICAPacketDataCmd(),
 	)
 
 	return txCmd
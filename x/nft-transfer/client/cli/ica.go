@@ -0,0 +1,281 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	nftcli "github.com/crypto-org-chain/chain-main/v8/x/nft/client/cli"
+	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagICAMemo  = "ica-memo"
+	flagEncoding = "encoding"
+)
+
+// NewICAPacketDataCmd returns the commands that build interchain accounts packet data
+// executing NFT messages on this chain, to be sent by a controller chain.
+func NewICAPacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-packet-data",
+		Short: "Build interchain accounts packet data for NFT messages",
+		Long: strings.TrimSpace(`Build interchain accounts packet data executing NFT messages with an interchain
+account on this chain. The packet data is printed to stdout, and can be sent by the controller chain, for instance
+with the send-tx command of the interchain accounts controller.`),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		newICAIssueDenomCmd(),
+		newICAMintNFTCmd(),
+		newICATransferCmd(),
+		newICATransferV2Cmd(),
+	)
+
+	return cmd
+}
+
+// newICAIssueDenomCmd returns the command that builds packet data issuing a denom.
+func newICAIssueDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "issue [interchain-account] [denom-id]",
+		Short:   "Build packet data issuing a denom owned by an interchain account",
+		Example: fmt.Sprintf("%s tx nft-transfer ica-packet-data issue cro1... kitties --name=Kitties --uri=<uri>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			denomName, err := cmd.Flags().GetString(nftcli.FlagDenomName)
+			if err != nil {
+				return err
+			}
+			schema, err := cmd.Flags().GetString(nftcli.FlagSchema)
+			if err != nil {
+				return err
+			}
+			uri, err := cmd.Flags().GetString(nftcli.FlagDenomURI)
+			if err != nil {
+				return err
+			}
+			if content, err := os.ReadFile(filepath.Clean(schema)); err == nil {
+				schema = string(content)
+			}
+
+			msg := nfttypes.NewMsgIssueDenom(args[1], denomName, schema, uri, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return printICAPacketData(cmd, msg)
+		},
+	}
+	cmd.Flags().String(nftcli.FlagDenomName, "", "The name of the denom")
+	cmd.Flags().String(nftcli.FlagSchema, "", "Denom data structure definition")
+	cmd.Flags().String(nftcli.FlagDenomURI, "", "URI of the denom")
+	addICAPacketDataFlags(cmd)
+
+	return cmd
+}
+
+// newICAMintNFTCmd returns the command that builds packet data minting an NFT.
+func newICAMintNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint [interchain-account] [denom-id] [token-id]",
+		Short:   "Build packet data minting an NFT of a denom owned by an interchain account",
+		Example: fmt.Sprintf("%s tx nft-transfer ica-packet-data mint cro1... kitties kitty1 --uri=<uri> --recipient=cro1...", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			recipient, err := cmd.Flags().GetString(nftcli.FlagRecipient)
+			if err != nil {
+				return err
+			}
+			if strings.TrimSpace(recipient) == "" {
+				recipient = args[0]
+			}
+			tokenName, err := cmd.Flags().GetString(nftcli.FlagTokenName)
+			if err != nil {
+				return err
+			}
+			tokenURI, err := cmd.Flags().GetString(nftcli.FlagTokenURI)
+			if err != nil {
+				return err
+			}
+			tokenData, err := cmd.Flags().GetString(nftcli.FlagTokenData)
+			if err != nil {
+				return err
+			}
+
+			msg := nfttypes.NewMsgMintNFT(args[2], args[1], tokenName, tokenURI, tokenData, args[0], recipient)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return printICAPacketData(cmd, msg)
+		},
+	}
+	cmd.Flags().String(nftcli.FlagTokenName, "", "The name of the nft")
+	cmd.Flags().String(nftcli.FlagTokenURI, "", "URI for supplemental off-chain tokenData (should return a JSON object)")
+	cmd.Flags().String(nftcli.FlagTokenData, "", "The origin data of the nft")
+	cmd.Flags().String(nftcli.FlagRecipient, "", "Receiver of the nft, if not filled, the default is the interchain account")
+	addICAPacketDataFlags(cmd)
+
+	return cmd
+}
+
+// newICATransferCmd returns the command that builds packet data transferring NFTs
+// through IBC.
+func newICATransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [interchain-account] [src-port] [src-channel] [receiver] [classID] [tokenIDs]",
+		Short: "Build packet data transferring NFTs of an interchain account through IBC",
+		Long: strings.TrimSpace(`Build packet data transferring NFTs of an interchain account through IBC. The
+packet timeout timestamp is in nanoseconds, and is relative to the local clock time unless the "absolute-timeouts"
+flag is set, as the time the packet data is executed on this chain is not known.`),
+		Example: fmt.Sprintf("%s tx nft-transfer ica-packet-data transfer cro1... nft channel-0 cro1... kitties kitty1,kitty2", version.AppName),
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			srcPort := args[1]
+			srcChannel := args[2]
+			tokenIDs := strings.Split(args[5], ",")
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if timeoutTimestamp == 0 {
+				return errors.New("packet timeout timestamp must be non zero")
+			}
+			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			if err != nil {
+				return err
+			}
+			if !absoluteTimeouts {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, args[4], tokenIDs, args[0], args[3], clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return printICAPacketData(cmd, msg)
+		},
+	}
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the NFT packet, such as a forwarding instruction.")
+	addICAPacketDataFlags(cmd)
+
+	return cmd
+}
+
+// newICATransferV2Cmd returns the command that builds packet data transferring NFTs
+// in an IBC v2 packet.
+func newICATransferV2Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-v2 [interchain-account] [src-client] [receiver] [classID] [tokenIDs]",
+		Short: "Build packet data transferring NFTs of an interchain account in an IBC v2 packet",
+		Long: strings.TrimSpace(`Build packet data transferring NFTs of an interchain account in an IBC v2 packet
+sent by the given client. The packet timeout timestamp is in seconds, and is relative to the local clock time unless
+the "absolute-timeouts" flag is set, as the time the packet data is executed on this chain is not known.`),
+		Example: fmt.Sprintf("%s tx nft-transfer ica-packet-data transfer-v2 cro1... 07-tendermint-0 cro1... kitties kitty1,kitty2", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			timeoutTimestamp, err := v2TimeoutTimestamp(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferV2(
+				args[1], args[3], strings.Split(args[4], ","), args[0], args[2], timeoutTimestamp, memo,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return printICAPacketData(cmd, msg)
+		},
+	}
+	addV2TimeoutFlags(cmd)
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the NFT packet, such as a forwarding instruction.")
+	addICAPacketDataFlags(cmd)
+
+	return cmd
+}
+
+// addICAPacketDataFlags adds the flags shared by the commands building interchain
+// accounts packet data.
+func addICAPacketDataFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagICAMemo, "", "Memo of the interchain accounts packet data")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, "Encoding of the messages in the interchain accounts packet data, either proto3 or proto3json")
+}
+
+// printICAPacketData prints the interchain accounts packet data executing messages.
+func printICAPacketData(cmd *cobra.Command, msgs ...sdk.Msg) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	memo, err := cmd.Flags().GetString(flagICAMemo)
+	if err != nil {
+		return err
+	}
+	encoding, err := cmd.Flags().GetString(flagEncoding)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, encoding) {
+		return fmt.Errorf("unsupported encoding type: %s", encoding)
+	}
+
+	protoMsgs := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMsgs[i] = msg
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	data, err := icatypes.SerializeCosmosTx(cdc, protoMsgs, encoding)
+	if err != nil {
+		return err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(&packetData)
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(bz))
+	return nil
+}
@@ -0,0 +1,204 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/client/cli"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	denomID = "kitties"
+	tokenID = "kitty"
+)
+
+// ICATestSuite runs NFT messages built by the interchain accounts packet data
+// commands with an interchain account on chain B, controlled from chain A.
+type ICATestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// controller chain
+	chainA *ibctesting.TestChain
+	// host chain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// interchain accounts path between chain A and chain B
+	icaPath *ibctesting.Path
+	// IBC v2 path between chain B and chain C
+	pathBToC *ibctesting.Path
+
+	owner string
+	ica   string
+}
+
+func (suite *ICATestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCustomAppCoordinator(suite.T(), 3, testutil.SetupTestingApp)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathBToC = ibctesting.NewPath(suite.chainB, suite.chainC)
+	suite.pathBToC.SetupV2()
+
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: ibctesting.FirstConnectionID,
+		HostConnectionId:       ibctesting.FirstConnectionID,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+	suite.icaPath = ibctesting.NewPath(suite.chainA, suite.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{suite.icaPath.EndpointA, suite.icaPath.EndpointB} {
+		endpoint.ChannelConfig.PortID = icatypes.HostPortID
+		endpoint.ChannelConfig.Order = channeltypes.ORDERED
+		endpoint.ChannelConfig.Version = version
+	}
+	suite.icaPath.SetupConnections()
+
+	// register the interchain account of the sender of chain A
+	endpointA := suite.icaPath.EndpointA
+	suite.owner = suite.chainA.SenderAccount.GetAddress().String()
+	portID, err := icatypes.NewControllerPortID(suite.owner)
+	suite.Require().NoError(err)
+	channelSequence := testutil.ChainApp(suite.chainA).IBCKeeper.ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	suite.Require().NoError(testutil.ChainApp(suite.chainA).ICAControllerKeeper.RegisterInterchainAccount(
+		suite.chainA.GetContext(), endpointA.ConnectionID, suite.owner, version, channeltypes.ORDERED,
+	))
+	suite.chainA.NextBlock()
+	endpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	endpointA.ChannelConfig.PortID = portID
+
+	suite.Require().NoError(suite.icaPath.EndpointB.ChanOpenTry())
+	suite.Require().NoError(endpointA.ChanOpenAck())
+	suite.Require().NoError(suite.icaPath.EndpointB.ChanOpenConfirm())
+
+	ica, found := testutil.ChainApp(suite.chainB).ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), suite.icaPath.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.ica = ica
+}
+
+func TestICATestSuite(t *testing.T) {
+	suite.Run(t, new(ICATestSuite))
+}
+
+// packetData builds interchain accounts packet data with the packet data commands
+func (suite *ICATestSuite) packetData(args ...string) icatypes.InterchainAccountPacketData {
+	hostApp := testutil.ChainApp(suite.chainB)
+	clientCtx := client.Context{}.WithCodec(hostApp.AppCodec()).WithInterfaceRegistry(hostApp.InterfaceRegistry())
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewICAPacketDataCmd(), args)
+	suite.Require().NoError(err)
+
+	var packetData icatypes.InterchainAccountPacketData
+	suite.Require().NoError(hostApp.AppCodec().UnmarshalJSON(out.Bytes(), &packetData))
+	return packetData
+}
+
+// executeTx sends the packet data from chain A, relays it to chain B and returns the
+// result of its reception and whether its execution succeeded
+func (suite *ICATestSuite) executeTx(packetData icatypes.InterchainAccountPacketData) (*abci.ExecTxResult, bool) {
+	msg := icacontrollertypes.NewMsgSendTx(suite.owner, suite.icaPath.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), packetData)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	recvRes, ackBz, err := suite.icaPath.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+
+	return recvRes, ack.Success()
+}
+
+func (suite *ICATestSuite) issueAndMint() {
+	_, ok := suite.executeTx(suite.packetData("issue", suite.ica, denomID, "--name=Kitties", "--uri=uri"))
+	suite.Require().True(ok)
+	_, ok = suite.executeTx(suite.packetData("mint", suite.ica, denomID, tokenID, "--uri=kitty_uri"))
+	suite.Require().True(ok)
+}
+
+func (suite *ICATestSuite) TestMintNFT() {
+	suite.issueAndMint()
+
+	ctx := suite.chainB.GetContext()
+	denom, err := testutil.ChainApp(suite.chainB).NFTKeeper.GetDenom(ctx, denomID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ica, denom.Creator)
+	nft, err := testutil.ChainApp(suite.chainB).NFTKeeper.GetNFT(ctx, denomID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ica, nft.GetOwner().String())
+	suite.Require().Equal("kitty_uri", nft.GetURI())
+
+	// messages signed by another account than the interchain account are rejected
+	other := suite.chainB.SenderAccount.GetAddress().String()
+	_, ok := suite.executeTx(suite.packetData("mint", other, denomID, "kitty2"))
+	suite.Require().False(ok)
+	suite.Require().False(testutil.ChainApp(suite.chainB).NFTKeeper.HasNFT(suite.chainB.GetContext(), denomID, "kitty2"))
+}
+
+func (suite *ICATestSuite) TestTransfer() {
+	suite.issueAndMint()
+
+	endpointB, endpointC := suite.pathBToC.EndpointA, suite.pathBToC.EndpointB
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	// the interchain accounts host authenticates the sender as the signer of the transfer
+	signers, _, err := testutil.ChainApp(suite.chainB).AppCodec().GetMsgV1Signers(
+		types.NewMsgTransferV2(endpointB.ClientID, denomID, []string{tokenID}, suite.ica, receiver.String(), 1, ""),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal([][]byte{sdk.MustAccAddressFromBech32(suite.ica)}, signers)
+
+	// the timeout is relative to the block time of the test chains rather than the local clock
+	timeout := fmt.Sprintf("--packet-timeout-timestamp=%d", suite.chainB.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	// messages signed by another account than the interchain account are rejected
+	other := suite.chainB.SenderAccount.GetAddress().String()
+	_, ok := suite.executeTx(suite.packetData("transfer-v2", other, endpointB.ClientID, receiver.String(), denomID, tokenID, timeout, "--absolute-timeouts"))
+	suite.Require().False(ok)
+
+	// IBC v1 transfers require a channel
+	_, ok = suite.executeTx(suite.packetData("transfer", suite.ica, types.PortID, endpointB.ClientID, receiver.String(), denomID, tokenID))
+	suite.Require().False(ok)
+
+	transferKeeperB := testutil.ChainApp(suite.chainB).NFTTransferKeeper
+	recvRes, ok := suite.executeTx(suite.packetData("transfer-v2", suite.ica, endpointB.ClientID, receiver.String(), denomID, tokenID, timeout, "--absolute-timeouts"))
+	suite.Require().True(ok)
+	suite.Require().True(transferKeeperB.HasEscrowedNFT(suite.chainB.GetContext(), types.PortID, endpointB.ClientID, denomID, tokenID))
+
+	res, err := transferKeeperB.InFlightTransfersBySender(suite.chainB.GetContext(), &types.QueryInFlightTransfersBySenderRequest{Sender: suite.ica})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.InFlightTransfers, 1)
+
+	// relay the NFT packet sent by the interchain account to chain C
+	packets, err := ibctesting.ParseIBCV2Packets(channeltypesv2.EventTypeSendPacket, recvRes.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	suite.Require().Equal(res.InFlightTransfers[0].Sequence, packets[0].Sequence)
+	suite.Require().NoError(endpointC.UpdateClient())
+	suite.Require().NoError(endpointC.MsgRecvPacket(packets[0]))
+
+	voucherClassID := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointC.ClientID) + denomID).IBCClassID()
+	nft, err := testutil.ChainApp(suite.chainC).NFTKeeper.GetNFT(suite.chainC.GetContext(), voucherClassID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiver, nft.GetOwner())
+}
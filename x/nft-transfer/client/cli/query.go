@@ -11,6 +11,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const (
+	flagChannel = "channel"
+	flagClass   = "class"
+	flagTokenID = "token-id"
+)
+
 // GetCmdQueryClassTrace defines the command to query a class trace from a given trace hash or ibc class.
 func GetCmdQueryClassTrace() *cobra.Command {
 	cmd := &cobra.Command{
@@ -138,3 +144,299 @@ func GetCmdQueryClassHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdParams returns the command handler for nft-transfer parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current nft-transfer parameters",
+		Long:    "Query the current nft-transfer parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query nft-transfer params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimits defines the command to query the rate limits, optionally of a single channel.
+func GetCmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query the rate limits of nft transfers",
+		Long:    "Query the rate limits of nft transfers, optionally filtered by channel",
+		Example: fmt.Sprintf("%s query nft-transfer rate-limits --%s channel-0", version.AppName, flagChannel),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			channelID, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				ChannelId:  channelID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagChannel, "", "Only query the rate limits of this channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+	return cmd
+}
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a class on a channel.
+func GetCmdQueryRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [channel-id] [class-id]",
+		Short:   "Query the rate limit of a class on a channel",
+		Long:    "Query the rate limit of a class on a channel, including its usage in the current window",
+		Example: fmt.Sprintf("%s query nft-transfer rate-limit channel-0 ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				ChannelId: args[0],
+				ClassId:   args[1],
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowedNFTs defines the command to query the NFTs held in escrow for a port and channel.
+func GetCmdQueryEscrowedNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrowed-nfts [port-id] [channel-id]",
+		Short:   "Query the NFTs held in escrow for a port and channel",
+		Long:    "Query the NFTs held in escrow for a port and channel, optionally filtered by class",
+		Example: fmt.Sprintf("%s query nft-transfer escrowed-nfts nft-transfer channel-0 --%s kitty", version.AppName, flagClass),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID, err := cmd.Flags().GetString(flagClass)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEscrowedNFTsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				ClassId:    classID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.EscrowedNFTs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagClass, "", "Only query the escrowed NFTs of this class")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "escrowed nfts")
+	return cmd
+}
+
+// GetCmdQueryTotalEscrow defines the command to query the number of NFTs of a class held in escrow.
+func GetCmdQueryTotalEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [class-id]",
+		Short:   "Query the number of NFTs of a class held in escrow",
+		Long:    "Query the number of NFTs of a class held in escrow across all channels",
+		Example: fmt.Sprintf("%s query nft-transfer total-escrow kitty", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalEscrow(cmd.Context(), &types.QueryTotalEscrowRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowConsistency defines the command to check the escrow index of a port and
+// channel against the NFTs its escrow account owns.
+func GetCmdQueryEscrowConsistency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-consistency [port-id] [channel-id]",
+		Short:   "Check the escrow index of a port and channel against x/nft ownership",
+		Long:    "Check the escrow index of a port and channel against the NFTs its escrow account owns, listing the differences",
+		Example: fmt.Sprintf("%s query nft-transfer escrow-consistency nft-transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowConsistencyRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowConsistency(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryInFlightTransfersBySender defines the command to query the in-flight transfers of a sender.
+func GetCmdQueryInFlightTransfersBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-transfers-by-sender [sender]",
+		Short:   "Query the in-flight transfers of a sender",
+		Long:    "Query the NFT transfers of a sender whose packets were neither acknowledged nor timed out yet",
+		Example: fmt.Sprintf("%s query nft-transfer in-flight-transfers-by-sender cro1...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInFlightTransfersBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InFlightTransfersBySender(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight transfers")
+	return cmd
+}
+
+// GetCmdQueryInFlightTransfersByClass defines the command to query the in-flight transfers of a class.
+func GetCmdQueryInFlightTransfersByClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "in-flight-transfers-by-class [class-id]",
+		Short:   "Query the in-flight transfers of a class",
+		Long:    "Query the NFT transfers of a class whose packets were neither acknowledged nor timed out yet, optionally filtered by token",
+		Example: fmt.Sprintf("%s query nft-transfer in-flight-transfers-by-class kitty --%s kitty1", version.AppName, flagTokenID),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			tokenID, err := cmd.Flags().GetString(flagTokenID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInFlightTransfersByClassRequest{
+				ClassId:    args[0],
+				TokenId:    tokenID,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InFlightTransfersByClass(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagTokenID, "", "Only query the in-flight transfers of this token")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "in-flight transfers")
+	return cmd
+}
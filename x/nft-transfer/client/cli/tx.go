@@ -20,8 +20,13 @@ const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
 )
 
+// defaultRelativeV2PacketTimeout is the default relative timeout timestamp of IBC v2
+// packets, in seconds
+var defaultRelativeV2PacketTimeout = types.DefaultRelativePacketTimeoutTimestamp / uint64(time.Second)
+
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -94,8 +99,13 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				timeoutTimestamp = uint64(now) + timeoutTimestamp
 			}
 
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp,
+				srcPort, srcChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -104,7 +114,76 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet, such as a forwarding instruction.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTransferV2TxCmd returns the command to create a NewMsgTransferV2 transaction
+func NewTransferV2TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-v2 [src-client] [receiver] [classID] [tokenIDs]",
+		Short: "Transfer a non-fungible token in an IBC v2 packet",
+		Long: strings.TrimSpace(`Transfer a non-fungible token in an IBC v2 packet sent by the given client. The
+packet timeout timestamp is in seconds, and is relative to the local clock time unless the "absolute-timeouts" flag
+is set. It can't be disabled.`),
+		Example: fmt.Sprintf("%s tx nft-transfer transfer-v2 07-tendermint-0 [receiver] [classID] [tokenIDs]", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			tokenIDs := strings.Split(args[3], ",")
+
+			timeoutTimestamp, err := v2TimeoutTimestamp(cmd)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferV2(
+				args[0], args[2], tokenIDs, clientCtx.GetFromAddress().String(), args[1], timeoutTimestamp, memo,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addV2TimeoutFlags(cmd)
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet, such as a forwarding instruction.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// addV2TimeoutFlags adds the timeout flags of the commands sending IBC v2 packets.
+func addV2TimeoutFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativeV2PacketTimeout, "Packet timeout timestamp in seconds from now. Default is 10 minutes.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+}
+
+// v2TimeoutTimestamp returns the absolute timeout timestamp in seconds of an IBC v2
+// packet from the timeout flags.
+func v2TimeoutTimestamp(cmd *cobra.Command) (uint64, error) {
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+	if timeoutTimestamp == 0 {
+		return 0, errors.New("packet timeout timestamp must be non zero")
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return 0, err
+	}
+	if !absoluteTimeouts {
+		timeoutTimestamp += uint64(time.Now().Unix())
+	}
+	return timeoutTimestamp, nil
+}
@@ -17,7 +17,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ porttypes.IBCModule = IBCModule{}
+var (
+	_ porttypes.IBCModule             = IBCModule{}
+	_ porttypes.PacketDataUnmarshaler = IBCModule{}
+)
 
 // IBCModule implements the ICS26 interface for transfer given the transfer keeper.
 type IBCModule struct {
@@ -149,7 +152,8 @@ func (im IBCModule) OnChanCloseConfirm(
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is successfully decoded and the receive application
-// logic returns without error.
+// logic returns without error. No acknowledgement is returned if the NFTs are
+// forwarded as instructed by the memo, it is written once the forward completes.
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	channelVersion string,
@@ -165,8 +169,12 @@ func (im IBCModule) OnRecvPacket(
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
+	var forwarded bool
 	if ack.Success() {
-		if err := im.keeper.OnRecvPacket(ctx, channelVersion, packet, data); err != nil {
+		var err error
+		forwarded, err = im.onRecvPacket(ctx, packet, data)
+		if err != nil {
+			forwarded = false
 			ack = types.NewErrorAcknowledgement(err)
 		}
 	}
@@ -183,10 +191,37 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	if forwarded {
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
 
+// onRecvPacket receives the NFTs of a packet, and forwards them if instructed by the
+// memo, in which case true is returned
+func (im IBCModule) onRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) (bool, error) {
+	forward, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return false, err
+	}
+	if forward == nil {
+		return false, im.keeper.OnRecvPacket(ctx, data, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	received := types.InFlightForward{
+		SourcePort:         packet.GetSourcePort(),
+		SourceChannel:      packet.GetSourceChannel(),
+		DestinationPort:    packet.GetDestPort(),
+		DestinationChannel: packet.GetDestChannel(),
+		Sequence:           packet.GetSequence(),
+		Data:               data,
+		Packet:             &packet,
+	}
+	return true, im.keeper.OnRecvForwardPacket(ctx, received, *forward)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
@@ -204,7 +239,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 		return newsdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet data: %s", err.Error())
 	}
 
-	if err := im.keeper.OnAcknowledgementPacket(ctx, channelVersion, packet, data, ack); err != nil {
+	if err := im.keeper.OnAcknowledgementPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), data, ack); err != nil {
 		return err
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -254,7 +289,7 @@ func (im IBCModule) OnTimeoutPacket(
 		return newsdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet data: %s", err.Error())
 	}
 	// refund tokens
-	if err := im.keeper.OnTimeoutPacket(ctx, channelVersion, packet, data); err != nil {
+	if err := im.keeper.OnTimeoutPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), data); err != nil {
 		return err
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -270,3 +305,14 @@ func (im IBCModule) OnTimeoutPacket(
 
 	return nil
 }
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes
+// into a NonFungibleTokenPacketData. This function implements the optional
+// PacketDataUnmarshaler interface required for ADR 008 support.
+func (im IBCModule) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (any, string, error) {
+	var data types.NonFungibleTokenPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return nil, "", newsdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet data: %s", err.Error())
+	}
+	return data, types.Version, nil
+}
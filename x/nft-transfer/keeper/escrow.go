@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasEscrowedNFT checks if an NFT is indexed as escrowed for a port and channel.
+func (k Keeper) HasEscrowedNFT(ctx sdk.Context, portID, channelID, classID, tokenID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetEscrowKey(portID, channelID, classID, tokenID))
+}
+
+// GetTotalEscrow returns the number of NFTs of a class escrowed across all channels.
+func (k Keeper) GetTotalEscrow(ctx sdk.Context, classID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTotalEscrowKey(classID))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setTotalEscrow stores the number of NFTs of a class escrowed across all channels.
+func (k Keeper) setTotalEscrow(ctx sdk.Context, classID string, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if amount == 0 {
+		store.Delete(types.GetTotalEscrowKey(classID))
+		return
+	}
+	store.Set(types.GetTotalEscrowKey(classID), sdk.Uint64ToBigEndian(amount))
+}
+
+// indexEscrow records an NFT moved into the escrow account of a port and channel.
+func (k Keeper) indexEscrow(ctx sdk.Context, portID, channelID, classID, tokenID string) {
+	if k.HasEscrowedNFT(ctx, portID, channelID, classID, tokenID) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEscrowKey(portID, channelID, classID, tokenID), []byte{})
+	k.setTotalEscrow(ctx, classID, k.GetTotalEscrow(ctx, classID)+1)
+}
+
+// unindexEscrow records an NFT moved out of the escrow account of a port and channel.
+func (k Keeper) unindexEscrow(ctx sdk.Context, portID, channelID, classID, tokenID string) {
+	if !k.HasEscrowedNFT(ctx, portID, channelID, classID, tokenID) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetEscrowKey(portID, channelID, classID, tokenID))
+	k.setTotalEscrow(ctx, classID, k.GetTotalEscrow(ctx, classID)-1)
+}
+
+// GetAllEscrowedNFTs returns all the indexed escrowed NFTs.
+func (k Keeper) GetAllEscrowedNFTs(ctx sdk.Context) []types.EscrowedNFT {
+	escrows := []types.EscrowedNFT{}
+	k.IterateEscrowedNFTs(ctx, types.EscrowKey, func(escrow types.EscrowedNFT) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+
+	return escrows
+}
+
+// IterateEscrowedNFTs iterates over the indexed escrowed NFTs under an escrow key prefix
+// and performs a callback function.
+func (k Keeper) IterateEscrowedNFTs(ctx sdk.Context, keyPrefix []byte, cb func(escrow types.EscrowedNFT) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		escrow, err := types.ParseEscrowKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// CheckEscrowConsistency compares the escrow index of a port and channel against the NFTs
+// its escrow account owns in x/nft. It returns the indexed NFTs the escrow account does not
+// own, and the NFTs it owns that are not indexed.
+func (k Keeper) CheckEscrowConsistency(ctx sdk.Context, portID, channelID string) (notOwned, notIndexed []types.EscrowedNFT, err error) {
+	owner, err := k.nftKeeper.GetOwner(ctx, types.GetEscrowAddress(portID, channelID), "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	owned := make(map[string]bool)
+	for _, idc := range owner.IDCollections {
+		for _, tokenID := range idc.TokenIds {
+			owned[string(types.GetEscrowKey(portID, channelID, idc.DenomId, tokenID))] = true
+			if !k.HasEscrowedNFT(ctx, portID, channelID, idc.DenomId, tokenID) {
+				notIndexed = append(notIndexed, types.NewEscrowedNFT(portID, channelID, idc.DenomId, tokenID))
+			}
+		}
+	}
+
+	k.IterateEscrowedNFTs(ctx, types.GetEscrowChannelPrefix(portID, channelID), func(escrow types.EscrowedNFT) bool {
+		if !owned[string(types.GetEscrowKey(portID, channelID, escrow.ClassId, escrow.TokenId))] {
+			notOwned = append(notOwned, escrow)
+		}
+		return false
+	})
+
+	return notOwned, notIndexed, nil
+}
+
+// indexEscrowAccounts indexes the NFTs owned by the escrow accounts of all the channels
+// bound to the module port.
+func (k Keeper) indexEscrowAccounts(ctx sdk.Context) error {
+	portID := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		owner, err := k.nftKeeper.GetOwner(ctx, types.GetEscrowAddress(channel.PortId, channel.ChannelId), "")
+		if err != nil {
+			return err
+		}
+		for _, idc := range owner.IDCollections {
+			for _, tokenID := range idc.TokenIds {
+				k.indexEscrow(ctx, channel.PortId, channel.ChannelId, idc.DenomId, tokenID)
+			}
+		}
+	}
+	return nil
+}
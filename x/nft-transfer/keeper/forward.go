@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetInFlightForward returns the in-flight forward of a packet forwarded on a port
+// and channel.
+func (k Keeper) GetInFlightForward(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightForward, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetForwardKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightForward{}, false
+	}
+
+	return k.MustUnmarshalInFlightForward(bz), true
+}
+
+// setInFlightForward stores an in-flight forward.
+func (k Keeper) setInFlightForward(ctx sdk.Context, forward types.InFlightForward) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetForwardKey(forward.ForwardPort, forward.ForwardChannel, forward.ForwardSequence), k.cdc.MustMarshal(&forward))
+}
+
+// deleteInFlightForward deletes the in-flight forward of a packet forwarded on a
+// port and channel.
+func (k Keeper) deleteInFlightForward(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetForwardKey(portID, channelID, sequence))
+}
+
+// GetAllInFlightForwards returns all the in-flight forwards.
+func (k Keeper) GetAllInFlightForwards(ctx sdk.Context) []types.InFlightForward {
+	forwards := []types.InFlightForward{}
+	k.IterateInFlightForwards(ctx, func(forward types.InFlightForward) bool {
+		forwards = append(forwards, forward)
+		return false
+	})
+
+	return forwards
+}
+
+// IterateInFlightForwards iterates over the in-flight forwards in the store
+// and performs a callback function.
+func (k Keeper) IterateInFlightForwards(ctx sdk.Context, cb func(forward types.InFlightForward) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ForwardKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.MustUnmarshalInFlightForward(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// MustUnmarshalInFlightForward attempts to decode and return an InFlightForward
+// object from raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalInFlightForward(bz []byte) types.InFlightForward {
+	var forward types.InFlightForward
+	k.cdc.MustUnmarshal(bz, &forward)
+	return forward
+}
+
+// OnRecvForwardPacket receives the NFTs of a packet whose memo holds a forwarding
+// instruction, and sends them on to the next hop. The NFTs are received by the
+// forward address of the original sender, whatever the receiver of the packet,
+// and forwarded over a channel for IBC v1 packets, or a client for IBC v2 packets.
+//
+// The acknowledgement of the received packet is written asynchronously, once the
+// forwarded packet is acknowledged or times out.
+func (k Keeper) OnRecvForwardPacket(ctx sdk.Context, received types.InFlightForward, forward types.ForwardMetadata) error {
+	data := received.Data
+	forwardAddress := types.GetForwardAddress(received.DestinationChannel, data.Sender)
+	data.Receiver = forwardAddress.String()
+
+	if err := k.OnRecvPacket(ctx, data, received.SourcePort, received.SourceChannel, received.DestinationPort, received.DestinationChannel); err != nil {
+		return err
+	}
+	received.Data = data
+
+	memo, err := forward.GetNextMemo()
+	if err != nil {
+		return err
+	}
+	timeout, err := forward.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	classID := receivedClassID(data, received.SourcePort, received.SourceChannel, received.DestinationPort, received.DestinationChannel)
+	var sequence uint64
+	if received.Packet != nil {
+		timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+		sequence, err = k.SendTransfer(
+			ctx, forward.Port, forward.Channel, classID, data.TokenIds,
+			forwardAddress, forward.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+		)
+	} else {
+		timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).Unix())
+		sequence, err = k.sendPacketV2(ctx, forward.Port, forward.Channel, classID, data.TokenIds, forwardAddress, forward.Receiver, memo, timeoutTimestamp)
+	}
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to forward to %s/%s", forward.Port, forward.Channel)
+	}
+
+	received.ForwardPort = forward.Port
+	received.ForwardChannel = forward.Channel
+	received.ForwardSequence = sequence
+	k.setInFlightForward(ctx, received)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(sdk.AttributeKeySender, forwardAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, forward.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, forward.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, forward.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+		),
+	)
+
+	return nil
+}
+
+// sendPacketV2 sends NFTs in an IBC v2 packet through the IBC core, which escrows
+// or burns them through the OnSendPacket callback of this module.
+func (k Keeper) sendPacketV2(
+	ctx sdk.Context,
+	sourcePort,
+	sourceClient,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver,
+	memo string,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	data, _, err := k.outgoingPacketData(ctx, classID, tokenIDs, sender, receiver, memo)
+	if err != nil {
+		return 0, err
+	}
+
+	payload := channeltypesv2.NewPayload(sourcePort, types.PortID, types.Version, types.EncodingJSON, data.GetBytes())
+	res, err := k.channelKeeperV2.SendPacket(ctx, channeltypesv2.NewMsgSendPacket(sourceClient, timeoutTimestamp, sender.String(), payload))
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// onForwardSucceeded writes a successful acknowledgement for the received packet
+// whose NFTs were forwarded by a packet, if any.
+func (k Keeper) onForwardSucceeded(ctx sdk.Context, portID, channelID string, sequence uint64) error {
+	forward, found := k.GetInFlightForward(ctx, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+	k.deleteInFlightForward(ctx, portID, channelID, sequence)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyForwardPort, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "true"),
+		),
+	)
+
+	return k.writeForwardAcknowledgement(ctx, forward, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// onForwardFailed reverts the receipt of the received packet whose NFTs were
+// forwarded by a packet, if any, and writes an error acknowledgement for it, so
+// that the previous chain refunds the sender in turn. The forwarded NFTs must have
+// been refunded to the forward address.
+func (k Keeper) onForwardFailed(ctx sdk.Context, portID, channelID string, sequence uint64, reason string) error {
+	forward, found := k.GetInFlightForward(ctx, portID, channelID, sequence)
+	if !found {
+		return nil
+	}
+	k.deleteInFlightForward(ctx, portID, channelID, sequence)
+
+	if err := k.revertReceivedPacket(ctx, forward); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyForwardPort, portID),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, "false"),
+			sdk.NewAttribute(types.AttributeKeyAckError, reason),
+		),
+	)
+
+	err := sdkerrors.Wrapf(types.ErrForwardFailed, "%s/%s sequence %d", portID, channelID, sequence)
+	return k.writeForwardAcknowledgement(ctx, forward, types.NewErrorAcknowledgement(err))
+}
+
+// revertReceivedPacket undoes the receipt of a packet whose NFTs are held by the
+// forward address: vouchers minted for them are burnt, and NFTs released from
+// escrow are escrowed again.
+func (k Keeper) revertReceivedPacket(ctx sdk.Context, forward types.InFlightForward) error {
+	data := forward.Data
+	forwardAddress, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return err
+	}
+
+	classID := receivedClassID(data, forward.SourcePort, forward.SourceChannel, forward.DestinationPort, forward.DestinationChannel)
+	if types.IsAwayFromOrigin(forward.SourcePort, forward.SourceChannel, data.ClassId) {
+		for _, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.BurnNFTUnverified(ctx, classID, tokenID, forwardAddress); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	escrowAddress := types.GetEscrowAddress(forward.DestinationPort, forward.DestinationChannel)
+	for _, tokenID := range data.TokenIds {
+		if err := k.nftKeeper.TransferOwner(ctx, classID, tokenID, forwardAddress, escrowAddress); err != nil {
+			return err
+		}
+		k.indexEscrow(ctx, forward.DestinationPort, forward.DestinationChannel, classID, tokenID)
+	}
+	return nil
+}
+
+// writeForwardAcknowledgement writes the acknowledgement of the received packet of
+// an in-flight forward. IBC v2 replaces error acknowledgements with a sentinel.
+func (k Keeper) writeForwardAcknowledgement(ctx sdk.Context, forward types.InFlightForward, ack channeltypes.Acknowledgement) error {
+	if forward.Packet != nil {
+		return k.ics4Wrapper.WriteAcknowledgement(ctx, *forward.Packet, ack)
+	}
+
+	appAck := ack.Acknowledgement()
+	if !ack.Success() {
+		appAck = channeltypesv2.ErrorAcknowledgement[:]
+	}
+	return k.channelKeeperV2.WriteAcknowledgement(ctx, forward.DestinationChannel, forward.Sequence, channeltypesv2.NewAcknowledgement(appAck))
+}
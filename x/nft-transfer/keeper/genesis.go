@@ -13,12 +13,38 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, trace := range state.Traces {
 		k.SetClassTrace(ctx, trace)
 	}
+
+	if err := k.SetParams(ctx, state.Params); err != nil {
+		panic(err)
+	}
+
+	for _, rateLimit := range state.RateLimits {
+		k.setRateLimit(ctx, rateLimit)
+	}
+
+	for _, escrow := range state.EscrowedNfts {
+		k.indexEscrow(ctx, escrow.PortId, escrow.ChannelId, escrow.ClassId, escrow.TokenId)
+	}
+
+	for _, forward := range state.InFlightForwards {
+		k.setInFlightForward(ctx, forward)
+	}
+
+	for _, transfer := range state.InFlightTransfers {
+		k.setInFlightTransfer(ctx, transfer)
+	}
 }
 
-// ExportGenesis exports ibc nft-transfer module's portID and class trace info into its genesis state.
+// ExportGenesis exports ibc nft-transfer module's portID, class trace info, params, rate limits, escrow index,
+// in-flight forwards and in-flight transfers into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId: k.GetPort(ctx),
-		Traces: k.GetAllClassTraces(ctx),
+		PortId:            k.GetPort(ctx),
+		Traces:            k.GetAllClassTraces(ctx),
+		Params:            k.GetParams(ctx),
+		RateLimits:        k.GetAllRateLimits(ctx),
+		EscrowedNfts:      k.GetAllEscrowedNFTs(ctx),
+		InFlightForwards:  k.GetAllInFlightForwards(ctx),
+		InFlightTransfers: k.GetAllInFlightTransfers(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
@@ -119,3 +120,207 @@ func (k Keeper) EscrowAddress(c context.Context,
 		EscrowAddress: addr.String(),
 	}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// RateLimits implements the Query/RateLimits gRPC method
+func (k Keeper) RateLimits(c context.Context,
+	req *types.QueryRateLimitsRequest,
+) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	keyPrefix := types.RateLimitKey
+	if req.ChannelId != "" {
+		keyPrefix = types.GetRateLimitChannelPrefix(req.ChannelId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimits := []types.RateLimit{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the Query/RateLimit gRPC method
+func (k Keeper) RateLimit(c context.Context,
+	req *types.QueryRateLimitRequest,
+) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := k.GetRateLimit(ctx, req.ChannelId, req.ClassId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrRateLimitNotFound, "class %s on channel %s", req.ClassId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+	}, nil
+}
+
+// EscrowedNFTs implements the Query/EscrowedNFTs gRPC method
+func (k Keeper) EscrowedNFTs(c context.Context,
+	req *types.QueryEscrowedNFTsRequest,
+) (*types.QueryEscrowedNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	keyPrefix := types.GetEscrowChannelPrefix(req.PortId, req.ChannelId)
+	if req.ClassId != "" {
+		keyPrefix = types.GetEscrowClassPrefix(req.PortId, req.ChannelId, req.ClassId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrows := []types.EscrowedNFT{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		escrow, err := types.ParseEscrowKey(append(append([]byte{}, keyPrefix...), key...))
+		if err != nil {
+			return err
+		}
+
+		escrows = append(escrows, escrow)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowedNFTsResponse{
+		EscrowedNfts: escrows,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// TotalEscrow implements the Query/TotalEscrow gRPC method
+func (k Keeper) TotalEscrow(c context.Context,
+	req *types.QueryTotalEscrowRequest,
+) (*types.QueryTotalEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalEscrowResponse{
+		Amount: k.GetTotalEscrow(ctx, req.ClassId),
+	}, nil
+}
+
+// EscrowConsistency implements the Query/EscrowConsistency gRPC method
+func (k Keeper) EscrowConsistency(c context.Context,
+	req *types.QueryEscrowConsistencyRequest,
+) (*types.QueryEscrowConsistencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	notOwned, notIndexed, err := k.CheckEscrowConsistency(ctx, req.PortId, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEscrowConsistencyResponse{
+		NotOwned:   notOwned,
+		NotIndexed: notIndexed,
+	}, nil
+}
+
+// InFlightTransfersBySender implements the Query/InFlightTransfersBySender gRPC method
+func (k Keeper) InFlightTransfersBySender(c context.Context,
+	req *types.QueryInFlightTransfersBySenderRequest,
+) (*types.QueryInFlightTransfersBySenderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	transfers := []types.InFlightTransfer{}
+	store := prefix.NewStore(kvStore, types.GetInFlightTransferSenderPrefix(sender))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		transfers = append(transfers, k.MustUnmarshalInFlightTransfer(kvStore.Get(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInFlightTransfersBySenderResponse{
+		InFlightTransfers: transfers,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// InFlightTransfersByClass implements the Query/InFlightTransfersByClass gRPC method
+func (k Keeper) InFlightTransfersByClass(c context.Context,
+	req *types.QueryInFlightTransfersByClassRequest,
+) (*types.QueryInFlightTransfersByClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.ClassId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "class id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	kvStore := ctx.KVStore(k.storeKey)
+	transfers := []types.InFlightTransfer{}
+	store := prefix.NewStore(kvStore, types.GetInFlightTransferClassPrefix(req.ClassId))
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		transfer := k.MustUnmarshalInFlightTransfer(kvStore.Get(value))
+		if req.TokenId != "" && !slices.Contains(transfer.TokenIds, req.TokenId) {
+			return false, nil
+		}
+
+		if accumulate {
+			transfers = append(transfers, transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInFlightTransfersByClassResponse{
+		InFlightTransfers: transfers,
+		Pagination:        pageRes,
+	}, nil
+}
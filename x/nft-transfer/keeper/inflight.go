@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetInFlightTransfer returns the in-flight transfer of a packet sent on a port and
+// channel.
+func (k Keeper) GetInFlightTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInFlightTransferKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightTransfer{}, false
+	}
+
+	return k.MustUnmarshalInFlightTransfer(bz), true
+}
+
+// setInFlightTransfer stores an in-flight transfer and indexes it by sender and
+// class. The index entries hold the store key of the transfer.
+func (k Keeper) setInFlightTransfer(ctx sdk.Context, transfer types.InFlightTransfer) {
+	sender := sdk.MustAccAddressFromBech32(transfer.Sender)
+	key := types.GetInFlightTransferKey(transfer.PortId, transfer.ChannelId, transfer.Sequence)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&transfer))
+	store.Set(types.GetInFlightTransferSenderKey(sender, transfer.PortId, transfer.ChannelId, transfer.Sequence), key)
+	store.Set(types.GetInFlightTransferClassKey(transfer.ClassId, transfer.PortId, transfer.ChannelId, transfer.Sequence), key)
+}
+
+// deleteInFlightTransfer deletes the in-flight transfer of a packet sent on a port
+// and channel, if any, along with its index entries.
+func (k Keeper) deleteInFlightTransfer(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	transfer, found := k.GetInFlightTransfer(ctx, portID, channelID, sequence)
+	if !found {
+		return
+	}
+	sender := sdk.MustAccAddressFromBech32(transfer.Sender)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetInFlightTransferKey(portID, channelID, sequence))
+	store.Delete(types.GetInFlightTransferSenderKey(sender, portID, channelID, sequence))
+	store.Delete(types.GetInFlightTransferClassKey(transfer.ClassId, portID, channelID, sequence))
+}
+
+// GetAllInFlightTransfers returns all the in-flight transfers.
+func (k Keeper) GetAllInFlightTransfers(ctx sdk.Context) []types.InFlightTransfer {
+	transfers := []types.InFlightTransfer{}
+	k.IterateInFlightTransfers(ctx, func(transfer types.InFlightTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+
+	return transfers
+}
+
+// IterateInFlightTransfers iterates over the in-flight transfers in the store
+// and performs a callback function.
+func (k Keeper) IterateInFlightTransfers(ctx sdk.Context, cb func(transfer types.InFlightTransfer) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.InFlightTransferKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.MustUnmarshalInFlightTransfer(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// MustUnmarshalInFlightTransfer attempts to decode and return an InFlightTransfer
+// object from raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalInFlightTransfer(bz []byte) types.InFlightTransfer {
+	var transfer types.InFlightTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer
+}
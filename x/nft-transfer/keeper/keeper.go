@@ -16,10 +16,16 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
-	ics4Wrapper   types.ICS4Wrapper
-	channelKeeper types.ChannelKeeper
-	nftKeeper     types.NFTKeeper
-	authKeeper    types.AccountKeeper
+	ics4Wrapper     types.ICS4Wrapper
+	channelKeeper   types.ChannelKeeper
+	channelKeeperV2 types.ChannelKeeperV2
+	clientKeeper    types.ClientKeeper
+	nftKeeper       types.NFTKeeper
+	authKeeper      types.AccountKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new IBC nft-transfer Keeper instance
@@ -28,16 +34,22 @@ func NewKeeper(
 	key storetypes.StoreKey,
 	ics4Wrapper types.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
+	channelKeeperV2 types.ChannelKeeperV2,
+	clientKeeper types.ClientKeeper,
 	nftKeeper types.NFTKeeper,
 	authKeeper types.AccountKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      key,
-		ics4Wrapper:   ics4Wrapper,
-		channelKeeper: channelKeeper,
-		nftKeeper:     nftKeeper,
-		authKeeper:    authKeeper,
+		cdc:             cdc,
+		storeKey:        key,
+		ics4Wrapper:     ics4Wrapper,
+		channelKeeper:   channelKeeper,
+		channelKeeperV2: channelKeeperV2,
+		clientKeeper:    clientKeeper,
+		nftKeeper:       nftKeeper,
+		authKeeper:      authKeeper,
+		authority:       authority,
 	}
 }
 
@@ -46,6 +58,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.SubModuleName+"-"+types.ModuleName)
 }
 
+// GetAuthority returns the nft-transfer module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetPort sets the portID for the nft-transfer module. Used in InitGenesis
 func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store := ctx.KVStore(k.storeKey)
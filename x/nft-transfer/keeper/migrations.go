@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the default params, which keep transfers enabled on all channels.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// Migrate2to3 builds the escrow index from the NFTs held by the escrow accounts.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.indexEscrowAccounts(ctx)
+}
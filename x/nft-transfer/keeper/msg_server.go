@@ -6,7 +6,10 @@ import (
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
 
+	sdkerrors "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.MsgServer = Keeper{}
@@ -19,31 +22,130 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	if err != nil {
 		return nil, err
 	}
-	if err := k.SendTransfer(
+
+	sequence, err := k.SendTransfer(
 		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds,
-		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-	); err != nil {
+		sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.emitTransferEvents(ctx, msg.ClassId, msg.TokenIds, msg.Sender, msg.Receiver)
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// TransferV2 defines a rpc handler method for MsgTransferV2.
+func (k Keeper) TransferV2(goCtx context.Context, msg *types.MsgTransferV2) (*types.MsgTransferV2Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
 		return nil, err
 	}
 
+	sequence, err := k.sendPacketV2(
+		ctx, types.PortID, msg.SourceClient, msg.ClassId, msg.TokenIds,
+		sender, msg.Receiver, msg.Memo, msg.TimeoutTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	k.emitTransferEvents(ctx, msg.ClassId, msg.TokenIds, msg.Sender, msg.Receiver)
+	return &types.MsgTransferV2Response{Sequence: sequence}, nil
+}
+
+// emitTransferEvents logs and emits the events of an IBC transfer sent by a message
+func (k Keeper) emitTransferEvents(ctx sdk.Context, classID string, tokenIDs []string, sender, receiver string) {
 	k.Logger(ctx).Info("IBC non-fungible token transfer",
-		"classID", msg.ClassId,
-		"tokenIDs", strings.Join(msg.TokenIds, ","),
-		"sender", msg.Sender,
-		"receiver", msg.Receiver,
+		"classID", classID,
+		"tokenIDs", strings.Join(tokenIDs, ","),
+		"sender", sender,
+		"receiver", receiver,
 	)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		),
 	})
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetRateLimit defines a rpc handler method for MsgSetRateLimit.
+func (k Keeper) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, msg.ChannelId, msg.ClassId)
+	if found {
+		// keep the usage of the current window
+		rateLimit.Quota = msg.Quota
+	} else {
+		rateLimit = types.NewRateLimit(msg.ChannelId, msg.ClassId, msg.Quota, ctx.BlockTime())
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.setRateLimit(ctx, rateLimit)
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines a rpc handler method for MsgRemoveRateLimit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.ChannelId, msg.ClassId); !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "class %s on channel %s", msg.ClassId, msg.ChannelId)
+	}
+
+	k.deleteRateLimit(ctx, msg.ChannelId, msg.ClassId)
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// RecoverEscrowedNFTs defines a rpc handler method for MsgRecoverEscrowedNFTs.
+func (k Keeper) RecoverEscrowedNFTs(goCtx context.Context, msg *types.MsgRecoverEscrowedNFTs) (*types.MsgRecoverEscrowedNFTsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recovered, err := k.recoverEscrowedNFTs(ctx, msg.PortId, msg.ChannelId, msg.Recoveries)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("recovered escrowed NFTs",
+		"port", msg.PortId,
+		"channel", msg.ChannelId,
+		"amount", recovered,
+	)
 
-	return &types.MsgTransferResponse{}, nil
+	return &types.MsgRecoverEscrowedNFTsResponse{Recovered: recovered}, nil
 }
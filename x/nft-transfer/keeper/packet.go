@@ -3,9 +3,8 @@ package keeper
 import (
 	"strings"
 
-	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
-	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	nftexported "github.com/crypto-org-chain/chain-main/v8/x/nft/exported"
 	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
 	newsdkerrors "cosmossdk.io/errors"
@@ -18,7 +17,7 @@ import (
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
 // the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+func (k Keeper) refundPacketToken(ctx sdk.Context, data types.NonFungibleTokenPacketData, sourcePort, sourceChannel string) error {
 	sender, err := sdk.AccAddressFromBech32(data.Sender)
 	if err != nil {
 		return err
@@ -27,10 +26,9 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	classTrace := types.ParseClassTrace(data.ClassId)
 	voucherClassID := classTrace.IBCClassID()
 
-	isAwayFromOrigin := types.IsAwayFromOrigin(packet.GetSourcePort(),
-		packet.GetSourceChannel(), data.ClassId)
+	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort, sourceChannel, data.ClassId)
 
-	escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
 
 	if isAwayFromOrigin {
 		// unescrow tokens back to the sender
@@ -38,6 +36,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 			if err := k.nftKeeper.TransferOwner(ctx, voucherClassID, tokenID, escrowAddress, sender); err != nil {
 				return err
 			}
+			k.unindexEscrow(ctx, sourcePort, sourceChannel, voucherClassID, tokenID)
 		}
 	} else {
 		// we are sink chain, mint voucher back to sender
@@ -51,32 +50,70 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	return nil
 }
 
-// createOutgoingPacket will escrow the tokens to escrow account
-// if the token was away from origin chain . Otherwise, the sent tokens
-// were burnt in the sending chain and will unescrow the token to receiver
-// in the destination chain
-func (k Keeper) createOutgoingPacket(ctx sdk.Context,
+// createOutgoingPacketData escrows or burns the tokens being sent, and returns the
+// packet data describing them
+func (k Keeper) createOutgoingPacketData(ctx sdk.Context,
 	sourcePort,
 	sourceChannel,
-	destinationPort,
-	destinationChannel,
 	classID string,
 	tokenIDs []string,
 	sender sdk.AccAddress,
 	receiver string,
-	sequence uint64,
-	timeoutHeight clienttypes.Height,
-	timeoutTimestamp uint64,
-) (channeltypes.Packet, error) {
+	memo string,
+) (types.NonFungibleTokenPacketData, error) {
+	packetData, nfts, err := k.outgoingPacketData(ctx, classID, tokenIDs, sender, receiver, memo)
+	if err != nil {
+		return types.NonFungibleTokenPacketData{}, err
+	}
+
+	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort,
+		sourceChannel, packetData.ClassId)
+
+	for _, nft := range nfts {
+		tokenID := nft.GetID()
+
+		owner := nft.GetOwner()
+		if !sender.Equals(owner) {
+			return types.NonFungibleTokenPacketData{}, newsdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
+		}
+
+		if isAwayFromOrigin {
+			// create the escrow address for the tokens
+			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+			if err := k.nftKeeper.TransferOwner(ctx, classID, tokenID, sender, escrowAddress); err != nil {
+				return types.NonFungibleTokenPacketData{}, err
+			}
+			k.indexEscrow(ctx, sourcePort, sourceChannel, classID, tokenID)
+		} else {
+			// we are sink chain, burn the voucher
+			if err := k.nftKeeper.BurnNFTUnverified(ctx, classID, tokenID, sender); err != nil {
+				return types.NonFungibleTokenPacketData{}, err
+			}
+		}
+	}
+
+	return packetData, nil
+}
+
+// outgoingPacketData returns the packet data describing NFTs of a class on this
+// chain, along with the NFTs, without moving them
+func (k Keeper) outgoingPacketData(ctx sdk.Context,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	memo string,
+) (types.NonFungibleTokenPacketData, []nftexported.NFT, error) {
 	denom, err := k.nftKeeper.GetDenom(ctx, classID)
 	if err != nil {
-		return channeltypes.Packet{}, err
+		return types.NonFungibleTokenPacketData{}, nil, err
 	}
 
 	var (
 		// NOTE: class and hex hash correctness checked during msg.ValidateBasic
 		fullClassPath = classID
 		tokenURIs     = []string{}
+		nfts          = []nftexported.NFT{}
 	)
 
 	// deconstruct the token denomination into the denomination trace info
@@ -84,75 +121,44 @@ func (k Keeper) createOutgoingPacket(ctx sdk.Context,
 	if strings.HasPrefix(classID, nfttypes.IBCPrefix) {
 		fullClassPath, err = k.ClassPathFromHash(ctx, classID)
 		if err != nil {
-			return channeltypes.Packet{}, err
+			return types.NonFungibleTokenPacketData{}, nil, err
 		}
 	}
 
-	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort,
-		sourceChannel, fullClassPath)
-
 	for _, tokenID := range tokenIDs {
 		nft, err := k.nftKeeper.GetNFT(ctx, classID, tokenID)
 		if err != nil {
-			return channeltypes.Packet{}, err
+			return types.NonFungibleTokenPacketData{}, nil, err
 		}
 		tokenURIs = append(tokenURIs, nft.GetURI())
-
-		owner := nft.GetOwner()
-		if !sender.Equals(owner) {
-			return channeltypes.Packet{}, newsdkerrors.Wrap(sdkerrors.ErrUnauthorized, "not token owner")
-		}
-
-		if isAwayFromOrigin {
-			// create the escrow address for the tokens
-			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-			if err := k.nftKeeper.TransferOwner(ctx, classID, tokenID, sender, escrowAddress); err != nil {
-				return channeltypes.Packet{}, err
-			}
-		} else {
-			// we are sink chain, burn the voucher
-			if err := k.nftKeeper.BurnNFTUnverified(ctx, classID, tokenID, sender); err != nil {
-				return channeltypes.Packet{}, err
-			}
-		}
+		nfts = append(nfts, nft)
 	}
 
-	packetData := types.NewNonFungibleTokenPacketData(
-		fullClassPath, denom.Uri, tokenIDs, tokenURIs, sender.String(), receiver,
-	)
-
-	return channeltypes.NewPacket(
-		packetData.GetBytes(),
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	), nil
+	return types.NewNonFungibleTokenPacketData(
+		fullClassPath, denom.Uri, tokenIDs, tokenURIs, sender.String(), receiver, memo,
+	), nfts, nil
 }
 
 // processReceivedPacket will mint the tokens to receiver account
 // if the token was away from origin chain . Otherwise, the sent tokens
 // were burnt in the sending chain and will unescrow the token to receiver
 // in the destination chain
-func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packet,
-	data types.NonFungibleTokenPacketData,
+func (k Keeper) processReceivedPacket(ctx sdk.Context, data types.NonFungibleTokenPacketData,
+	sourcePort, sourceChannel, destPort, destChannel string,
 ) error {
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
 		return err
 	}
 
-	isAwayFromOrigin := types.IsAwayFromOrigin(packet.GetSourcePort(), packet.GetSourceChannel(), data.ClassId)
+	isAwayFromOrigin := types.IsAwayFromOrigin(sourcePort, sourceChannel, data.ClassId)
 
 	// create the escrow address for creating denom and minting nft
-	escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+	escrowAddress := types.GetEscrowAddress(destPort, destChannel)
 
 	if isAwayFromOrigin {
 		// since SendPacket did not prefix the classID, we must prefix classID here
-		classPrefix := types.GetClassPrefix(packet.GetDestPort(), packet.GetDestChannel())
+		classPrefix := types.GetClassPrefix(destPort, destChannel)
 		// NOTE: sourcePrefix contains the trailing "/"
 		prefixedClassID := classPrefix + data.ClassId
 
@@ -189,8 +195,7 @@ func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packe
 
 		// we should remove the prefix. For example:
 		// p6/c6/p4/c4/p2/c2/nftClass -> p4/c4/p2/c2/nftClass
-		unprefixedClassID := types.RemoveClassPrefix(packet.GetSourcePort(),
-			packet.GetSourceChannel(), data.ClassId)
+		unprefixedClassID := types.RemoveClassPrefix(sourcePort, sourceChannel, data.ClassId)
 
 		voucherClassID := types.ParseClassTrace(unprefixedClassID).IBCClassID()
 		for _, tokenID := range data.TokenIds {
@@ -198,8 +203,20 @@ func (k Keeper) processReceivedPacket(ctx sdk.Context, packet channeltypes.Packe
 				voucherClassID, tokenID, escrowAddress, receiver); err != nil {
 				return err
 			}
+			k.unindexEscrow(ctx, destPort, destChannel, voucherClassID, tokenID)
 		}
 	}
 
 	return nil
 }
+
+// receivedClassID returns the ID the class of a received packet has on this chain
+func receivedClassID(data types.NonFungibleTokenPacketData, sourcePort, sourceChannel, destPort, destChannel string) string {
+	if types.IsAwayFromOrigin(sourcePort, sourceChannel, data.ClassId) {
+		prefixedClassID := types.GetClassPrefix(destPort, destChannel) + data.ClassId
+		return types.ParseClassTrace(prefixedClassID).IBCClassID()
+	}
+
+	unprefixedClassID := types.RemoveClassPrefix(sourcePort, sourceChannel, data.ClassId)
+	return types.ParseClassTrace(unprefixedClassID).IBCClassID()
+}
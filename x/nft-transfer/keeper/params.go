@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the current nft-transfer module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		panic(errors.New("nft-transfer params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams validates and sets the nft-transfer module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	return nil
+}
+
+// MustUnmarshalParams attempts to decode and return a Params object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalParams(bz []byte) types.Params {
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimit returns the rate limit of a class on a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, classID string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitKey(channelID, classID))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	return k.MustUnmarshalRateLimit(bz), true
+}
+
+// setRateLimit stores the rate limit of a class on a channel. It is unexported as
+// the exported name is taken by the MsgSetRateLimit handler.
+func (k Keeper) setRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRateLimitKey(rateLimit.ChannelId, rateLimit.ClassId), k.cdc.MustMarshal(&rateLimit))
+}
+
+// deleteRateLimit deletes the rate limit of a class on a channel.
+func (k Keeper) deleteRateLimit(ctx sdk.Context, channelID, classID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(channelID, classID))
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over the rate limits in the store
+// and performs a callback function.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RateLimitKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.MustUnmarshalRateLimit(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// MustUnmarshalRateLimit attempts to decode and return a RateLimit object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalRateLimit(bz []byte) types.RateLimit {
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit
+}
+
+// consumeSendQuota counts the NFTs of a class sent on a channel against its rate
+// limit, if any. NFTs refunded after a failed transfer are not credited back.
+func (k Keeper) consumeSendQuota(ctx sdk.Context, channelID, classID string, amount int) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, classID)
+	if !found {
+		return nil
+	}
+	if err := rateLimit.AddSent(ctx.BlockTime(), uint64(amount)); err != nil {
+		return err
+	}
+
+	k.setRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// consumeReceiveQuota counts the NFTs of a class received on a channel against
+// its rate limit, if any. The class ID is the one of the class on this chain.
+func (k Keeper) consumeReceiveQuota(ctx sdk.Context, channelID, classID string, amount int) error {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, classID)
+	if !found {
+		return nil
+	}
+	if err := rateLimit.AddReceived(ctx.BlockTime(), uint64(amount)); err != nil {
+		return err
+	}
+
+	k.setRateLimit(ctx, rateLimit)
+	return nil
+}
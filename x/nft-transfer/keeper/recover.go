@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkEscrowRecoverable checks that the NFTs escrowed for a port and channel can no
+// longer be relayed back, as the channel is closed, or its client is frozen or
+// expired. The channel is the client ID for IBC v2 packets.
+func (k Keeper) checkEscrowRecoverable(ctx sdk.Context, portID, channelID string) error {
+	clientID := channelID
+	if channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+		if channel.State == channeltypes.CLOSED {
+			return nil
+		}
+
+		var err error
+		clientID, _, err = k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+		if err != nil {
+			return err
+		}
+	}
+
+	status := k.clientKeeper.GetClientStatus(ctx, clientID)
+	if status != ibcexported.Frozen && status != ibcexported.Expired {
+		return sdkerrors.Wrapf(types.ErrEscrowNotRecoverable, "channel %s/%s is open with client %s %s", portID, channelID, clientID, status)
+	}
+	return nil
+}
+
+// recoverEscrowedNFTs releases the NFTs escrowed for a port and channel that can no
+// longer be relayed back. The in-flight transfers sent on the channel are refunded
+// as if their packets timed out: escrowed NFTs are released to their senders,
+// burnt vouchers are minted to them again, and the received packets they forwarded
+// are reverted and acknowledged with an error, so that the previous chain refunds
+// the original sender in turn. The explicitly mapped NFTs, which cannot belong to
+// an in-flight transfer, are released to their recipients.
+//
+// Class traces are left untouched: they describe the classes of the vouchers on
+// this chain, which the recovery does not change. The emitted events hold the full
+// class path of the released NFTs instead.
+//
+// Packets of the in-flight transfers can no longer be refunded by a timeout once
+// they are recovered.
+func (k Keeper) recoverEscrowedNFTs(ctx sdk.Context, portID, channelID string, recoveries []types.EscrowRecovery) (uint64, error) {
+	if err := k.checkEscrowRecoverable(ctx, portID, channelID); err != nil {
+		return 0, err
+	}
+
+	var transfers []types.InFlightTransfer
+	inFlight := make(map[string]bool)
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetInFlightTransferChannelPrefix(portID, channelID))
+	for ; iterator.Valid(); iterator.Next() {
+		transfer := k.MustUnmarshalInFlightTransfer(iterator.Value())
+		for _, tokenID := range transfer.TokenIds {
+			inFlight[transfer.ClassId+"/"+tokenID] = true
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := iterator.Close(); err != nil {
+		return 0, err
+	}
+
+	var recovered uint64
+	for _, recovery := range recoveries {
+		if err := recovery.Validate(); err != nil {
+			return 0, err
+		}
+		if inFlight[recovery.ClassId+"/"+recovery.TokenId] {
+			return 0, sdkerrors.Wrapf(types.ErrEscrowInFlight, "%s/%s on %s/%s", recovery.ClassId, recovery.TokenId, portID, channelID)
+		}
+		if !k.HasEscrowedNFT(ctx, portID, channelID, recovery.ClassId, recovery.TokenId) {
+			return 0, sdkerrors.Wrapf(types.ErrNotEscrowed, "%s/%s on %s/%s", recovery.ClassId, recovery.TokenId, portID, channelID)
+		}
+		if err := k.releaseEscrowedNFT(ctx, portID, channelID, recovery.ClassId, recovery.TokenId, recovery.Recipient); err != nil {
+			return 0, err
+		}
+		recovered++
+	}
+
+	for _, transfer := range transfers {
+		if err := k.refundInFlightTransfer(ctx, transfer); err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to recover sequence %d", transfer.Sequence)
+		}
+		recovered += uint64(len(transfer.TokenIds))
+	}
+
+	return recovered, nil
+}
+
+// refundInFlightTransfer refunds the sender of an in-flight transfer like a timeout of
+// its packet would, and clears the transfer.
+func (k Keeper) refundInFlightTransfer(ctx sdk.Context, transfer types.InFlightTransfer) error {
+	classPath, err := k.classPath(ctx, transfer.ClassId)
+	if err != nil {
+		return err
+	}
+
+	k.deleteInFlightTransfer(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence)
+
+	data := types.NewNonFungibleTokenPacketData(classPath, "", transfer.TokenIds, transfer.TokenUris, transfer.Sender, transfer.Receiver, "")
+	if err := k.refundPacketToken(ctx, data, transfer.PortId, transfer.ChannelId); err != nil {
+		return err
+	}
+	if err := k.onForwardFailed(ctx, transfer.PortId, transfer.ChannelId, transfer.Sequence, "escrow recovered"); err != nil {
+		return err
+	}
+
+	for _, tokenID := range transfer.TokenIds {
+		emitRecoverEscrowEvent(ctx, transfer.PortId, transfer.ChannelId, transfer.ClassId, classPath, tokenID, transfer.Sender)
+	}
+	return nil
+}
+
+// releaseEscrowedNFT transfers an NFT out of the escrow account of a port and channel
+// to a recipient.
+func (k Keeper) releaseEscrowedNFT(ctx sdk.Context, portID, channelID, classID, tokenID, recipient string) error {
+	recipientAddress, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+
+	classPath, err := k.classPath(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
+	if err := k.nftKeeper.TransferOwner(ctx, classID, tokenID, escrowAddress, recipientAddress); err != nil {
+		return err
+	}
+	k.unindexEscrow(ctx, portID, channelID, classID, tokenID)
+
+	emitRecoverEscrowEvent(ctx, portID, channelID, classID, classPath, tokenID, recipient)
+	return nil
+}
+
+// classPath returns the full class path of a class on this chain, as recorded by the
+// class traces for voucher classes.
+func (k Keeper) classPath(ctx sdk.Context, classID string) (string, error) {
+	if !strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return classID, nil
+	}
+	return k.ClassPathFromHash(ctx, classID)
+}
+
+func emitRecoverEscrowEvent(ctx sdk.Context, portID, channelID, classID, classPath, tokenID, recipient string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverEscrow,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyClassTrace, classPath),
+			sdk.NewAttribute(types.AttributeKeyTokenID, tokenID),
+			sdk.NewAttribute(types.AttributeKeyReceiver, recipient),
+		),
+	)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"slices"
+
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
@@ -10,8 +12,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SendTransfer handles nft-transfer sending logic.
-// A sending chain may be acting as a source or sink zone.
+// SendTransfer handles nft-transfer sending logic, and returns the sequence of the
+// sent packet. A sending chain may be acting as a source or sink zone.
 //
 // when a chain is sending tokens across a port and channel which are
 // not equal to the last prefixed port and channel pair, it is acting as a source zone.
@@ -46,87 +48,167 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
-) error {
+	memo string,
+) (uint64, error) {
 	if sourcePort != types.PortID {
-		return sdkerrors.Wrapf(types.ErrInvalidSourcePort, "source port must be %q", types.PortID)
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSourcePort, "source port must be %q", types.PortID)
 	}
 
-	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	if err := k.validateSend(ctx, sourceChannel, classID, tokenIDs); err != nil {
+		return 0, err
 	}
 
-	destinationPort := sourceChannelEnd.Counterparty.PortId
-	destinationChannel := sourceChannelEnd.Counterparty.ChannelId
-
-	// get the next sequence
-	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
-	if !found {
-		return sdkerrors.Wrapf(
-			channeltypes.ErrSequenceSendNotFound,
-			"source port: %s, source channel: %s", sourcePort, sourceChannel,
-		)
+	if _, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
 	// See spec for this logic: https://github.com/cosmos/ibc/blob/master/spec/app/ics-721-nft-transfer/README.md#packet-relay
-	packet, err := k.createOutgoingPacket(ctx,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		classID,
-		tokenIDs,
-		sender,
-		receiver,
-		sequence,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
+	packetData, err := k.createOutgoingPacketData(ctx, sourcePort, sourceChannel, classID, tokenIDs, sender, receiver, memo)
+	if err != nil {
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
 	if err != nil {
+		return 0, err
+	}
+
+	k.setInFlightTransfer(ctx, types.NewInFlightTransfer(
+		sourcePort, sourceChannel, sequence, classID, tokenIDs, packetData.TokenUris, sender.String(), receiver, timeoutHeight, timeoutTimestamp,
+	))
+	return sequence, nil
+}
+
+// OnSendPacket handles the sending logic of an IBC v2 payload, which is sent by the
+// IBC core rather than by this module. The NFTs described by the packet data are
+// escrowed or burnt like in SendTransfer, and the packet data is checked against
+// the NFTs on this chain, so that the counterparty mints faithful vouchers.
+func (k Keeper) OnSendPacket(
+	ctx sdk.Context,
+	sourcePort,
+	sourceClient string,
+	sequence uint64,
+	data types.NonFungibleTokenPacketData,
+	sender sdk.AccAddress,
+) error {
+	if sourcePort != types.PortID {
+		return sdkerrors.Wrapf(types.ErrInvalidSourcePort, "source port must be %q", types.PortID)
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+	if err := k.validateSend(ctx, sourceClient, classID, data.TokenIds); err != nil {
 		return err
 	}
 
-	if _, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packet.GetData()); err != nil {
+	expected, err := k.createOutgoingPacketData(ctx, sourcePort, sourceClient, classID, data.TokenIds, sender, data.Receiver, data.Memo)
+	if err != nil {
 		return err
 	}
+	if expected.ClassId != data.ClassId || expected.ClassUri != data.ClassUri || !slices.Equal(expected.TokenUris, data.TokenUris) {
+		return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet data does not match class %s on this chain", classID)
+	}
 
+	// the timeout of IBC v2 packets is not passed to the send callback
+	k.setInFlightTransfer(ctx, types.NewInFlightTransfer(
+		sourcePort, sourceClient, sequence, classID, data.TokenIds, data.TokenUris, sender.String(), data.Receiver, clienttypes.ZeroHeight(), 0,
+	))
 	return nil
 }
 
+// validateSend checks that NFTs of a class may be sent over a channel, or a client
+// for IBC v2, and counts them against the rate limit.
+func (k Keeper) validateSend(ctx sdk.Context, sourceChannel, classID string, tokenIDs []string) error {
+	params := k.GetParams(ctx)
+	if !params.IsSendEnabledClass(classID) {
+		return sdkerrors.Wrapf(types.ErrSendDisabled, "class %s cannot be sent", classID)
+	}
+	if !params.IsRouteEnabled(sourceChannel) {
+		return sdkerrors.Wrapf(types.ErrChannelDisabled, "channel %s", sourceChannel)
+	}
+	if err := params.ValidateTokens(classID, tokenIDs); err != nil {
+		return err
+	}
+	return k.consumeSendQuota(ctx, sourceChannel, classID, len(tokenIDs))
+}
+
 // OnRecvPacket processes a cross chain fungible token transfer. If the
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
 // unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet,
+//
+// The channels are the client IDs for IBC v2 packets.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
 	data types.NonFungibleTokenPacketData,
+	sourcePort,
+	sourceChannel,
+	destPort,
+	destChannel string,
 ) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return err
 	}
 
+	params := k.GetParams(ctx)
+	if !params.ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+	if !params.IsRouteEnabled(destChannel) {
+		return sdkerrors.Wrapf(types.ErrChannelDisabled, "channel %s", destChannel)
+	}
+	// the class ID limits apply to the class on this chain, not to its full path
+	classID := receivedClassID(data, sourcePort, sourceChannel, destPort, destChannel)
+	if err := params.ValidateTokens(classID, data.TokenIds); err != nil {
+		return err
+	}
+	if err := k.consumeReceiveQuota(ctx, destChannel, classID, len(data.TokenIds)); err != nil {
+		return err
+	}
+
 	// See spec for this logic: https://github.com/cosmos/ibc/blob/master/spec/app/ics-721-nft-transfer/README.md#packet-relay
-	return k.processReceivedPacket(ctx, packet, data)
+	return k.processReceivedPacket(ctx, data, sourcePort, sourceChannel, destPort, destChannel)
 }
 
 // OnAcknowledgementPacket responds to the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
-func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
-	switch ack.Response.(type) {
+// Either way, the packet is no longer recorded as an in-flight transfer.
+//
+// If the packet forwarded NFTs received by this chain, the acknowledgement of
+// the received packet is written as well.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	sequence uint64,
+	data types.NonFungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+) error {
+	k.deleteInFlightTransfer(ctx, sourcePort, sourceChannel, sequence)
+
+	switch resp := ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, data, sourcePort, sourceChannel); err != nil {
+			return err
+		}
+		return k.onForwardFailed(ctx, sourcePort, sourceChannel, sequence, resp.Error)
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
-		return nil
+		return k.onForwardSucceeded(ctx, sourcePort, sourceChannel, sequence)
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+// never received and has been timed out, and clears its in-flight transfer.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, sourcePort, sourceChannel string, sequence uint64, data types.NonFungibleTokenPacketData) error {
+	k.deleteInFlightTransfer(ctx, sourcePort, sourceChannel, sequence)
+
+	if err := k.refundPacketToken(ctx, data, sourcePort, sourceChannel); err != nil {
+		return err
+	}
+	return k.onForwardFailed(ctx, sourcePort, sourceChannel, sequence, "timeout")
 }
@@ -6,16 +6,22 @@ import (
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalClassTrace([]byte) types.ClassTrace
+	MustUnmarshalParams([]byte) types.Params
+	MustUnmarshalRateLimit([]byte) types.RateLimit
+	MustUnmarshalInFlightForward([]byte) types.InFlightForward
+	MustUnmarshalInFlightTransfer([]byte) types.InFlightTransfer
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding ClassTrace type.
+// Value to the corresponding ClassTrace, Params, RateLimit, escrow, InFlightForward or
+// InFlightTransfer type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,6 +33,36 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			classTraceB := cdc.MustUnmarshalClassTrace(kvB.Value)
 			return fmt.Sprintf("ClassTrace A: %s\nClassTrace B: %s", classTraceA.IBCClassID(), classTraceB.IBCClassID())
 
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			paramsA := cdc.MustUnmarshalParams(kvA.Value)
+			paramsB := cdc.MustUnmarshalParams(kvB.Value)
+			return fmt.Sprintf("Params A: %v\nParams B: %v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.RateLimitKey):
+			rateLimitA := cdc.MustUnmarshalRateLimit(kvA.Value)
+			rateLimitB := cdc.MustUnmarshalRateLimit(kvB.Value)
+			return fmt.Sprintf("RateLimit A: %v\nRateLimit B: %v", rateLimitA, rateLimitB)
+
+		case bytes.Equal(kvA.Key[:1], types.EscrowKey):
+			return fmt.Sprintf("EscrowedNFT A: %X\nEscrowedNFT B: %X", kvA.Key, kvB.Key)
+
+		case bytes.Equal(kvA.Key[:1], types.TotalEscrowKey):
+			return fmt.Sprintf("TotalEscrow A: %d\nTotalEscrow B: %d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ForwardKey):
+			forwardA := cdc.MustUnmarshalInFlightForward(kvA.Value)
+			forwardB := cdc.MustUnmarshalInFlightForward(kvB.Value)
+			return fmt.Sprintf("InFlightForward A: %v\nInFlightForward B: %v", forwardA, forwardB)
+
+		case bytes.Equal(kvA.Key[:1], types.InFlightTransferKey):
+			transferA := cdc.MustUnmarshalInFlightTransfer(kvA.Value)
+			transferB := cdc.MustUnmarshalInFlightTransfer(kvB.Value)
+			return fmt.Sprintf("InFlightTransfer A: %v\nInFlightTransfer B: %v", transferA, transferB)
+
+		case bytes.Equal(kvA.Key[:1], types.InFlightTransferSenderKey),
+			bytes.Equal(kvA.Key[:1], types.InFlightTransferClassKey):
+			return fmt.Sprintf("InFlightTransfer index A: %X\nInFlightTransfer index B: %X", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -13,7 +13,11 @@ import (
 )
 
 // Simulation parameter constants
-const port = "port_id"
+const (
+	port           = "port_id"
+	sendEnabled    = "send_enabled"
+	receiveEnabled = "receive_enabled"
+)
 
 // RadomEnabled randomized send or receive enabled param with 75% prob of being true.
 func RadomEnabled(r *rand.Rand) bool {
@@ -28,9 +32,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { portID = strings.ToLower(simtypes.RandStringOfLength(r, 20)) },
 	)
 
+	params := types.DefaultParams()
+	simState.AppParams.GetOrGenerate(
+		sendEnabled, &params.SendEnabled, simState.Rand,
+		func(r *rand.Rand) { params.SendEnabled = RadomEnabled(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		receiveEnabled, &params.ReceiveEnabled, simState.Rand,
+		func(r *rand.Rand) { params.ReceiveEnabled = RadomEnabled(r) },
+	)
+
 	transferGenesis := types.GenesisState{
 		PortId: portID,
 		Traces: types.Traces{},
+		Params: params,
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
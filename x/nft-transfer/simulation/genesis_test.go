@@ -44,6 +44,7 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, "euzxpfgkqegqiqwixnku", ibcTransferGenesis.PortId)
 	require.Len(t, ibcTransferGenesis.Traces, 0)
+	require.NoError(t, ibcTransferGenesis.Params.Validate())
 }
 
 // TestRandomizedGenState1 tests abnormal scenarios of applying RandomizedGenState.
@@ -22,12 +22,24 @@ var (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "cosmos-sdk/MsgTransferNFT", nil)
+	cdc.RegisterConcrete(&MsgTransferV2{}, "chainmain/nft-transfer/MsgTransferV2", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "chainmain/nft-transfer/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "chainmain/nft-transfer/MsgSetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "chainmain/nft-transfer/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRecoverEscrowedNFTs{}, "chainmain/nft-transfer/MsgRecoverEscrowedNFTs", nil)
 }
 
 // RegisterInterfaces register the ibc nft-transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransfer{},
+		&MsgTransferV2{},
+		&MsgUpdateParams{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgRecoverEscrowedNFTs{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -12,4 +12,16 @@ var (
 	ErrInvalidVersion      = sdkerrors.Register(ModuleName, 1505, "invalid ICS721 version")
 	ErrMaxTransferChannels = sdkerrors.Register(ModuleName, 1506, "max nft-transfer channels")
 	ErrInvalidSourcePort   = sdkerrors.Register(ModuleName, 1507, "invalid source port")
+	ErrSendDisabled        = sdkerrors.Register(ModuleName, 1508, "nft transfers from this chain are disabled")
+	ErrReceiveDisabled     = sdkerrors.Register(ModuleName, 1509, "nft transfers to this chain are disabled")
+	ErrChannelDisabled     = sdkerrors.Register(ModuleName, 1510, "nft transfers are disabled on this channel")
+	ErrInvalidRateLimit    = sdkerrors.Register(ModuleName, 1511, "invalid rate limit")
+	ErrRateLimitExceeded   = sdkerrors.Register(ModuleName, 1512, "rate limit exceeded")
+	ErrRateLimitNotFound   = sdkerrors.Register(ModuleName, 1513, "rate limit not found")
+	ErrInvalidMemo         = sdkerrors.Register(ModuleName, 1514, "invalid memo")
+	ErrForwardFailed       = sdkerrors.Register(ModuleName, 1515, "forwarded packet failed")
+
+	ErrEscrowNotRecoverable = sdkerrors.Register(ModuleName, 1516, "escrow not recoverable")
+	ErrNotEscrowed          = sdkerrors.Register(ModuleName, 1517, "nft not escrowed")
+	ErrEscrowInFlight       = sdkerrors.Register(ModuleName, 1518, "nft escrowed by an in-flight transfer")
 )
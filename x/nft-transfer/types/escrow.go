@@ -0,0 +1,91 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewEscrowedNFT creates a new EscrowedNFT instance
+func NewEscrowedNFT(portID, channelID, classID, tokenID string) EscrowedNFT {
+	return EscrowedNFT{
+		PortId:    portID,
+		ChannelId: channelID,
+		ClassId:   classID,
+		TokenId:   tokenID,
+	}
+}
+
+// Validate performs basic validation of an escrowed NFT
+func (e EscrowedNFT) Validate() error {
+	if err := host.PortIdentifierValidator(e.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid port %s", e.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(e.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel %s", e.ChannelId)
+	}
+	if strings.TrimSpace(e.ClassId) == "" || strings.ContainsRune(e.ClassId, 0) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "invalid escrowed class %q", e.ClassId)
+	}
+	if strings.TrimSpace(e.TokenId) == "" {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "escrowed tokenId cannot be blank")
+	}
+	return nil
+}
+
+// NewEscrowRecovery creates a new EscrowRecovery instance
+func NewEscrowRecovery(classID, tokenID, recipient string) EscrowRecovery {
+	return EscrowRecovery{
+		ClassId:   classID,
+		TokenId:   tokenID,
+		Recipient: recipient,
+	}
+}
+
+// Validate performs basic validation of an escrow recovery
+func (r EscrowRecovery) Validate() error {
+	if strings.TrimSpace(r.ClassId) == "" || strings.ContainsRune(r.ClassId, 0) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "invalid recovered class %q", r.ClassId)
+	}
+	if strings.TrimSpace(r.TokenId) == "" {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "recovered tokenId cannot be blank")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// ParseEscrowKey decodes an escrowed NFT from its full store key
+func ParseEscrowKey(key []byte) (EscrowedNFT, error) {
+	if !bytes.HasPrefix(key, EscrowKey) {
+		return EscrowedNFT{}, fmt.Errorf("invalid escrow key %X", key)
+	}
+	// port and channel identifiers cannot contain a slash
+	parts := bytes.SplitN(key[len(EscrowKey):], []byte{'/'}, 3)
+	if len(parts) != 3 {
+		return EscrowedNFT{}, fmt.Errorf("invalid escrow key %X", key)
+	}
+	classID, tokenID, err := ParseEscrowClassKey(parts[2])
+	if err != nil {
+		return EscrowedNFT{}, err
+	}
+	return NewEscrowedNFT(string(parts[0]), string(parts[1]), classID, tokenID), nil
+}
+
+// ParseEscrowClassKey decodes the class and token IDs from an escrow key stripped of
+// its port and channel prefix
+func ParseEscrowClassKey(key []byte) (classID, tokenID string, err error) {
+	i := bytes.IndexByte(key, 0)
+	if i < 0 {
+		return "", "", fmt.Errorf("invalid escrow key %X", key)
+	}
+	return string(key[:i]), string(key[i+1:]), nil
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrowedNFT_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		escrow  types.EscrowedNFT
+		wantErr bool
+	}{
+		{"valid", types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "kitty1"), false},
+		{"ibc class", types.NewEscrowedNFT("nft-transfer", "channel-0", "ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", "kitty1"), false},
+		{"invalid port", types.NewEscrowedNFT("(port)", "channel-0", "kitty", "kitty1"), true},
+		{"invalid channel", types.NewEscrowedNFT("nft-transfer", "@channel-0", "kitty", "kitty1"), true},
+		{"blank class", types.NewEscrowedNFT("nft-transfer", "channel-0", " ", "kitty1"), true},
+		{"class with zero byte", types.NewEscrowedNFT("nft-transfer", "channel-0", "kit\x00ty", "kitty1"), true},
+		{"blank token", types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", ""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.escrow.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("EscrowedNFT.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseEscrowKey(t *testing.T) {
+	escrows := []types.EscrowedNFT{
+		types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "kitty1"),
+		// class and token IDs may contain slashes
+		types.NewEscrowedNFT("nft-transfer", "channel-0", "ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", "token/with/slash"),
+	}
+	for _, escrow := range escrows {
+		key := types.GetEscrowKey(escrow.PortId, escrow.ChannelId, escrow.ClassId, escrow.TokenId)
+		got, err := types.ParseEscrowKey(key)
+		require.NoError(t, err)
+		require.Equal(t, escrow, got)
+	}
+
+	// a class is not a prefix of a longer class
+	require.NotEqual(t,
+		types.GetEscrowClassPrefix("nft-transfer", "channel-0", "kitty"),
+		types.GetEscrowClassPrefix("nft-transfer", "channel-0", "kitty2")[:len(types.GetEscrowClassPrefix("nft-transfer", "channel-0", "kitty"))],
+	)
+
+	_, err := types.ParseEscrowKey(types.GetTotalEscrowKey("kitty"))
+	require.Error(t, err)
+	_, err = types.ParseEscrowKey(append(types.GetEscrowChannelPrefix("nft-transfer", "channel-0"), "kitty"...))
+	require.Error(t, err)
+}
+
+func TestEscrowRecovery_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		recovery types.EscrowRecovery
+		wantErr  bool
+	}{
+		{"valid", types.NewEscrowRecovery("kitty", "kitty1", receiver), false},
+		{"blank class", types.NewEscrowRecovery(" ", "kitty1", receiver), true},
+		{"blank token", types.NewEscrowRecovery("kitty", "", receiver), true},
+		{"invalid recipient", types.NewEscrowRecovery("kitty", "kitty1", "recipient"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.recovery.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("EscrowRecovery.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "non_fungible_token_packet"
-	EventTypeTransfer     = "ibc_nft_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeClassTrace   = "class_trace"
+	EventTypeTimeout       = "timeout"
+	EventTypePacket        = "non_fungible_token_packet"
+	EventTypeTransfer      = "ibc_nft_transfer"
+	EventTypeChannelClose  = "channel_closed"
+	EventTypeClassTrace    = "class_trace"
+	EventTypeForward       = "nft_forward"
+	EventTypeRecoverEscrow = "recover_escrowed_nft"
 
 	AttributeKeyReceiver   = "receiver"
 	AttributeKeyClassID    = "classID"
@@ -14,4 +16,12 @@ const (
 	AttributeKeyAckSuccess = "success"
 	AttributeKeyAckError   = "error"
 	AttributeKeyTraceHash  = "trace_hash"
+	AttributeKeyClassTrace = "class_trace"
+	AttributeKeyTokenID    = "tokenID"
+	AttributeKeyPortID     = "port_id"
+	AttributeKeyChannelID  = "channel_id"
+
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
 )
@@ -5,6 +5,8 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	nftexported "github.com/crypto-org-chain/chain-main/v8/x/nft/exported"
 	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
@@ -21,12 +23,31 @@ type ICS4Wrapper interface {
 		timeoutTimestamp uint64,
 		data []byte,
 	) (uint64, error)
+
+	WriteAcknowledgement(
+		ctx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+	) error
+}
+
+// ChannelKeeperV2 defines the expected IBC v2 channel keeper
+type ChannelKeeperV2 interface {
+	SendPacket(ctx context.Context, msg *channeltypesv2.MsgSendPacket) (*channeltypesv2.MsgSendPacketResponse, error)
+	WriteAcknowledgement(ctx sdk.Context, clientID string, sequence uint64, ack channeltypesv2.Acknowledgement) error
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientID string) ibcexported.Status
 }
 
 // NFTKeeper defines the expected nft keeper
@@ -36,6 +57,7 @@ type NFTKeeper interface {
 	IssueDenom(ctx sdk.Context, id, name, schema, uri string, creator sdk.AccAddress) error
 
 	GetNFT(ctx sdk.Context, denomID, tokenID string) (nft nftexported.NFT, err error)
+	GetOwner(ctx sdk.Context, address sdk.AccAddress, denom string) (nfttypes.Owner, error)
 	MintNFT(
 		ctx sdk.Context, denomID, tokenID, tokenNm,
 		tokenURI, tokenData string, sender, owner sdk.AccAddress,
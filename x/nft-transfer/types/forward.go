@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// DefaultForwardTimeout is the timeout of a forwarded packet, relative to the block
+// time of the forwarding chain, when the forwarding instruction does not set one
+const DefaultForwardTimeout = 10 * time.Minute
+
+// ForwardMemoKey is the key of the forwarding instruction in a JSON memo
+const ForwardMemoKey = "forward"
+
+// ForwardMetadata is the instruction in the memo of a packet to forward the received
+// NFTs to another chain, for example:
+//
+//	{"forward": {"receiver": "cro1...", "port": "nft", "channel": "channel-1", "timeout": "10m", "next": {...}}}
+//
+// The channel is a client ID when the NFTs were received over IBC v2. The optional
+// next field is the memo of the forwarded packet, so that NFTs can be forwarded over
+// multiple hops.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forwarding instruction in a memo, or nil if there
+// is none. Memos that are not JSON objects hold no instruction.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil
+	}
+	raw, found := fields[ForwardMemoKey]
+	if !found {
+		return nil, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(raw, &metadata); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward metadata: %v", err)
+	}
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+	return &metadata, nil
+}
+
+// Validate performs basic validation of a forwarding instruction
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidMemo, "forward receiver cannot be blank")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward port %s: %v", m.Port, err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward channel %s: %v", m.Channel, err)
+	}
+	if _, err := m.GetTimeout(); err != nil {
+		return err
+	}
+	if _, err := m.GetNextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the timeout of the forwarded packet
+func (m ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidMemo, "invalid forward timeout %s: %v", m.Timeout, err)
+	}
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidMemo, "forward timeout %s must be positive", m.Timeout)
+	}
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forwarded packet. The next field is either
+// a JSON object, which is used as is, or a string holding the memo.
+func (m ForwardMetadata) GetNextMemo() (string, error) {
+	if len(m.Next) == 0 {
+		return "", nil
+	}
+	var memo string
+	if err := json.Unmarshal(m.Next, &memo); err == nil {
+		return memo, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(m.Next, &object); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidMemo, "forward next must be a JSON object or a string")
+	}
+	return string(m.Next), nil
+}
+
+// Validate performs basic validation of an in-flight forward
+func (f InFlightForward) Validate() error {
+	if err := host.PortIdentifierValidator(f.ForwardPort); err != nil {
+		return sdkerrors.Wrapf(err, "invalid forward port %s", f.ForwardPort)
+	}
+	if err := host.ChannelIdentifierValidator(f.ForwardChannel); err != nil {
+		return sdkerrors.Wrapf(err, "invalid forward channel %s", f.ForwardChannel)
+	}
+	if err := host.PortIdentifierValidator(f.DestinationPort); err != nil {
+		return sdkerrors.Wrapf(err, "invalid destination port %s", f.DestinationPort)
+	}
+	if err := host.ChannelIdentifierValidator(f.DestinationChannel); err != nil {
+		return sdkerrors.Wrapf(err, "invalid destination channel %s", f.DestinationChannel)
+	}
+	if f.Packet != nil {
+		if err := f.Packet.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return f.Data.ValidateBasic()
+}
@@ -0,0 +1,790 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/nft_transfer/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightForward is a received packet whose NFTs were forwarded to another chain,
+// as instructed by its memo. The acknowledgement of the received packet is written
+// once the forwarded packet is acknowledged or times out.
+type InFlightForward struct {
+	// forward_port is the port the NFTs were forwarded on.
+	ForwardPort string `protobuf:"bytes,1,opt,name=forward_port,json=forwardPort,proto3" json:"forward_port,omitempty"`
+	// forward_channel is the channel the NFTs were forwarded on, or the client ID
+	// for IBC v2 packets.
+	ForwardChannel string `protobuf:"bytes,2,opt,name=forward_channel,json=forwardChannel,proto3" json:"forward_channel,omitempty"`
+	// forward_sequence is the sequence of the forwarded packet.
+	ForwardSequence uint64 `protobuf:"varint,3,opt,name=forward_sequence,json=forwardSequence,proto3" json:"forward_sequence,omitempty"`
+	// source_port is the source port of the received packet.
+	SourcePort string `protobuf:"bytes,4,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// source_channel is the source channel of the received packet, or the client ID
+	// for IBC v2 packets.
+	SourceChannel string `protobuf:"bytes,5,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// destination_port is the destination port of the received packet.
+	DestinationPort string `protobuf:"bytes,6,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// destination_channel is the destination channel of the received packet, or the
+	// client ID for IBC v2 packets.
+	DestinationChannel string `protobuf:"bytes,7,opt,name=destination_channel,json=destinationChannel,proto3" json:"destination_channel,omitempty"`
+	// sequence is the sequence of the received packet.
+	Sequence uint64 `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// data is the packet data of the received packet.
+	Data NonFungibleTokenPacketData `protobuf:"bytes,9,opt,name=data,proto3" json:"data"`
+	// packet is the received IBC v1 packet, to which the acknowledgement is written.
+	// It is unset for IBC v2 packets, which are kept by the IBC core.
+	Packet *types.Packet `protobuf:"bytes,10,opt,name=packet,proto3" json:"packet,omitempty"`
+}
+
+func (m *InFlightForward) Reset()         { *m = InFlightForward{} }
+func (m *InFlightForward) String() string { return proto.CompactTextString(m) }
+func (*InFlightForward) ProtoMessage()    {}
+func (*InFlightForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe7f63513884653d, []int{0}
+}
+func (m *InFlightForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightForward.Merge(m, src)
+}
+func (m *InFlightForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightForward proto.InternalMessageInfo
+
+func (m *InFlightForward) GetForwardPort() string {
+	if m != nil {
+		return m.ForwardPort
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetForwardChannel() string {
+	if m != nil {
+		return m.ForwardChannel
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetForwardSequence() uint64 {
+	if m != nil {
+		return m.ForwardSequence
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetDestinationPort() string {
+	if m != nil {
+		return m.DestinationPort
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetDestinationChannel() string {
+	if m != nil {
+		return m.DestinationChannel
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetData() NonFungibleTokenPacketData {
+	if m != nil {
+		return m.Data
+	}
+	return NonFungibleTokenPacketData{}
+}
+
+func (m *InFlightForward) GetPacket() *types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InFlightForward)(nil), "chainmain.nft_transfer.v1.InFlightForward")
+}
+
+func init() {
+	proto.RegisterFile("chainmain/nft_transfer/v1/forward.proto", fileDescriptor_fe7f63513884653d)
+}
+
+var fileDescriptor_fe7f63513884653d = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0x56, 0xca, 0xe6, 0xc2, 0x8a, 0x0c, 0x87, 0x50, 0xa4, 0xac, 0x43, 0x82, 0x95,
+	0x43, 0x6c, 0x95, 0x89, 0x13, 0xb7, 0x81, 0x2a, 0x71, 0x81, 0x29, 0xc0, 0x85, 0xcb, 0xe4, 0xb8,
+	0x6e, 0x62, 0xad, 0xb3, 0x83, 0xe3, 0x14, 0xf6, 0x2d, 0xf8, 0x58, 0x3b, 0xa1, 0x1d, 0x39, 0x21,
+	0xd4, 0x7e, 0x91, 0x29, 0xcf, 0x4e, 0x94, 0x4b, 0x2f, 0x95, 0xfb, 0xcf, 0xcf, 0xbf, 0xf7, 0xac,
+	0xf7, 0xd0, 0x09, 0xcf, 0x99, 0x54, 0x57, 0x4c, 0x2a, 0xaa, 0x96, 0xf6, 0xc2, 0x1a, 0xa6, 0xca,
+	0xa5, 0x30, 0x74, 0x3d, 0xa3, 0x4b, 0x6d, 0x7e, 0x32, 0xb3, 0x20, 0x85, 0xd1, 0x56, 0xe3, 0x67,
+	0x2d, 0x48, 0xba, 0x20, 0x59, 0xcf, 0xc6, 0xaf, 0x76, 0x3b, 0x0a, 0xc6, 0x2f, 0x85, 0x75, 0x8a,
+	0xf1, 0xd3, 0x4c, 0x67, 0x1a, 0x8e, 0xb4, 0x3e, 0xf9, 0xf4, 0x58, 0xa6, 0x9c, 0x72, 0x6d, 0x04,
+	0xe5, 0x39, 0x53, 0x4a, 0xac, 0xea, 0x7b, 0xfe, 0xe8, 0x90, 0x17, 0x7f, 0xf6, 0xd0, 0xe8, 0xa3,
+	0x9a, 0xaf, 0x64, 0x96, 0xdb, 0xb9, 0xeb, 0x0a, 0x1f, 0xa3, 0x87, 0xbe, 0xc1, 0x8b, 0x42, 0x1b,
+	0x1b, 0x06, 0x93, 0x60, 0x7a, 0x90, 0x0c, 0x7d, 0x76, 0xae, 0x8d, 0xc5, 0x27, 0x68, 0xd4, 0x20,
+	0xde, 0x17, 0xde, 0x03, 0xea, 0xd0, 0xc7, 0xef, 0x5d, 0x8a, 0x5f, 0xa3, 0xc7, 0x0d, 0x58, 0x8a,
+	0x1f, 0x95, 0x50, 0x5c, 0x84, 0x7b, 0x93, 0x60, 0xda, 0x4f, 0x1a, 0xc1, 0x17, 0x1f, 0xe3, 0x23,
+	0x34, 0x2c, 0x75, 0x65, 0xb8, 0x70, 0x55, 0xfb, 0xe0, 0x43, 0x2e, 0x82, 0xa2, 0x2f, 0xd1, 0xa1,
+	0x07, 0x9a, 0x9a, 0xf7, 0x81, 0x79, 0xe4, 0xd2, 0x4e, 0xc9, 0x85, 0x28, 0xad, 0x54, 0xcc, 0x4a,
+	0xad, 0x9c, 0x6c, 0x00, 0xe0, 0xa8, 0x93, 0x83, 0x91, 0xa2, 0x27, 0x5d, 0xb4, 0xd1, 0x3e, 0x00,
+	0x1a, 0x77, 0x3e, 0x35, 0xee, 0x31, 0xda, 0x6f, 0x9f, 0xb1, 0x0f, 0xcf, 0x68, 0xff, 0xe3, 0xcf,
+	0xa8, 0xbf, 0x60, 0x96, 0x85, 0x07, 0x93, 0x60, 0x3a, 0x7c, 0xf3, 0x96, 0xec, 0x9c, 0x2a, 0xf9,
+	0xa4, 0xd5, 0xbc, 0x52, 0x99, 0x4c, 0x57, 0xe2, 0xab, 0xbe, 0x14, 0xea, 0x1c, 0x46, 0xf9, 0x81,
+	0x59, 0x76, 0xd6, 0xbf, 0xf9, 0x77, 0xd4, 0x4b, 0x40, 0x84, 0x4f, 0xd1, 0xc0, 0x0d, 0x39, 0x44,
+	0xa0, 0x7c, 0x4e, 0x64, 0xca, 0x49, 0x3d, 0x4f, 0xd2, 0x0c, 0x71, 0x3d, 0x23, 0xee, 0x72, 0xe2,
+	0xd1, 0xb3, 0x6f, 0x37, 0x9b, 0x28, 0xb8, 0xdd, 0x44, 0xc1, 0xff, 0x4d, 0x14, 0xfc, 0xde, 0x46,
+	0xbd, 0xdb, 0x6d, 0xd4, 0xfb, 0xbb, 0x8d, 0x7a, 0xdf, 0xdf, 0x65, 0xd2, 0xe6, 0x55, 0x4a, 0xb8,
+	0xbe, 0xa2, 0xdc, 0x5c, 0x17, 0x56, 0xc7, 0xda, 0x64, 0x31, 0xb4, 0x49, 0xe1, 0x37, 0x86, 0x45,
+	0xfb, 0x55, 0xaf, 0x5a, 0xdc, 0xae, 0x9a, 0xbd, 0x2e, 0x44, 0x99, 0x0e, 0x60, 0x5d, 0x4e, 0xef,
+	0x06, 0x00, 0xde, 0x14, 0x36, 0x7e, 0xd5, 0x02, 0x00, 0x00,
+}
+
+func (m *InFlightForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintForward(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForward(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Sequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DestinationChannel) > 0 {
+		i -= len(m.DestinationChannel)
+		copy(dAtA[i:], m.DestinationChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.DestinationChannel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestinationPort) > 0 {
+		i -= len(m.DestinationPort)
+		copy(dAtA[i:], m.DestinationPort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.DestinationPort)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ForwardSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.ForwardSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ForwardChannel) > 0 {
+		i -= len(m.ForwardChannel)
+		copy(dAtA[i:], m.ForwardChannel)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardPort) > 0 {
+		i -= len(m.ForwardPort)
+		copy(dAtA[i:], m.ForwardPort)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ForwardPort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForwardPort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.ForwardChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.ForwardSequence != 0 {
+		n += 1 + sovForward(uint64(m.ForwardSequence))
+	}
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.DestinationPort)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.DestinationChannel)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovForward(uint64(m.Sequence))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovForward(uint64(l))
+	if m.Packet != nil {
+		l = m.Packet.Size()
+		n += 1 + l + sovForward(uint64(l))
+	}
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardSequence", wireType)
+			}
+			m.ForwardSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Packet == nil {
+				m.Packet = &types.Packet{}
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
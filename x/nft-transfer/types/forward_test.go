@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		memo    string
+		found   bool
+		wantErr bool
+	}{
+		{"empty memo", "", false, false},
+		{"text memo", "happy birthday", false, false},
+		{"other instruction", `{"wasm":{"contract":"cro1"}}`, false, false},
+		{"forward", `{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1"}}`, true, false},
+		{"forward with timeout and next", `{"forward":{"receiver":"cro1","port":"nft","channel":"07-tendermint-0","timeout":"1h","next":{"forward":{}}}}`, true, false},
+		{"forward with next string", `{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","next":"{}"}}`, true, false},
+		{"forward not an object", `{"forward":"channel-1"}`, false, true},
+		{"blank receiver", `{"forward":{"receiver":" ","port":"nft","channel":"channel-1"}}`, false, true},
+		{"invalid port", `{"forward":{"receiver":"cro1","port":"@nft","channel":"channel-1"}}`, false, true},
+		{"invalid channel", `{"forward":{"receiver":"cro1","port":"nft","channel":""}}`, false, true},
+		{"invalid timeout", `{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","timeout":"soon"}}`, false, true},
+		{"negative timeout", `{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","timeout":"-1m"}}`, false, true},
+		{"invalid next", `{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","next":[1]}}`, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := types.ParseForwardMetadata(tt.memo)
+			if tt.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidMemo)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.found, metadata != nil)
+		})
+	}
+}
+
+func TestForwardMetadata_Getters(t *testing.T) {
+	metadata, err := types.ParseForwardMetadata(`{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1"}}`)
+	require.NoError(t, err)
+	timeout, err := metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultForwardTimeout, timeout)
+	memo, err := metadata.GetNextMemo()
+	require.NoError(t, err)
+	require.Empty(t, memo)
+
+	metadata, err = types.ParseForwardMetadata(`{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","timeout":"1h","next":{"forward":{"channel":"channel-2"}}}}`)
+	require.NoError(t, err)
+	timeout, err = metadata.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, timeout)
+	memo, err = metadata.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"channel":"channel-2"}}`, memo)
+
+	metadata, err = types.ParseForwardMetadata(`{"forward":{"receiver":"cro1","port":"nft","channel":"channel-1","next":"thanks"}}`)
+	require.NoError(t, err)
+	memo, err = metadata.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, "thanks", memo)
+}
@@ -1,22 +1,30 @@
 package types
 
 import (
+	"fmt"
+
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 // NewGenesisState creates a new ibc nft-transfer GenesisState instance.
-func NewGenesisState(portID string, traces Traces) *GenesisState {
+func NewGenesisState(portID string, traces Traces, params Params) *GenesisState {
 	return &GenesisState{
 		PortId: portID,
 		Traces: traces,
+		Params: params,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "nft-transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId: PortID,
-		Traces: Traces{},
+		PortId:            PortID,
+		Traces:            Traces{},
+		Params:            DefaultParams(),
+		RateLimits:        []RateLimit{},
+		EscrowedNfts:      []EscrowedNFT{},
+		InFlightForwards:  []InFlightForward{},
+		InFlightTransfers: []InFlightTransfer{},
 	}
 }
 
@@ -26,5 +34,59 @@ func (gs GenesisState) Validate() error {
 	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
 		return err
 	}
-	return gs.Traces.Validate()
+	if err := gs.Traces.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenRateLimits := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		key := string(GetRateLimitKey(rateLimit.ChannelId, rateLimit.ClassId))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicate rate limit for class %s on channel %s", rateLimit.ClassId, rateLimit.ChannelId)
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenEscrows := make(map[string]bool, len(gs.EscrowedNfts))
+	for _, escrow := range gs.EscrowedNfts {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+		key := string(GetEscrowKey(escrow.PortId, escrow.ChannelId, escrow.ClassId, escrow.TokenId))
+		if seenEscrows[key] {
+			return fmt.Errorf("duplicate escrowed nft %s/%s on %s/%s", escrow.ClassId, escrow.TokenId, escrow.PortId, escrow.ChannelId)
+		}
+		seenEscrows[key] = true
+	}
+
+	seenForwards := make(map[string]bool, len(gs.InFlightForwards))
+	for _, forward := range gs.InFlightForwards {
+		if err := forward.Validate(); err != nil {
+			return err
+		}
+		key := string(GetForwardKey(forward.ForwardPort, forward.ForwardChannel, forward.ForwardSequence))
+		if seenForwards[key] {
+			return fmt.Errorf("duplicate in-flight forward %d on %s/%s", forward.ForwardSequence, forward.ForwardPort, forward.ForwardChannel)
+		}
+		seenForwards[key] = true
+	}
+
+	seenTransfers := make(map[string]bool, len(gs.InFlightTransfers))
+	for _, transfer := range gs.InFlightTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		key := string(GetInFlightTransferKey(transfer.PortId, transfer.ChannelId, transfer.Sequence))
+		if seenTransfers[key] {
+			return fmt.Errorf("duplicate in-flight transfer %d on %s/%s", transfer.Sequence, transfer.PortId, transfer.ChannelId)
+		}
+		seenTransfers[key] = true
+	}
+	return nil
 }
@@ -25,8 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc-nft-transfer genesis state
 type GenesisState struct {
-	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
-	Traces Traces `protobuf:"bytes,2,rep,name=traces,proto3,castrepeated=Traces" json:"traces"`
+	PortId            string             `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Traces            Traces             `protobuf:"bytes,2,rep,name=traces,proto3,castrepeated=Traces" json:"traces"`
+	Params            Params             `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	RateLimits        []RateLimit        `protobuf:"bytes,4,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	EscrowedNfts      []EscrowedNFT      `protobuf:"bytes,5,rep,name=escrowed_nfts,json=escrowedNfts,proto3" json:"escrowed_nfts"`
+	InFlightForwards  []InFlightForward  `protobuf:"bytes,6,rep,name=in_flight_forwards,json=inFlightForwards,proto3" json:"in_flight_forwards"`
+	InFlightTransfers []InFlightTransfer `protobuf:"bytes,7,rep,name=in_flight_transfers,json=inFlightTransfers,proto3" json:"in_flight_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +81,41 @@ func (m *GenesisState) GetTraces() Traces {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowedNfts() []EscrowedNFT {
+	if m != nil {
+		return m.EscrowedNfts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInFlightForwards() []InFlightForward {
+	if m != nil {
+		return m.InFlightForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInFlightTransfers() []InFlightTransfer {
+	if m != nil {
+		return m.InFlightTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "chainmain.nft_transfer.v1.GenesisState")
 }
@@ -85,23 +125,36 @@ func init() {
 }
 
 var fileDescriptor_49c17ad52dcafd12 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0x4d, 0xcc, 0xcc, 0xd3, 0xcf, 0x4b, 0x2b, 0x89, 0x2f, 0x29, 0x4a, 0xcc, 0x2b, 0x4e,
-	0x4b, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x2b, 0xd4, 0x43, 0x56, 0xa8, 0x57, 0x66, 0x28, 0xa5, 0x8a,
-	0xdb, 0x8c, 0x92, 0xa2, 0xc4, 0xe4, 0x54, 0x88, 0x09, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x2a, 0xe3, 0xe2, 0x71, 0x87, 0x58, 0x14, 0x5c, 0x92, 0x58,
-	0x92, 0x2a, 0x24, 0xce, 0xc5, 0x5e, 0x90, 0x5f, 0x54, 0x12, 0x9f, 0x99, 0x22, 0xc1, 0xa8, 0xc0,
-	0xa8, 0xc1, 0x19, 0xc4, 0x06, 0xe2, 0x7a, 0xa6, 0x08, 0xf9, 0x72, 0xb1, 0x81, 0x4d, 0x2b, 0x96,
-	0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd5, 0xc3, 0xe9, 0x22, 0x3d, 0xe7, 0x9c, 0xc4, 0xe2,
-	0xe2, 0x10, 0x90, 0x6a, 0x27, 0xbe, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0x67, 0x03, 0x73,
-	0x8b, 0x83, 0xa0, 0x86, 0x38, 0x85, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
-	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
-	0x94, 0x75, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x72, 0x51, 0x65,
-	0x41, 0x49, 0xbe, 0x6e, 0x7e, 0x51, 0xba, 0x2e, 0xd8, 0x36, 0x7d, 0x30, 0xa9, 0x0b, 0xf6, 0x6b,
-	0x05, 0xc8, 0xb7, 0xba, 0x70, 0xdf, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x65,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x2b, 0x9c, 0x7b, 0x58, 0x01, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0x87, 0x13, 0x56, 0x32, 0xe1, 0x0e, 0x04, 0x06, 0x89, 0xd0, 0x43, 0x56, 0x10, 0x1b, 0x01,
+	0x94, 0x44, 0x1b, 0x47, 0x0e, 0x48, 0x45, 0x14, 0x4d, 0xc0, 0x04, 0xa1, 0x5c, 0x38, 0x10, 0x79,
+	0x89, 0x93, 0x5a, 0x6a, 0xec, 0xc8, 0xaf, 0xd9, 0xd8, 0xb7, 0xe0, 0xcc, 0x47, 0xe0, 0x93, 0xec,
+	0xb8, 0x23, 0x27, 0x40, 0xed, 0x17, 0x41, 0x71, 0x9c, 0xf2, 0x47, 0x4a, 0xc4, 0x25, 0xb2, 0x5f,
+	0x3d, 0x7e, 0x7e, 0xce, 0xeb, 0x17, 0xdd, 0x4b, 0xe7, 0x84, 0xf1, 0x92, 0x30, 0x1e, 0xf1, 0x5c,
+	0x25, 0x4a, 0x12, 0x0e, 0x39, 0x95, 0xd1, 0xf1, 0x5e, 0x54, 0x50, 0x4e, 0x81, 0x41, 0x58, 0x49,
+	0xa1, 0x04, 0xbe, 0xb5, 0x06, 0xc3, 0x3f, 0xc1, 0xf0, 0x78, 0x6f, 0xb4, 0xdb, 0xed, 0xa0, 0x90,
+	0x4a, 0x71, 0xd2, 0x28, 0x46, 0x3d, 0x59, 0xb9, 0x90, 0x27, 0x44, 0x66, 0x06, 0xf4, 0xbb, 0x41,
+	0xc6, 0xf3, 0x05, 0x2b, 0xe6, 0xca, 0x90, 0x3d, 0xd1, 0x15, 0x91, 0xa4, 0x34, 0xb7, 0x1f, 0xdd,
+	0xef, 0xe6, 0x24, 0x51, 0x74, 0xc1, 0x4a, 0xd6, 0x2a, 0x77, 0xba, 0x51, 0x25, 0x49, 0x4a, 0x0d,
+	0x76, 0xa3, 0x10, 0x85, 0xd0, 0xcb, 0xa8, 0x5e, 0x35, 0xd5, 0x3b, 0x5f, 0x06, 0x68, 0xeb, 0x79,
+	0xd3, 0xb7, 0xb7, 0x8a, 0x28, 0x8a, 0x6f, 0xa2, 0xcd, 0x4a, 0x48, 0x95, 0xb0, 0xcc, 0xb5, 0xc7,
+	0xb6, 0x7f, 0x29, 0x76, 0xea, 0xed, 0x41, 0x86, 0x5f, 0x21, 0x47, 0xeb, 0xc0, 0xbd, 0x30, 0xde,
+	0xf0, 0x87, 0xfb, 0x3b, 0x61, 0x67, 0x83, 0xc3, 0xa7, 0x0b, 0x02, 0x30, 0xab, 0xe9, 0xc9, 0x95,
+	0xb3, 0xef, 0xdb, 0xd6, 0xd7, 0x1f, 0xdb, 0x8e, 0xde, 0x42, 0x6c, 0x24, 0xf8, 0x09, 0x72, 0x9a,
+	0x1f, 0x76, 0x37, 0xc6, 0xb6, 0x3f, 0xdc, 0xbf, 0xdd, 0xa3, 0x7b, 0xad, 0xc1, 0xc9, 0xa0, 0x56,
+	0xc5, 0xe6, 0x18, 0x7e, 0x81, 0x86, 0x75, 0x27, 0x12, 0xdd, 0x0a, 0x70, 0x07, 0xfa, 0x52, 0x77,
+	0x7b, 0x2c, 0x31, 0x51, 0xf4, 0x65, 0x0d, 0x1b, 0x11, 0x92, 0x6d, 0x01, 0xf0, 0x1b, 0x74, 0xb9,
+	0x79, 0x79, 0x9a, 0x25, 0x3c, 0x57, 0xe0, 0x5e, 0xd4, 0xba, 0xdd, 0x1e, 0xdd, 0x33, 0xc3, 0x1f,
+	0x4e, 0x67, 0x46, 0xb8, 0xd5, 0x2a, 0x0e, 0x73, 0x05, 0xf8, 0x03, 0xc2, 0x8c, 0x27, 0xcd, 0xe3,
+	0x27, 0x66, 0x5c, 0xc0, 0x75, 0xb4, 0xf7, 0x41, 0x8f, 0xf7, 0x80, 0x4f, 0xf5, 0x99, 0x69, 0x73,
+	0xc4, 0xb8, 0xaf, 0xb2, 0xbf, 0xcb, 0x80, 0x09, 0xba, 0xfe, 0xdb, 0xdf, 0x0a, 0xc0, 0xdd, 0xd4,
+	0x01, 0x0f, 0xff, 0x23, 0x60, 0x66, 0x6a, 0x26, 0xe1, 0x1a, 0xfb, 0xa7, 0x0e, 0x93, 0x77, 0x67,
+	0x4b, 0xcf, 0x3e, 0x5f, 0x7a, 0xf6, 0xcf, 0xa5, 0x67, 0x7f, 0x5e, 0x79, 0xd6, 0xf9, 0xca, 0xb3,
+	0xbe, 0xad, 0x3c, 0xeb, 0xfd, 0xe3, 0x82, 0xa9, 0xf9, 0xc7, 0xa3, 0x30, 0x15, 0x65, 0x94, 0xca,
+	0xd3, 0x4a, 0x89, 0x40, 0xc8, 0x22, 0xd0, 0xa1, 0x91, 0xfe, 0x06, 0x7a, 0x20, 0x3f, 0xd5, 0x23,
+	0x19, 0xac, 0x47, 0x52, 0x9d, 0x56, 0x14, 0x8e, 0x1c, 0x3d, 0x7a, 0x8f, 0x7e, 0x0d, 0x00, 0xf8,
+	0x85, 0x51, 0x11, 0xcb, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +177,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InFlightTransfers) > 0 {
+		for iNdEx := len(m.InFlightTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.InFlightForwards) > 0 {
+		for iNdEx := len(m.InFlightForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EscrowedNfts) > 0 {
+		for iNdEx := len(m.EscrowedNfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedNfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Traces) > 0 {
 		for iNdEx := len(m.Traces) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +294,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowedNfts) > 0 {
+		for _, e := range m.EscrowedNfts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightForwards) > 0 {
+		for _, e := range m.InFlightForwards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightTransfers) > 0 {
+		for _, e := range m.InFlightTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -279,6 +424,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedNfts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedNfts = append(m.EscrowedNfts, EscrowedNFT{})
+			if err := m.EscrowedNfts[len(m.EscrowedNfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightForwards = append(m.InFlightForwards, InFlightForward{})
+			if err := m.InFlightForwards[len(m.InFlightForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightTransfers = append(m.InFlightTransfers, InFlightTransfer{})
+			if err := m.InFlightTransfers[len(m.InFlightTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
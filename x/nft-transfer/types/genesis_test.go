@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
 )
@@ -24,6 +25,122 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid params",
+			&types.GenesisState{
+				PortId: "portidone",
+				Params: types.NewParams(true, true, []string{"(INVALIDCHANNEL)"}, nil, nil, 0, 0, 0),
+			},
+			true,
+		},
+		{
+			"rate limits",
+			&types.GenesisState{
+				PortId: "portidone",
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxSend: 1, Window: time.Hour}, time.Time{}),
+					types.NewRateLimit("channel-1", "kitty", types.RateLimitQuota{MaxSend: 1, Window: time.Hour}, time.Time{}),
+				},
+			},
+			false,
+		},
+		{
+			"invalid rate limit",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{}, time.Time{})},
+			},
+			true,
+		},
+		{
+			"duplicate rate limit",
+			&types.GenesisState{
+				PortId: "portidone",
+				RateLimits: []types.RateLimit{
+					types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxSend: 1, Window: time.Hour}, time.Time{}),
+					types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxReceive: 1, Window: time.Hour}, time.Time{}),
+				},
+			},
+			true,
+		},
+		{
+			"escrowed nfts",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowedNfts: []types.EscrowedNFT{
+					types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "kitty1"),
+					types.NewEscrowedNFT("nft-transfer", "channel-1", "kitty", "kitty1"),
+				},
+			},
+			false,
+		},
+		{
+			"invalid escrowed nft",
+			&types.GenesisState{
+				PortId:       "portidone",
+				EscrowedNfts: []types.EscrowedNFT{types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "")},
+			},
+			true,
+		},
+		{
+			"duplicate escrowed nft",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowedNfts: []types.EscrowedNFT{
+					types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "kitty1"),
+					types.NewEscrowedNFT("nft-transfer", "channel-0", "kitty", "kitty1"),
+				},
+			},
+			true,
+		},
+		{
+			"in-flight forwards",
+			&types.GenesisState{
+				PortId:           "portidone",
+				InFlightForwards: []types.InFlightForward{inFlightForward(1), inFlightForward(2)},
+			},
+			false,
+		},
+		{
+			"duplicate in-flight forward",
+			&types.GenesisState{
+				PortId:           "portidone",
+				InFlightForwards: []types.InFlightForward{inFlightForward(1), inFlightForward(1)},
+			},
+			true,
+		},
+		{
+			"invalid in-flight forward",
+			&types.GenesisState{
+				PortId:           "portidone",
+				InFlightForwards: []types.InFlightForward{{ForwardPort: "nft", ForwardChannel: "channel-1"}},
+			},
+			true,
+		},
+		{
+			"in-flight transfers",
+			&types.GenesisState{
+				PortId:            "portidone",
+				InFlightTransfers: []types.InFlightTransfer{inFlightTransfer(1), inFlightTransfer(2)},
+			},
+			false,
+		},
+		{
+			"duplicate in-flight transfer",
+			&types.GenesisState{
+				PortId:            "portidone",
+				InFlightTransfers: []types.InFlightTransfer{inFlightTransfer(1), inFlightTransfer(1)},
+			},
+			true,
+		},
+		{
+			"invalid in-flight transfer",
+			&types.GenesisState{
+				PortId:            "portidone",
+				InFlightTransfers: []types.InFlightTransfer{{PortId: types.PortID, ChannelId: "channel-0"}},
+			},
+			true,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -40,3 +157,17 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func inFlightForward(sequence uint64) types.InFlightForward {
+	return types.InFlightForward{
+		ForwardPort:        types.PortID,
+		ForwardChannel:     "channel-1",
+		ForwardSequence:    sequence,
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+		Sequence:           sequence,
+		Data:               types.NewNonFungibleTokenPacketData("cryptoCat", "uri", []string{"kitty"}, []string{"kitty_uri"}, sender, receiver, ""),
+	}
+}
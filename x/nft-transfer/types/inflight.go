@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewInFlightTransfer creates a new InFlightTransfer instance
+func NewInFlightTransfer(
+	portID, channelID string,
+	sequence uint64,
+	classID string,
+	tokenIDs, tokenURIs []string,
+	sender, receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) InFlightTransfer {
+	return InFlightTransfer{
+		PortId:           portID,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		ClassId:          classID,
+		TokenIds:         tokenIDs,
+		TokenUris:        tokenURIs,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Validate performs basic validation of an in-flight transfer
+func (t InFlightTransfer) Validate() error {
+	if err := host.PortIdentifierValidator(t.PortId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid port %s", t.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(t.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel %s", t.ChannelId)
+	}
+	if strings.TrimSpace(t.ClassId) == "" || strings.ContainsRune(t.ClassId, 0) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "invalid in-flight class %q", t.ClassId)
+	}
+	if len(t.TokenIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
+	}
+	for _, tokenID := range t.TokenIds {
+		if strings.TrimSpace(tokenID) == "" {
+			return sdkerrors.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
+		}
+	}
+	if len(t.TokenUris) != len(t.TokenIds) {
+		return sdkerrors.Wrap(ErrInvalidTokenID, "tokenIds and tokenUris lengths do not match")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Sender); err != nil {
+		return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if strings.TrimSpace(t.Receiver) == "" {
+		return sdkerrors.Wrap(errortypes.ErrInvalidAddress, "missing recipient address")
+	}
+	return nil
+}
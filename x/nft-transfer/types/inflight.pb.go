@@ -0,0 +1,787 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/nft_transfer/v1/inflight.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightTransfer is an outbound transfer whose NFTs were escrowed or burnt on
+// this chain, and whose packet was neither acknowledged nor timed out yet.
+type InFlightTransfer struct {
+	// port_id is the source port of the packet.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the source channel of the packet, or the client ID for IBC v2
+	// packets.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// class_id is the ID of the class of the NFTs on this chain.
+	ClassId string `protobuf:"bytes,4,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// token_ids are the IDs of the NFTs.
+	TokenIds []string `protobuf:"bytes,5,rep,name=token_ids,json=tokenIds,proto3" json:"token_ids,omitempty"`
+	// sender is the address that sent the NFTs.
+	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver is the address that receives the NFTs on the counterparty chain.
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_height is the timeout height of the packet, zero if disabled.
+	TimeoutHeight types.Height `protobuf:"bytes,8,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// timeout_timestamp is the timeout timestamp of the packet in nanoseconds, zero
+	// if disabled. It is unset for IBC v2 packets, as the IBC core does not pass the
+	// timeout to the application when sending them.
+	TimeoutTimestamp uint64 `protobuf:"varint,9,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// token_uris are the URIs of the NFTs, used to mint burnt vouchers again when
+	// the NFTs are recovered.
+	TokenUris []string `protobuf:"bytes,10,rep,name=token_uris,json=tokenUris,proto3" json:"token_uris,omitempty"`
+}
+
+func (m *InFlightTransfer) Reset()         { *m = InFlightTransfer{} }
+func (m *InFlightTransfer) String() string { return proto.CompactTextString(m) }
+func (*InFlightTransfer) ProtoMessage()    {}
+func (*InFlightTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d0602d55f56a776, []int{0}
+}
+func (m *InFlightTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightTransfer.Merge(m, src)
+}
+func (m *InFlightTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightTransfer proto.InternalMessageInfo
+
+func (m *InFlightTransfer) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightTransfer) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightTransfer) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *InFlightTransfer) GetTokenIds() []string {
+	if m != nil {
+		return m.TokenIds
+	}
+	return nil
+}
+
+func (m *InFlightTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *InFlightTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightTransfer) GetTimeoutHeight() types.Height {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return types.Height{}
+}
+
+func (m *InFlightTransfer) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightTransfer) GetTokenUris() []string {
+	if m != nil {
+		return m.TokenUris
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InFlightTransfer)(nil), "chainmain.nft_transfer.v1.InFlightTransfer")
+}
+
+func init() {
+	proto.RegisterFile("chainmain/nft_transfer/v1/inflight.proto", fileDescriptor_1d0602d55f56a776)
+}
+
+var fileDescriptor_1d0602d55f56a776 = []byte{
+	// 407 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x6d, 0x76, 0x4b, 0xdb, 0x18, 0x81, 0x16, 0x0b, 0x81, 0xb7, 0x88, 0x6c, 0xc5, 0x29, 0x12,
+	0xaa, 0xad, 0xc2, 0x91, 0xdb, 0x1e, 0x80, 0x5c, 0xab, 0xdd, 0x0b, 0x97, 0x2a, 0x75, 0xa6, 0x89,
+	0x45, 0x63, 0x07, 0xdb, 0x89, 0xd8, 0xbf, 0xe0, 0xb3, 0xf6, 0xb8, 0x47, 0x4e, 0x08, 0xb5, 0x07,
+	0x7e, 0x03, 0xd9, 0x4e, 0xa3, 0xbd, 0x24, 0x7e, 0x33, 0xef, 0x8d, 0xfd, 0x66, 0x06, 0xa5, 0xbc,
+	0xca, 0x85, 0xac, 0x73, 0x21, 0x99, 0xdc, 0xd9, 0x8d, 0xd5, 0xb9, 0x34, 0x3b, 0xd0, 0xac, 0x5b,
+	0x31, 0x21, 0x77, 0x7b, 0x51, 0x56, 0x96, 0x36, 0x5a, 0x59, 0x85, 0x2f, 0x07, 0x26, 0x7d, 0xcc,
+	0xa4, 0xdd, 0x6a, 0xfe, 0xb2, 0x54, 0xa5, 0xf2, 0x2c, 0xe6, 0x4e, 0x41, 0x30, 0xbf, 0x12, 0x5b,
+	0xce, 0xb8, 0xd2, 0xc0, 0xf8, 0x5e, 0x80, 0xb4, 0xae, 0x66, 0x38, 0x05, 0xc2, 0xbb, 0x7f, 0x67,
+	0xe8, 0x22, 0x93, 0x9f, 0xfd, 0x25, 0x37, 0x7d, 0x39, 0xfc, 0x1a, 0x4d, 0x1b, 0xa5, 0xed, 0x46,
+	0x14, 0x24, 0x5a, 0x44, 0x69, 0xbc, 0x9e, 0x38, 0x98, 0x15, 0xf8, 0x2d, 0x42, 0xbc, 0xca, 0xa5,
+	0x84, 0xbd, 0xcb, 0x9d, 0xf9, 0x5c, 0xdc, 0x47, 0xb2, 0x02, 0xcf, 0xd1, 0xcc, 0xc0, 0x8f, 0x16,
+	0x24, 0x07, 0x72, 0xbe, 0x88, 0xd2, 0xf1, 0x7a, 0xc0, 0xf8, 0x12, 0xcd, 0xf8, 0x3e, 0x37, 0xc6,
+	0x09, 0xc7, 0x5e, 0x38, 0xf5, 0x38, 0x2b, 0xf0, 0x1b, 0x14, 0x5b, 0xf5, 0x1d, 0xe4, 0x46, 0x14,
+	0x86, 0x3c, 0x59, 0x9c, 0xa7, 0xf1, 0x7a, 0xe6, 0x03, 0x59, 0x61, 0xf0, 0x2b, 0x34, 0x31, 0x20,
+	0x0b, 0xd0, 0x64, 0x12, 0x9e, 0x12, 0x90, 0xbb, 0x4b, 0x03, 0x07, 0xd1, 0x81, 0x26, 0x53, 0x9f,
+	0x19, 0x30, 0xfe, 0x82, 0x9e, 0x5b, 0x51, 0x83, 0x6a, 0xed, 0xa6, 0x02, 0xe7, 0x8c, 0xcc, 0x16,
+	0x51, 0xfa, 0xf4, 0xc3, 0x9c, 0x8a, 0x2d, 0xa7, 0xae, 0x1d, 0xb4, 0x6f, 0x42, 0xb7, 0xa2, 0x5f,
+	0x3d, 0xe3, 0x7a, 0x7c, 0xff, 0xe7, 0x6a, 0xb4, 0x7e, 0xd6, 0xeb, 0x42, 0x10, 0xbf, 0x47, 0x2f,
+	0x4e, 0x85, 0xdc, 0xdf, 0xd8, 0xbc, 0x6e, 0x48, 0xec, 0x9d, 0x5d, 0xf4, 0x89, 0x9b, 0x53, 0xdc,
+	0x35, 0x27, 0xd8, 0x68, 0xb5, 0x30, 0x04, 0x79, 0x1f, 0xc1, 0xd8, 0xad, 0x16, 0xe6, 0xfa, 0xf6,
+	0xfe, 0x90, 0x44, 0x0f, 0x87, 0x24, 0xfa, 0x7b, 0x48, 0xa2, 0x5f, 0xc7, 0x64, 0xf4, 0x70, 0x4c,
+	0x46, 0xbf, 0x8f, 0xc9, 0xe8, 0xdb, 0xa7, 0x52, 0xd8, 0xaa, 0xdd, 0x52, 0xae, 0x6a, 0xc6, 0xf5,
+	0x5d, 0x63, 0xd5, 0x52, 0xe9, 0x72, 0xe9, 0x67, 0xcd, 0xfc, 0x77, 0xe9, 0x97, 0xe3, 0xa7, 0x5b,
+	0x8f, 0xe5, 0xb0, 0x1e, 0xf6, 0xae, 0x01, 0xb3, 0x9d, 0xf8, 0x39, 0x7e, 0xfc, 0x3f, 0x00, 0x76,
+	0x8a, 0x47, 0x7e, 0x45, 0x02, 0x00, 0x00,
+}
+
+func (m *InFlightTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenUris) > 0 {
+		for iNdEx := len(m.TokenUris) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenUris[iNdEx])
+			copy(dAtA[i:], m.TokenUris[iNdEx])
+			i = encodeVarintInflight(dAtA, i, uint64(len(m.TokenUris[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintInflight(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflight(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintInflight(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintInflight(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenIds) > 0 {
+		for iNdEx := len(m.TokenIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIds[iNdEx])
+			copy(dAtA[i:], m.TokenIds[iNdEx])
+			i = encodeVarintInflight(dAtA, i, uint64(len(m.TokenIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintInflight(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintInflight(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintInflight(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintInflight(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflight(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflight(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovInflight(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovInflight(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovInflight(uint64(m.Sequence))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovInflight(uint64(l))
+	}
+	if len(m.TokenIds) > 0 {
+		for _, s := range m.TokenIds {
+			l = len(s)
+			n += 1 + l + sovInflight(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovInflight(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovInflight(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovInflight(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovInflight(uint64(m.TimeoutTimestamp))
+	}
+	if len(m.TokenUris) > 0 {
+		for _, s := range m.TokenUris {
+			l = len(s)
+			n += 1 + l + sovInflight(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovInflight(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflight(x uint64) (n int) {
+	return sovInflight(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflight
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIds = append(m.TokenIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenUris", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflight
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenUris = append(m.TokenUris, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflight(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflight
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInflight(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInflight
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInflight
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInflight
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInflight
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInflight
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInflight        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInflight          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInflight = fmt.Errorf("proto: unexpected end of group")
+)
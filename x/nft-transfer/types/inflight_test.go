@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInFlightTransfer_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer types.InFlightTransfer
+		wantErr  bool
+	}{
+		{"valid", inFlightTransfer(1), false},
+		{"invalid port", types.NewInFlightTransfer("(port)", "channel-0", 1, "kitty", []string{"kitty1"}, []string{""}, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"invalid channel", types.NewInFlightTransfer(types.PortID, "@channel-0", 1, "kitty", []string{"kitty1"}, []string{""}, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"class with zero byte", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kit\x00ty", []string{"kitty1"}, []string{""}, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"no tokens", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kitty", nil, nil, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"blank token", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kitty", []string{"kitty1", " "}, []string{"", ""}, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"missing token uri", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kitty", []string{"kitty1"}, nil, sender, receiver, clienttypes.ZeroHeight(), 0), true},
+		{"invalid sender", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kitty", []string{"kitty1"}, []string{""}, "sender", receiver, clienttypes.ZeroHeight(), 0), true},
+		{"blank receiver", types.NewInFlightTransfer(types.PortID, "channel-0", 1, "kitty", []string{"kitty1"}, []string{""}, sender, "", clienttypes.ZeroHeight(), 0), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.transfer.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("InFlightTransfer.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestInFlightTransferKeys(t *testing.T) {
+	// a class is not a prefix of a longer class
+	require.NotEqual(t,
+		types.GetInFlightTransferClassPrefix("kitty"),
+		types.GetInFlightTransferClassPrefix("kitty2")[:len(types.GetInFlightTransferClassPrefix("kitty"))],
+	)
+
+	// the indices end with the same suffix as the transfer key
+	key := types.GetInFlightTransferKey(types.PortID, "channel-0", 1)
+	require.Equal(t, key[1:], types.GetInFlightTransferClassKey("kitty", types.PortID, "channel-0", 1)[len(types.GetInFlightTransferClassPrefix("kitty")):])
+}
+
+func inFlightTransfer(sequence uint64) types.InFlightTransfer {
+	return types.NewInFlightTransfer(
+		types.PortID, "channel-0", sequence, "kitty", []string{"kitty1", "kitty2"}, []string{"kitty1_uri", ""}, sender, receiver, clienttypes.NewHeight(1, 100), 0,
+	)
+}
@@ -5,6 +5,7 @@ import (
 	fmt "fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -27,6 +28,9 @@ const (
 	// PortID is the default port id that nft-transfer module binds to
 	PortID = "nft"
 
+	// EncodingJSON is the encoding of the packet data in IBC v2 payloads
+	EncodingJSON = "application/json"
+
 	// ClassPrefix is the prefix used for internal SDK NFT representation.
 	ClassPrefix = "ibc"
 )
@@ -37,8 +41,45 @@ var (
 
 	// ClassTraceKey defines the key to store the class trace info in store
 	ClassTraceKey = []byte{0x02}
+
+	// ParamsKey defines the key to store the module params in store
+	ParamsKey = []byte{0x03}
+
+	// RateLimitKey defines the key prefix to store the rate limits in store
+	RateLimitKey = []byte{0x04}
+
+	// EscrowKey defines the key prefix to store the index of escrowed NFTs in store
+	EscrowKey = []byte{0x05}
+
+	// TotalEscrowKey defines the key prefix to store the number of escrowed NFTs per class in store
+	TotalEscrowKey = []byte{0x06}
+
+	// ForwardKey defines the key prefix to store the in-flight forwards in store
+	ForwardKey = []byte{0x07}
+
+	// InFlightTransferKey defines the key prefix to store the in-flight outbound transfers in store
+	InFlightTransferKey = []byte{0x08}
+
+	// InFlightTransferSenderKey defines the key prefix to store the index of in-flight transfers by sender in store
+	InFlightTransferSenderKey = []byte{0x09}
+
+	// InFlightTransferClassKey defines the key prefix to store the index of in-flight transfers by class in store
+	InFlightTransferClassKey = []byte{0x0A}
 )
 
+// GetRateLimitKey returns the store key of the rate limit of a class on a channel.
+// Channel identifiers cannot contain a slash, so the key is unambiguous.
+func GetRateLimitKey(channelID, classID string) []byte {
+	return append(GetRateLimitChannelPrefix(channelID), classID...)
+}
+
+// GetRateLimitChannelPrefix returns the store key prefix of the rate limits of a channel.
+func GetRateLimitChannelPrefix(channelID string) []byte {
+	key := append([]byte{}, RateLimitKey...)
+	key = append(key, channelID...)
+	return append(key, '/')
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -54,3 +95,107 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
+
+// GetForwardAddress returns the address that receives the NFTs forwarded from a
+// channel, or a client for IBC v2, on behalf of the original sender. Channel
+// identifiers cannot contain a slash, so it cannot collide with an escrow address.
+func GetForwardAddress(channelID, sender string) sdk.AccAddress {
+	contents := fmt.Sprintf("forward/%s/%s", channelID, sender)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// GetEscrowChannelPrefix returns the store key prefix of the NFTs escrowed for a port and channel.
+func GetEscrowChannelPrefix(portID, channelID string) []byte {
+	key := append([]byte{}, EscrowKey...)
+	key = append(key, portID...)
+	key = append(key, '/')
+	key = append(key, channelID...)
+	return append(key, '/')
+}
+
+// GetEscrowClassPrefix returns the store key prefix of the NFTs of a class escrowed
+// for a port and channel. Class IDs cannot contain a zero byte, which terminates them.
+func GetEscrowClassPrefix(portID, channelID, classID string) []byte {
+	key := append(GetEscrowChannelPrefix(portID, channelID), classID...)
+	return append(key, 0)
+}
+
+// GetEscrowKey returns the store key of an escrowed NFT.
+func GetEscrowKey(portID, channelID, classID, tokenID string) []byte {
+	return append(GetEscrowClassPrefix(portID, channelID, classID), tokenID...)
+}
+
+// GetTotalEscrowKey returns the store key of the number of escrowed NFTs of a class.
+func GetTotalEscrowKey(classID string) []byte {
+	return append(append([]byte{}, TotalEscrowKey...), classID...)
+}
+
+// GetForwardChannelPrefix returns the store key prefix of the in-flight forwards sent
+// on a port and channel.
+func GetForwardChannelPrefix(portID, channelID string) []byte {
+	key := append([]byte{}, ForwardKey...)
+	key = append(key, portID...)
+	key = append(key, '/')
+	key = append(key, channelID...)
+	return append(key, '/')
+}
+
+// GetForwardKey returns the store key of the in-flight forward of a forwarded packet.
+func GetForwardKey(portID, channelID string, sequence uint64) []byte {
+	return append(GetForwardChannelPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// getInFlightTransferSuffix returns the part of the store key of an in-flight transfer
+// that follows its prefix, shared by the transfer and its indices.
+func getInFlightTransferSuffix(portID, channelID string, sequence uint64) []byte {
+	key := append([]byte(portID), '/')
+	key = append(key, channelID...)
+	key = append(key, '/')
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetInFlightTransferKey returns the store key of the in-flight transfer of a packet.
+func GetInFlightTransferKey(portID, channelID string, sequence uint64) []byte {
+	return append(append([]byte{}, InFlightTransferKey...), getInFlightTransferSuffix(portID, channelID, sequence)...)
+}
+
+// GetInFlightTransferChannelPrefix returns the store key prefix of the in-flight
+// transfers sent on a port and channel.
+func GetInFlightTransferChannelPrefix(portID, channelID string) []byte {
+	key := append([]byte{}, InFlightTransferKey...)
+	key = append(key, portID...)
+	key = append(key, '/')
+	key = append(key, channelID...)
+	return append(key, '/')
+}
+
+// GetInFlightTransferSenderPrefix returns the store key prefix of the index of the
+// in-flight transfers of a sender.
+func GetInFlightTransferSenderPrefix(sender sdk.AccAddress) []byte {
+	return append(append([]byte{}, InFlightTransferSenderKey...), address.MustLengthPrefix(sender)...)
+}
+
+// GetInFlightTransferSenderKey returns the store key of a sender index entry of the
+// in-flight transfer of a packet.
+func GetInFlightTransferSenderKey(sender sdk.AccAddress, portID, channelID string, sequence uint64) []byte {
+	return append(GetInFlightTransferSenderPrefix(sender), getInFlightTransferSuffix(portID, channelID, sequence)...)
+}
+
+// GetInFlightTransferClassPrefix returns the store key prefix of the index of the
+// in-flight transfers of a class. Class IDs cannot contain a zero byte, which
+// terminates them.
+func GetInFlightTransferClassPrefix(classID string) []byte {
+	key := append(append([]byte{}, InFlightTransferClassKey...), classID...)
+	return append(key, 0)
+}
+
+// GetInFlightTransferClassKey returns the store key of a class index entry of the
+// in-flight transfer of a packet.
+func GetInFlightTransferClassKey(classID, portID, channelID string, sequence uint64) []byte {
+	return append(GetInFlightTransferClassPrefix(classID), getInFlightTransferSuffix(portID, channelID, sequence)...)
+}
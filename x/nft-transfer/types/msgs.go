@@ -4,7 +4,9 @@ import (
 	"strings"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
 	newsdkerrors "cosmossdk.io/errors"
 
@@ -17,13 +19,15 @@ const (
 	TypeMsgTransfer = "nft-transfer"
 )
 
+var _ nfttypes.IBCTransferMsg = &MsgTransfer{}
+
 // NewMsgTransfer creates a new MsgTransfer instance
 //
 //nolint:interfacer
 func NewMsgTransfer(
 	sourcePort, sourceChannel string,
 	classID string, tokenIds []string, sender, receiver string,
-	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
 ) *MsgTransfer {
 	return &MsgTransfer{
 		SourcePort:       sourcePort,
@@ -34,6 +38,7 @@ func NewMsgTransfer(
 		Receiver:         receiver,
 		TimeoutHeight:    timeoutHeight,
 		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
 	}
 }
 
@@ -62,28 +67,37 @@ func (msg MsgTransfer) ValidateBasic() error {
 		return newsdkerrors.Wrap(err, "invalid source channel ID")
 	}
 
-	if strings.TrimSpace(msg.ClassId) == "" {
+	return validateTransfer(msg.ClassId, msg.TokenIds, msg.Sender, msg.Receiver, msg.Memo)
+}
+
+// validateTransfer performs a basic check of the fields shared by MsgTransfer and
+// MsgTransferV2.
+func validateTransfer(classID string, tokenIDs []string, sender, receiver, memo string) error {
+	if strings.TrimSpace(classID) == "" {
 		return newsdkerrors.Wrap(ErrInvalidClassID, "classId cannot be blank")
 	}
 
-	if len(msg.TokenIds) == 0 {
+	if len(tokenIDs) == 0 {
 		return newsdkerrors.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
 	}
 
-	for _, tokenID := range msg.TokenIds {
+	for _, tokenID := range tokenIDs {
 		if strings.TrimSpace(tokenID) == "" {
 			return newsdkerrors.Wrap(ErrInvalidTokenID, "tokenId cannot be blank")
 		}
 	}
 
 	// NOTE: sender format must be validated as it is required by the GetSigners function.
-	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	_, err := sdk.AccAddressFromBech32(sender)
 	if err != nil {
 		return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
-	if strings.TrimSpace(msg.Receiver) == "" {
+	if strings.TrimSpace(receiver) == "" {
 		return newsdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing recipient address")
 	}
+	if len(memo) > MaximumMemoLength {
+		return newsdkerrors.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
 	return nil
 }
 
@@ -95,3 +109,65 @@ func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// GetSourcePort implements nfttypes.IBCTransferMsg
+func (msg MsgTransfer) GetSourcePort() string {
+	return msg.SourcePort
+}
+
+// GetSourceChannel implements nfttypes.IBCTransferMsg
+func (msg MsgTransfer) GetSourceChannel() string {
+	return msg.SourceChannel
+}
+
+// GetClassId implements nfttypes.IBCTransferMsg
+func (msg MsgTransfer) GetClassId() string {
+	return msg.ClassId
+}
+
+// GetTokenIds implements nfttypes.IBCTransferMsg
+func (msg MsgTransfer) GetTokenIds() []string {
+	return msg.TokenIds
+}
+
+// GetReceiver implements nfttypes.IBCTransferMsg
+func (msg MsgTransfer) GetReceiver() string {
+	return msg.Receiver
+}
+
+// NewMsgTransferV2 creates a new MsgTransferV2 instance
+func NewMsgTransferV2(
+	sourceClient, classID string, tokenIds []string, sender, receiver string, timeoutTimestamp uint64, memo string,
+) *MsgTransferV2 {
+	return &MsgTransferV2{
+		SourceClient:     sourceClient,
+		ClassId:          classID,
+		TokenIds:         tokenIds,
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgTransferV2 fields.
+// NOTE: unlike IBC v1 packets, the timeout timestamp, in seconds, can't be 0.
+func (msg MsgTransferV2) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.SourceClient); err != nil {
+		return newsdkerrors.Wrap(err, "invalid source client ID")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return newsdkerrors.Wrap(channeltypesv2.ErrInvalidTimeout, "timeout timestamp must be set")
+	}
+
+	return validateTransfer(msg.ClassId, msg.TokenIds, msg.Sender, msg.Receiver, msg.Memo)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgTransferV2) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -21,14 +22,15 @@ func TestMsgTransfer_ValidateBasic(t *testing.T) {
 		msg     *types.MsgTransfer
 		wantErr bool
 	}{
-		{"valid msg", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1), false},
-		{"invalid msg with port", types.NewMsgTransfer("@nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with wrong port", types.NewMsgTransfer("transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with channel", types.NewMsgTransfer(types.PortID, "@channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with class", types.NewMsgTransfer(types.PortID, "channel-1", "", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with token_id", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{""}, sender, receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with sender", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, "", receiver, clienttypes.NewHeight(1, 1), 1), true},
-		{"invalid msg with receiver", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, sender, "", clienttypes.NewHeight(1, 1), 1), true},
+		{"valid msg", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), false},
+		{"invalid msg with port", types.NewMsgTransfer("@nft-transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with wrong port", types.NewMsgTransfer("transfer", "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with channel", types.NewMsgTransfer(types.PortID, "@channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with class", types.NewMsgTransfer(types.PortID, "channel-1", "", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with token_id", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{""}, sender, receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with sender", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, "", receiver, clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with receiver", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, sender, "", clienttypes.NewHeight(1, 1), 1, ""), true},
+		{"invalid msg with memo", types.NewMsgTransfer(types.PortID, "channel-1", "cryptoCat", []string{"kitty"}, sender, receiver, clienttypes.NewHeight(1, 1), 1, strings.Repeat("m", types.MaximumMemoLength+1)), true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -38,3 +40,25 @@ func TestMsgTransfer_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgTransferV2_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     *types.MsgTransferV2
+		wantErr bool
+	}{
+		{"valid msg", types.NewMsgTransferV2("07-tendermint-0", "cryptoCat", []string{"kitty"}, sender, receiver, 1, ""), false},
+		{"invalid msg with client", types.NewMsgTransferV2("@07-tendermint-0", "cryptoCat", []string{"kitty"}, sender, receiver, 1, ""), true},
+		{"invalid msg without timeout", types.NewMsgTransferV2("07-tendermint-0", "cryptoCat", []string{"kitty"}, sender, receiver, 0, ""), true},
+		{"invalid msg with class", types.NewMsgTransferV2("07-tendermint-0", "", []string{"kitty"}, sender, receiver, 1, ""), true},
+		{"invalid msg with sender", types.NewMsgTransferV2("07-tendermint-0", "cryptoCat", []string{"kitty"}, "", receiver, 1, ""), true},
+		{"invalid msg with receiver", types.NewMsgTransferV2("07-tendermint-0", "cryptoCat", []string{"kitty"}, sender, "", 1, ""), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.msg.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("MsgTransferV2.ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"strings"
 	"time"
 
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
 	newsdkerrors "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +18,11 @@ import (
 // timeout.
 var DefaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
 
+// MaximumMemoLength is the maximum length of the memo of a transfer
+const MaximumMemoLength = 32768
+
+var _ ibcexported.PacketData = NonFungibleTokenPacketData{}
+
 // NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
 func NewNonFungibleTokenPacketData(
 	classID string,
@@ -24,6 +31,7 @@ func NewNonFungibleTokenPacketData(
 	tokenURI []string,
 	sender string,
 	receiver string,
+	memo string,
 ) NonFungibleTokenPacketData {
 	return NonFungibleTokenPacketData{
 		ClassId:   classID,
@@ -32,6 +40,7 @@ func NewNonFungibleTokenPacketData(
 		TokenUris: tokenURI,
 		Sender:    sender,
 		Receiver:  receiver,
+		Memo:      memo,
 	}
 }
 
@@ -68,6 +77,10 @@ func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(nftpd.Receiver); err != nil {
 		return newsdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address")
 	}
+
+	if len(nftpd.Memo) > MaximumMemoLength {
+		return newsdkerrors.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
 	return nil
 }
 
@@ -75,3 +88,8 @@ func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
 func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
 	return ModuleCdc.MustMarshalJSON(&nftpd)
 }
+
+// GetPacketSender returns the sender address of the packet data.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return nftpd.Sender
+}
@@ -38,6 +38,8 @@ type NonFungibleTokenPacketData struct {
 	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// optional memo, which may hold a forwarding instruction for the receiving chain
+	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (m *NonFungibleTokenPacketData) Reset()         { *m = NonFungibleTokenPacketData{} }
@@ -115,6 +117,13 @@ func (m *NonFungibleTokenPacketData) GetReceiver() string {
 	return ""
 }
 
+func (m *NonFungibleTokenPacketData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NonFungibleTokenPacketData)(nil), "chainmain.nft_transfer.v1.NonFungibleTokenPacketData")
 }
@@ -124,25 +133,26 @@ func init() {
 }
 
 var fileDescriptor_004dc252e639081a = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x17, 0xa7, 0x75, 0xcb, 0x31, 0x07, 0xc9, 0x26, 0x86, 0xe1, 0x41, 0xbc, 0xb4, 0x65,
-	0x78, 0xf4, 0x26, 0x22, 0xec, 0x22, 0x22, 0xf6, 0xe2, 0xa5, 0xa4, 0x69, 0xd6, 0x85, 0x6d, 0x49,
-	0xf9, 0x27, 0x2d, 0xee, 0x2d, 0x7c, 0x28, 0x0f, 0x1e, 0x77, 0xf4, 0x28, 0xed, 0x8b, 0x48, 0x53,
-	0x1d, 0x5e, 0x02, 0x5f, 0x7e, 0x5f, 0xbe, 0xc0, 0x0f, 0x5f, 0x89, 0x15, 0x57, 0x7a, 0xcb, 0x95,
-	0x8e, 0xf5, 0xd2, 0xa5, 0x0e, 0xb8, 0xb6, 0x4b, 0x09, 0x71, 0x3d, 0x8f, 0x4b, 0x2e, 0xd6, 0xd2,
-	0x45, 0x25, 0x18, 0x67, 0xc8, 0xe4, 0xd0, 0x8b, 0xfe, 0xf7, 0xa2, 0x7a, 0x7e, 0xf9, 0x81, 0xf0,
-	0xf4, 0xd1, 0xe8, 0x87, 0x4a, 0x17, 0x2a, 0xdb, 0xc8, 0x17, 0xb3, 0x96, 0xfa, 0xc9, 0xbf, 0xbd,
-	0xe7, 0x8e, 0x93, 0x09, 0x1e, 0x89, 0x0d, 0xb7, 0x36, 0x55, 0x39, 0x45, 0x33, 0x74, 0x3d, 0x7e,
-	0x3e, 0xf5, 0x79, 0x91, 0x93, 0x73, 0x3c, 0xee, 0x51, 0x05, 0x8a, 0x1e, 0x79, 0xd6, 0x77, 0x13,
-	0x50, 0x1d, 0x74, 0xdd, 0x54, 0xaa, 0x72, 0x4b, 0x87, 0xb3, 0x61, 0x07, 0xfd, 0xc5, 0x22, 0xb7,
-	0xe4, 0x02, 0xe3, 0x1e, 0x56, 0xa0, 0x2c, 0x3d, 0xf6, 0xb4, 0xaf, 0x27, 0xa0, 0x2c, 0x39, 0xc3,
-	0x81, 0x95, 0x3a, 0x97, 0x40, 0x4f, 0xfc, 0xea, 0x6f, 0x22, 0x53, 0x3c, 0x02, 0x29, 0xa4, 0xaa,
-	0x25, 0xd0, 0xa0, 0xff, 0xef, 0x2f, 0xdf, 0x25, 0x9f, 0x0d, 0x43, 0xfb, 0x86, 0xa1, 0xef, 0x86,
-	0xa1, 0xf7, 0x96, 0x0d, 0xf6, 0x2d, 0x1b, 0x7c, 0xb5, 0x6c, 0xf0, 0x7a, 0x5b, 0x28, 0xb7, 0xaa,
-	0xb2, 0x48, 0x98, 0x6d, 0x2c, 0x60, 0x57, 0x3a, 0x13, 0x1a, 0x28, 0x42, 0x6f, 0x24, 0xf6, 0x67,
-	0xe8, 0x05, 0xbe, 0x75, 0x0a, 0xc3, 0x83, 0x42, 0xb7, 0x2b, 0xa5, 0xcd, 0x02, 0xef, 0xef, 0xe6,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x76, 0x1a, 0x6a, 0xb3, 0x69, 0x01, 0x00, 0x00,
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
+	0x18, 0xc6, 0x1b, 0x5b, 0xfb, 0x27, 0x63, 0x06, 0x49, 0x2b, 0x86, 0xe2, 0x20, 0x2e, 0x77, 0x47,
+	0x71, 0x74, 0x13, 0x11, 0xba, 0x88, 0x88, 0x5d, 0x5c, 0x4a, 0x9a, 0x4b, 0xdb, 0xd0, 0x5e, 0x72,
+	0xbc, 0xc9, 0x15, 0xfb, 0x2d, 0xfc, 0x58, 0x8e, 0x1d, 0x3b, 0xca, 0xdd, 0x17, 0x91, 0x7b, 0x4f,
+	0x8b, 0x4b, 0xc8, 0xf3, 0xfe, 0x9e, 0xbc, 0x81, 0x1f, 0xbd, 0x51, 0x6b, 0x69, 0x6c, 0x26, 0x8d,
+	0x4d, 0xec, 0x32, 0xcc, 0x03, 0x48, 0xeb, 0x97, 0x1a, 0x92, 0xdd, 0x24, 0xc9, 0xa5, 0xda, 0xe8,
+	0x10, 0xe7, 0xe0, 0x82, 0x63, 0xc3, 0x53, 0x2f, 0xfe, 0xdf, 0x8b, 0x77, 0x93, 0xeb, 0x23, 0xa1,
+	0xa3, 0x67, 0x67, 0x9f, 0x0a, 0xbb, 0x32, 0x8b, 0xad, 0x7e, 0x73, 0x1b, 0x6d, 0x5f, 0xf0, 0xed,
+	0xa3, 0x0c, 0x92, 0x0d, 0x69, 0x5f, 0x6d, 0xa5, 0xf7, 0x73, 0x93, 0x72, 0x32, 0x26, 0xb7, 0x83,
+	0xd7, 0x1e, 0xe6, 0x69, 0xca, 0x2e, 0xe9, 0xa0, 0x41, 0x05, 0x18, 0x7e, 0x86, 0xac, 0xe9, 0xce,
+	0xc0, 0xd4, 0x30, 0xd4, 0xab, 0xe6, 0x26, 0xf5, 0xbc, 0x3d, 0x6e, 0xd7, 0x10, 0x07, 0xd3, 0xd4,
+	0xb3, 0x2b, 0x4a, 0x1b, 0x58, 0x80, 0xf1, 0xbc, 0x83, 0xb4, 0xa9, 0xcf, 0xc0, 0x78, 0x76, 0x41,
+	0xbb, 0x5e, 0xdb, 0x54, 0x03, 0x3f, 0xc7, 0xad, 0xbf, 0x89, 0x8d, 0x68, 0x1f, 0xb4, 0xd2, 0x66,
+	0xa7, 0x81, 0x77, 0x9b, 0xff, 0xfe, 0x32, 0x63, 0xb4, 0x93, 0xe9, 0xcc, 0xf1, 0x1e, 0xce, 0xf1,
+	0xfe, 0x30, 0xfb, 0x2a, 0x05, 0x39, 0x94, 0x82, 0x7c, 0x97, 0x82, 0x7c, 0x56, 0xa2, 0x75, 0xa8,
+	0x44, 0xeb, 0x58, 0x89, 0xd6, 0xfb, 0xfd, 0xca, 0x84, 0x75, 0xb1, 0x88, 0x95, 0xcb, 0x12, 0x05,
+	0xfb, 0x3c, 0xb8, 0xc8, 0xc1, 0x2a, 0x42, 0x4b, 0x09, 0x9e, 0x11, 0x4a, 0xfd, 0xa8, 0xb5, 0x46,
+	0x27, 0xad, 0x61, 0x9f, 0x6b, 0xbf, 0xe8, 0xa2, 0xd3, 0xbb, 0x9f, 0x01, 0x00, 0x99, 0x86, 0xf9,
+	0x04, 0x7d, 0x01, 0x00, 0x00,
 }
 
 func (m *NonFungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -165,6 +175,13 @@ func (m *NonFungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Receiver) > 0 {
 		i -= len(m.Receiver)
 		copy(dAtA[i:], m.Receiver)
@@ -259,6 +276,10 @@ func (m *NonFungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -489,6 +510,38 @@ func (m *NonFungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -14,32 +14,32 @@ func TestNonFungibleTokenPacketData_ValidateBasic(t *testing.T) {
 	}{
 		{
 			name:    "valid packet",
-			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{"kitty_uri"}, sender, receiver},
+			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{"kitty_uri"}, sender, receiver, ""},
 			wantErr: false,
 		},
 		{
 			name:    "invalid packet with empty classID",
-			packet:  types.NonFungibleTokenPacketData{"", "uri", []string{"kitty"}, []string{"kitty_uri"}, sender, receiver},
+			packet:  types.NonFungibleTokenPacketData{"", "uri", []string{"kitty"}, []string{"kitty_uri"}, sender, receiver, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty tokenIds",
-			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{}, []string{"kitty_uri"}, sender, receiver},
+			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{}, []string{"kitty_uri"}, sender, receiver, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty tokenUris",
-			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, sender, receiver},
+			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, sender, receiver, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty sender",
-			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, "", receiver},
+			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, "", receiver, ""},
 			wantErr: true,
 		},
 		{
 			name:    "invalid packet with empty receiver",
-			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, sender, receiver},
+			packet:  types.NonFungibleTokenPacketData{"cryptoCat", "uri", []string{"kitty"}, []string{}, sender, receiver, ""},
 			wantErr: true,
 		},
 	}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultMaxTokenIds is the default maximum number of tokens in a single transfer
+	DefaultMaxTokenIds = 256
+	// DefaultMaxClassIDLength is the default maximum length of a class ID
+	DefaultMaxClassIDLength = 2048
+	// DefaultMaxTokenIDLength is the default maximum length of a token ID
+	DefaultMaxTokenIDLength = 2048
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	sendEnabled, receiveEnabled bool,
+	allowedChannels, deniedChannels []string,
+	classSendEnabled []ClassSendEnabled,
+	maxTokenIds, maxClassIDLength, maxTokenIDLength uint32,
+) Params {
+	return Params{
+		SendEnabled:      sendEnabled,
+		ReceiveEnabled:   receiveEnabled,
+		AllowedChannels:  allowedChannels,
+		DeniedChannels:   deniedChannels,
+		ClassSendEnabled: classSendEnabled,
+		MaxTokenIds:      maxTokenIds,
+		MaxClassIdLength: maxClassIDLength,
+		MaxTokenIdLength: maxTokenIDLength,
+	}
+}
+
+// DefaultParams returns the default nft-transfer parameters, which enable
+// transfers on all channels
+func DefaultParams() Params {
+	return NewParams(true, true, []string{}, []string{}, []ClassSendEnabled{},
+		DefaultMaxTokenIds, DefaultMaxClassIDLength, DefaultMaxTokenIDLength)
+}
+
+// Validate performs basic validation of the nft-transfer parameters
+func (p Params) Validate() error {
+	if err := validateIDLists("channel", p.AllowedChannels, p.DeniedChannels, host.ChannelIdentifierValidator); err != nil {
+		return err
+	}
+	if err := validateIDLists("client", p.AllowedClients, p.DeniedClients, host.ClientIdentifierValidator); err != nil {
+		return err
+	}
+
+	classes := make(map[string]bool, len(p.ClassSendEnabled))
+	for _, c := range p.ClassSendEnabled {
+		if strings.TrimSpace(c.ClassId) == "" {
+			return sdkerrors.Wrap(ErrInvalidClassID, "classId cannot be blank")
+		}
+		if classes[c.ClassId] {
+			return fmt.Errorf("duplicate send enabled entry for class %s", c.ClassId)
+		}
+		classes[c.ClassId] = true
+	}
+
+	return nil
+}
+
+// IsChannelEnabled returns whether transfers are enabled on the given channel
+func (p Params) IsChannelEnabled(channelID string) bool {
+	return isIDEnabled(channelID, p.AllowedChannels, p.DeniedChannels)
+}
+
+// IsClientEnabled returns whether IBC v2 transfers are enabled on the given light
+// client
+func (p Params) IsClientEnabled(clientID string) bool {
+	return isIDEnabled(clientID, p.AllowedClients, p.DeniedClients)
+}
+
+// IsRouteEnabled returns whether transfers are enabled on the given channel, or on
+// the given client for IBC v2 packets. IBC v2 packets sent over an aliased channel
+// are sent over the channel ID, and use the channel lists.
+func (p Params) IsRouteEnabled(id string) bool {
+	if channeltypes.IsValidChannelID(id) {
+		return p.IsChannelEnabled(id)
+	}
+	return p.IsClientEnabled(id)
+}
+
+// IsSendEnabledClass returns whether the tokens of the given class can be sent,
+// falling back to SendEnabled for classes without an entry
+func (p Params) IsSendEnabledClass(classID string) bool {
+	for _, c := range p.ClassSendEnabled {
+		if c.ClassId == classID {
+			return c.Enabled
+		}
+	}
+	return p.SendEnabled
+}
+
+// ValidateTokens checks the class ID and token IDs of a transfer against the
+// configured limits
+func (p Params) ValidateTokens(classID string, tokenIDs []string) error {
+	if p.MaxClassIdLength > 0 && len(classID) > int(p.MaxClassIdLength) {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "class id length must not exceed %d", p.MaxClassIdLength)
+	}
+	if p.MaxTokenIds > 0 && len(tokenIDs) > int(p.MaxTokenIds) {
+		return sdkerrors.Wrapf(ErrInvalidTokenID, "number of token ids must not exceed %d", p.MaxTokenIds)
+	}
+	if p.MaxTokenIdLength > 0 {
+		for _, tokenID := range tokenIDs {
+			if len(tokenID) > int(p.MaxTokenIdLength) {
+				return sdkerrors.Wrapf(ErrInvalidTokenID, "token id length must not exceed %d", p.MaxTokenIdLength)
+			}
+		}
+	}
+	return nil
+}
+
+// validateIDLists checks that the identifiers of the allowed and denied lists are
+// valid, unique and not both allowed and denied
+func validateIDLists(kind string, allowedIDs, deniedIDs []string, validator host.ValidateFn) error {
+	denied := make(map[string]bool, len(deniedIDs))
+	for _, id := range deniedIDs {
+		if err := validator(id); err != nil {
+			return sdkerrors.Wrapf(err, "invalid denied %s %s", kind, id)
+		}
+		if denied[id] {
+			return fmt.Errorf("duplicate denied %s %s", kind, id)
+		}
+		denied[id] = true
+	}
+
+	allowed := make(map[string]bool, len(allowedIDs))
+	for _, id := range allowedIDs {
+		if err := validator(id); err != nil {
+			return sdkerrors.Wrapf(err, "invalid allowed %s %s", kind, id)
+		}
+		if allowed[id] {
+			return fmt.Errorf("duplicate allowed %s %s", kind, id)
+		}
+		if denied[id] {
+			return fmt.Errorf("%s %s is both allowed and denied", kind, id)
+		}
+		allowed[id] = true
+	}
+	return nil
+}
+
+// isIDEnabled returns whether an identifier is not denied, and allowed when the
+// allowed list is not empty
+func isIDEnabled(id string, allowed, denied []string) bool {
+	if slices.Contains(denied, id) {
+		return false
+	}
+	return len(allowed) == 0 || slices.Contains(allowed, id)
+}
@@ -0,0 +1,983 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/nft_transfer/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the set of ibc nft-transfer parameters.
+type Params struct {
+	// send_enabled enables or disables all cross-chain nft transfers from this chain.
+	SendEnabled bool `protobuf:"varint,1,opt,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// receive_enabled enables or disables all cross-chain nft transfers to this chain.
+	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty"`
+	// allowed_channels restricts transfers to the listed channels. All channels are
+	// allowed when it is empty.
+	AllowedChannels []string `protobuf:"bytes,3,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels,omitempty"`
+	// denied_channels lists the channels on which transfers are disabled. It takes
+	// precedence over allowed_channels.
+	DeniedChannels []string `protobuf:"bytes,4,rep,name=denied_channels,json=deniedChannels,proto3" json:"denied_channels,omitempty"`
+	// class_send_enabled overrides send_enabled for the listed classes.
+	ClassSendEnabled []ClassSendEnabled `protobuf:"bytes,5,rep,name=class_send_enabled,json=classSendEnabled,proto3" json:"class_send_enabled"`
+	// max_token_ids is the maximum number of tokens in a single transfer, 0 means no limit.
+	MaxTokenIds uint32 `protobuf:"varint,6,opt,name=max_token_ids,json=maxTokenIds,proto3" json:"max_token_ids,omitempty"`
+	// max_class_id_length is the maximum length of a class ID, including its trace,
+	// 0 means no limit.
+	MaxClassIdLength uint32 `protobuf:"varint,7,opt,name=max_class_id_length,json=maxClassIdLength,proto3" json:"max_class_id_length,omitempty"`
+	// max_token_id_length is the maximum length of a token ID, 0 means no limit.
+	MaxTokenIdLength uint32 `protobuf:"varint,8,opt,name=max_token_id_length,json=maxTokenIdLength,proto3" json:"max_token_id_length,omitempty"`
+	// allowed_clients restricts the IBC v2 transfers to the listed light clients. All
+	// clients are allowed when it is empty. IBC v2 transfers over aliased channels
+	// use the channel lists.
+	AllowedClients []string `protobuf:"bytes,9,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// denied_clients lists the light clients on which IBC v2 transfers are disabled.
+	// It takes precedence over allowed_clients.
+	DeniedClients []string `protobuf:"bytes,10,rep,name=denied_clients,json=deniedClients,proto3" json:"denied_clients,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7492dfa6c1004909, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSendEnabled() bool {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return false
+}
+
+func (m *Params) GetReceiveEnabled() bool {
+	if m != nil {
+		return m.ReceiveEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowedChannels() []string {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedChannels() []string {
+	if m != nil {
+		return m.DeniedChannels
+	}
+	return nil
+}
+
+func (m *Params) GetClassSendEnabled() []ClassSendEnabled {
+	if m != nil {
+		return m.ClassSendEnabled
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTokenIds() uint32 {
+	if m != nil {
+		return m.MaxTokenIds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxClassIdLength() uint32 {
+	if m != nil {
+		return m.MaxClassIdLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTokenIdLength() uint32 {
+	if m != nil {
+		return m.MaxTokenIdLength
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedClients() []string {
+	if m != nil {
+		return m.AllowedClients
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedClients() []string {
+	if m != nil {
+		return m.DeniedClients
+	}
+	return nil
+}
+
+// ClassSendEnabled maps a class ID to whether its tokens can be sent.
+type ClassSendEnabled struct {
+	// class_id is the class ID on this chain, for example ibc/{hash} for received classes.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Enabled bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *ClassSendEnabled) Reset()         { *m = ClassSendEnabled{} }
+func (m *ClassSendEnabled) String() string { return proto.CompactTextString(m) }
+func (*ClassSendEnabled) ProtoMessage()    {}
+func (*ClassSendEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7492dfa6c1004909, []int{1}
+}
+func (m *ClassSendEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassSendEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassSendEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassSendEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassSendEnabled.Merge(m, src)
+}
+func (m *ClassSendEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassSendEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassSendEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassSendEnabled proto.InternalMessageInfo
+
+func (m *ClassSendEnabled) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassSendEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "chainmain.nft_transfer.v1.Params")
+	proto.RegisterType((*ClassSendEnabled)(nil), "chainmain.nft_transfer.v1.ClassSendEnabled")
+}
+
+func init() {
+	proto.RegisterFile("chainmain/nft_transfer/v1/params.proto", fileDescriptor_7492dfa6c1004909)
+}
+
+var fileDescriptor_7492dfa6c1004909 = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x8b, 0xd4, 0x30,
+	0x18, 0xc7, 0xa7, 0xce, 0x3a, 0x2f, 0x19, 0xe7, 0x85, 0xe8, 0xa1, 0xeb, 0xa1, 0x8e, 0x03, 0xba,
+	0x23, 0xd2, 0x96, 0xd5, 0xa3, 0xb7, 0x5d, 0x44, 0x16, 0x3c, 0x48, 0xd5, 0x8b, 0x97, 0x90, 0x49,
+	0xb2, 0x6d, 0xb0, 0x4d, 0x4a, 0x13, 0xc7, 0xee, 0xb7, 0xf0, 0x63, 0xed, 0x71, 0x8f, 0x82, 0x20,
+	0x32, 0xf3, 0x45, 0xa4, 0x49, 0x53, 0x77, 0x07, 0xbc, 0x94, 0xf6, 0xc7, 0x2f, 0x4f, 0x9f, 0xfc,
+	0x9f, 0x07, 0x3c, 0x27, 0x19, 0xe6, 0xa2, 0xc0, 0x5c, 0xc4, 0xe2, 0x52, 0x23, 0x5d, 0x61, 0xa1,
+	0x2e, 0x59, 0x15, 0x6f, 0x4f, 0xe3, 0x12, 0x57, 0xb8, 0x50, 0x51, 0x59, 0x49, 0x2d, 0xe1, 0x71,
+	0xe7, 0x45, 0xb7, 0xbd, 0x68, 0x7b, 0xfa, 0xf8, 0x51, 0x2a, 0x53, 0x69, 0xac, 0xb8, 0x79, 0xb3,
+	0x07, 0x56, 0xbf, 0xfa, 0x60, 0xf0, 0xc1, 0x54, 0x80, 0x4f, 0xc1, 0x03, 0xc5, 0x04, 0x45, 0x4c,
+	0xe0, 0x4d, 0xce, 0xa8, 0xef, 0x2d, 0xbd, 0xf5, 0x28, 0x99, 0x34, 0xec, 0xad, 0x45, 0xf0, 0x04,
+	0xcc, 0x2b, 0x46, 0x18, 0xdf, 0xb2, 0xce, 0xba, 0x67, 0xac, 0x59, 0x8b, 0x9d, 0xf8, 0x02, 0x2c,
+	0x70, 0x9e, 0xcb, 0xef, 0x8c, 0x22, 0x92, 0x61, 0x21, 0x58, 0xae, 0xfc, 0xfe, 0xb2, 0xbf, 0x1e,
+	0x27, 0xf3, 0x96, 0x9f, 0xb7, 0xb8, 0xa9, 0x49, 0x99, 0xe0, 0xb7, 0xcd, 0x23, 0x63, 0xce, 0x2c,
+	0xee, 0x44, 0x04, 0x20, 0xc9, 0xb1, 0x52, 0xe8, 0x4e, 0x97, 0xf7, 0x97, 0xfd, 0xf5, 0xe4, 0xd5,
+	0xcb, 0xe8, 0xbf, 0x17, 0x8f, 0xce, 0x9b, 0x43, 0x1f, 0xff, 0xdd, 0xe2, 0xec, 0xe8, 0xfa, 0xf7,
+	0x93, 0x5e, 0xb2, 0x20, 0x07, 0x1c, 0xae, 0xc0, 0xb4, 0xc0, 0x35, 0xd2, 0xf2, 0x2b, 0x13, 0x88,
+	0x53, 0xe5, 0x0f, 0x96, 0xde, 0x7a, 0x9a, 0x4c, 0x0a, 0x5c, 0x7f, 0x6a, 0xd8, 0x05, 0x55, 0x30,
+	0x04, 0x0f, 0x1b, 0xc7, 0x36, 0xc2, 0x29, 0xca, 0x99, 0x48, 0x75, 0xe6, 0x0f, 0x8d, 0xb9, 0x28,
+	0x70, 0x6d, 0xfe, 0x76, 0x41, 0xdf, 0x1b, 0xee, 0x74, 0x57, 0xd2, 0xe9, 0xa3, 0x4e, 0x6f, 0x0b,
+	0xb7, 0xfa, 0x09, 0x98, 0x77, 0xb1, 0xe5, 0x9c, 0x09, 0xad, 0xfc, 0xb1, 0xcd, 0xc2, 0xa5, 0x66,
+	0x29, 0x7c, 0x06, 0x66, 0x2e, 0xb4, 0xd6, 0x03, 0xc6, 0x9b, 0xb6, 0x99, 0x59, 0xb8, 0x7a, 0x07,
+	0x16, 0x87, 0xb7, 0x87, 0xc7, 0x60, 0xe4, 0xba, 0x37, 0x23, 0x1e, 0x27, 0x43, 0x62, 0x7b, 0x86,
+	0x3e, 0x18, 0xde, 0x1d, 0xab, 0xfb, 0x3c, 0xfb, 0x7c, 0xbd, 0x0b, 0xbc, 0x9b, 0x5d, 0xe0, 0xfd,
+	0xd9, 0x05, 0xde, 0x8f, 0x7d, 0xd0, 0xbb, 0xd9, 0x07, 0xbd, 0x9f, 0xfb, 0xa0, 0xf7, 0xe5, 0x4d,
+	0xca, 0x75, 0xf6, 0x6d, 0x13, 0x11, 0x59, 0xc4, 0xa4, 0xba, 0x2a, 0xb5, 0x0c, 0x65, 0x95, 0x86,
+	0x66, 0x1c, 0xb1, 0x79, 0x86, 0x66, 0x6d, 0xeb, 0x66, 0x71, 0xc3, 0x6e, 0x71, 0xf5, 0x55, 0xc9,
+	0xd4, 0x66, 0x60, 0x96, 0xf0, 0xf5, 0xdf, 0x01, 0x00, 0xec, 0x00, 0x52, 0xb1, 0xdf, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeniedClients) > 0 {
+		for iNdEx := len(m.DeniedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedClients[iNdEx])
+			copy(dAtA[i:], m.DeniedClients[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedClients) > 0 {
+		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedClients[iNdEx])
+			copy(dAtA[i:], m.AllowedClients[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxTokenIdLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTokenIdLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxClassIdLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxClassIdLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTokenIds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTokenIds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ClassSendEnabled) > 0 {
+		for iNdEx := len(m.ClassSendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassSendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for iNdEx := len(m.DeniedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedChannels[iNdEx])
+			copy(dAtA[i:], m.DeniedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedChannels[iNdEx])
+			copy(dAtA[i:], m.AllowedChannels[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedChannels[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ReceiveEnabled {
+		i--
+		if m.ReceiveEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SendEnabled {
+		i--
+		if m.SendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassSendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendEnabled {
+		n += 2
+	}
+	if m.ReceiveEnabled {
+		n += 2
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, s := range m.AllowedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedChannels) > 0 {
+		for _, s := range m.DeniedChannels {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ClassSendEnabled) > 0 {
+		for _, e := range m.ClassSendEnabled {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTokenIds != 0 {
+		n += 1 + sovParams(uint64(m.MaxTokenIds))
+	}
+	if m.MaxClassIdLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxClassIdLength))
+	}
+	if m.MaxTokenIdLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTokenIdLength))
+	}
+	if len(m.AllowedClients) > 0 {
+		for _, s := range m.AllowedClients {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedClients) > 0 {
+		for _, s := range m.DeniedClients {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClassSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedChannels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedChannels = append(m.DeniedChannels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassSendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassSendEnabled = append(m.ClassSendEnabled, ClassSendEnabled{})
+			if err := m.ClassSendEnabled[len(m.ClassSendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenIds", wireType)
+			}
+			m.MaxTokenIds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokenIds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClassIdLength", wireType)
+			}
+			m.MaxClassIdLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClassIdLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenIdLength", wireType)
+			}
+			m.MaxTokenIdLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokenIdLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedClients = append(m.DeniedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassSendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassSendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
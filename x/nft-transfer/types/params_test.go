@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr bool
+	}{
+		{"default", types.DefaultParams(), false},
+		{"zero value", types.Params{}, false},
+		{"channel lists", types.NewParams(true, true, []string{"channel-0"}, []string{"channel-1"}, nil, 1, 1, 1), false},
+		{"invalid allowed channel", types.NewParams(true, true, []string{"@channel-0"}, nil, nil, 1, 1, 1), true},
+		{"invalid denied channel", types.NewParams(true, true, nil, []string{"@channel-0"}, nil, 1, 1, 1), true},
+		{"duplicate allowed channel", types.NewParams(true, true, []string{"channel-0", "channel-0"}, nil, nil, 1, 1, 1), true},
+		{"allowed and denied channel", types.NewParams(true, true, []string{"channel-0"}, []string{"channel-0"}, nil, 1, 1, 1), true},
+		{"client lists", types.Params{AllowedClients: []string{"07-tendermint-0"}, DeniedClients: []string{"07-tendermint-1"}}, false},
+		{"invalid allowed client", types.Params{AllowedClients: []string{"07/tendermint-0"}}, true},
+		{"invalid denied client", types.Params{DeniedClients: []string{"@07-tendermint-0"}}, true},
+		{"duplicate denied client", types.Params{DeniedClients: []string{"07-tendermint-0", "07-tendermint-0"}}, true},
+		{"allowed and denied client", types.Params{AllowedClients: []string{"07-tendermint-0"}, DeniedClients: []string{"07-tendermint-0"}}, true},
+		{"blank class", types.NewParams(true, true, nil, nil, []types.ClassSendEnabled{{ClassId: " "}}, 1, 1, 1), true},
+		{"duplicate class", types.NewParams(true, true, nil, nil, []types.ClassSendEnabled{{ClassId: "kitty"}, {ClassId: "kitty", Enabled: true}}, 1, 1, 1), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.params.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Params.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_IsChannelEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.IsChannelEnabled("channel-0"))
+
+	params.DeniedChannels = []string{"channel-1"}
+	require.True(t, params.IsChannelEnabled("channel-0"))
+	require.False(t, params.IsChannelEnabled("channel-1"))
+
+	params.AllowedChannels = []string{"channel-2"}
+	require.False(t, params.IsChannelEnabled("channel-0"))
+	require.False(t, params.IsChannelEnabled("channel-1"))
+	require.True(t, params.IsChannelEnabled("channel-2"))
+}
+
+func TestParams_IsRouteEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	params.DeniedChannels = []string{"channel-0"}
+	params.AllowedClients = []string{"07-tendermint-1"}
+
+	// the channel IDs, including aliased channels of IBC v2 packets, use the channel lists
+	require.False(t, params.IsRouteEnabled("channel-0"))
+	require.True(t, params.IsRouteEnabled("channel-1"))
+	require.False(t, params.IsRouteEnabled("07-tendermint-0"))
+	require.True(t, params.IsRouteEnabled("07-tendermint-1"))
+
+	params.DeniedClients = []string{"07-tendermint-1"}
+	require.False(t, params.IsClientEnabled("07-tendermint-1"))
+}
+
+func TestParams_IsSendEnabledClass(t *testing.T) {
+	params := types.DefaultParams()
+	params.ClassSendEnabled = []types.ClassSendEnabled{
+		{ClassId: "kitty", Enabled: false},
+		{ClassId: "puppy", Enabled: true},
+	}
+	require.True(t, params.IsSendEnabledClass("bunny"))
+	require.False(t, params.IsSendEnabledClass("kitty"))
+	require.True(t, params.IsSendEnabledClass("puppy"))
+
+	params.SendEnabled = false
+	require.False(t, params.IsSendEnabledClass("bunny"))
+	require.False(t, params.IsSendEnabledClass("kitty"))
+	require.True(t, params.IsSendEnabledClass("puppy"))
+}
+
+func TestParams_ValidateTokens(t *testing.T) {
+	params := types.NewParams(true, true, nil, nil, nil, 2, 10, 5)
+	require.NoError(t, params.ValidateTokens("cryptoCat", []string{"kitty", "tom"}))
+	require.ErrorIs(t, params.ValidateTokens("cryptoCat", []string{"a", "b", "c"}), types.ErrInvalidTokenID)
+	require.ErrorIs(t, params.ValidateTokens("cryptoCat", []string{"kitty1"}), types.ErrInvalidTokenID)
+	require.ErrorIs(t, params.ValidateTokens("nft/channel-0/cryptoCat", []string{"kitty"}), types.ErrInvalidClassID)
+
+	// zero disables the limits
+	params = types.Params{}
+	require.NoError(t, params.ValidateTokens(strings.Repeat("c", 4096), []string{strings.Repeat("t", 4096)}))
+}
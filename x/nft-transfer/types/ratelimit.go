@@ -0,0 +1,77 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// NewRateLimit creates a rate limit of a class on a channel with a new window
+// starting at windowStart
+func NewRateLimit(channelID, classID string, quota RateLimitQuota, windowStart time.Time) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		ClassId:   classID,
+		Quota:     quota,
+		Flow:      RateLimitFlow{WindowStart: windowStart},
+	}
+}
+
+// Validate performs basic validation of a rate limit quota
+func (q RateLimitQuota) Validate() error {
+	if q.Window <= 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "window must be positive")
+	}
+	if q.MaxSend == 0 && q.MaxReceive == 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "at least one of max send and max receive must be set")
+	}
+	return nil
+}
+
+// Validate performs basic validation of a rate limit
+func (r RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return sdkerrors.Wrapf(err, "invalid channel %s", r.ChannelId)
+	}
+	if strings.TrimSpace(r.ClassId) == "" {
+		return sdkerrors.Wrap(ErrInvalidClassID, "classId cannot be blank")
+	}
+	return r.Quota.Validate()
+}
+
+// AddSent counts amount NFTs sent at the given block time, failing if the send
+// quota of the current window would be exceeded
+func (r *RateLimit) AddSent(now time.Time, amount uint64) error {
+	r.rollWindow(now)
+	if r.Quota.MaxSend > 0 && r.Flow.Sent+amount > r.Quota.MaxSend {
+		return sdkerrors.Wrapf(ErrRateLimitExceeded,
+			"sending %d NFTs of class %s on channel %s exceeds the quota, %d of %d already sent in the current window",
+			amount, r.ClassId, r.ChannelId, r.Flow.Sent, r.Quota.MaxSend)
+	}
+	r.Flow.Sent += amount
+	return nil
+}
+
+// AddReceived counts amount NFTs received at the given block time, failing if the
+// receive quota of the current window would be exceeded
+func (r *RateLimit) AddReceived(now time.Time, amount uint64) error {
+	r.rollWindow(now)
+	if r.Quota.MaxReceive > 0 && r.Flow.Received+amount > r.Quota.MaxReceive {
+		return sdkerrors.Wrapf(ErrRateLimitExceeded,
+			"receiving %d NFTs of class %s on channel %s exceeds the quota, %d of %d already received in the current window",
+			amount, r.ClassId, r.ChannelId, r.Flow.Received, r.Quota.MaxReceive)
+	}
+	r.Flow.Received += amount
+	return nil
+}
+
+// rollWindow starts a new window at now once the current one has elapsed
+func (r *RateLimit) rollWindow(now time.Time) {
+	if now.Before(r.Flow.WindowStart.Add(r.Quota.Window)) {
+		return
+	}
+	r.Flow = RateLimitFlow{WindowStart: now}
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	quota := types.RateLimitQuota{MaxSend: 10, Window: time.Hour}
+	tests := []struct {
+		name      string
+		rateLimit types.RateLimit
+		wantErr   bool
+	}{
+		{"valid", types.NewRateLimit("channel-0", "kitty", quota, time.Time{}), false},
+		{"receive only", types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxReceive: 1, Window: time.Hour}, time.Time{}), false},
+		{"invalid channel", types.NewRateLimit("@channel-0", "kitty", quota, time.Time{}), true},
+		{"blank class", types.NewRateLimit("channel-0", " ", quota, time.Time{}), true},
+		{"no window", types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxSend: 10}, time.Time{}), true},
+		{"no limit", types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{Window: time.Hour}, time.Time{}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.rateLimit.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("RateLimit.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRateLimit_AddSent(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxSend: 3, Window: time.Hour}, start)
+
+	require.NoError(t, rateLimit.AddSent(start, 2))
+	require.NoError(t, rateLimit.AddSent(start.Add(time.Minute), 1))
+	require.ErrorIs(t, rateLimit.AddSent(start.Add(time.Minute), 1), types.ErrRateLimitExceeded)
+	require.Equal(t, uint64(3), rateLimit.Flow.Sent)
+
+	// receiving is not limited
+	require.NoError(t, rateLimit.AddReceived(start.Add(time.Minute), 100))
+
+	// a new window starts once the current one has elapsed
+	require.NoError(t, rateLimit.AddSent(start.Add(time.Hour), 3))
+	require.Equal(t, types.RateLimitFlow{Sent: 3, WindowStart: start.Add(time.Hour)}, rateLimit.Flow)
+}
+
+func TestRateLimit_AddReceived(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := types.NewRateLimit("channel-0", "kitty", types.RateLimitQuota{MaxReceive: 2, Window: time.Hour}, start)
+
+	require.ErrorIs(t, rateLimit.AddReceived(start, 3), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimit.AddReceived(start, 2))
+	require.ErrorIs(t, rateLimit.AddReceived(start.Add(time.Hour-time.Second), 1), types.ErrRateLimitExceeded)
+	require.NoError(t, rateLimit.AddReceived(start.Add(2*time.Hour), 1))
+	require.Equal(t, uint64(1), rateLimit.Flow.Received)
+}
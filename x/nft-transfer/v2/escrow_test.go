@@ -0,0 +1,139 @@
+package v2_test
+
+import (
+	"time"
+
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// requireEscrowIndex checks that the escrow index of chain A holds exactly the NFTs of
+// the test class, in token ID order, and matches the NFTs owned by the escrow account
+func (suite *TransferTestSuite) requireEscrowIndex(tokenIDs ...string) {
+	ctx := suite.chainA.GetContext()
+	transferKeeper := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	clientID := suite.path.EndpointA.ClientID
+
+	expected := []types.EscrowedNFT{}
+	for _, tokenID := range tokenIDs {
+		expected = append(expected, types.NewEscrowedNFT(types.PortID, clientID, classID, tokenID))
+	}
+
+	escrowed, err := transferKeeper.EscrowedNFTs(ctx, &types.QueryEscrowedNFTsRequest{PortId: types.PortID, ChannelId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, escrowed.EscrowedNfts)
+
+	escrowed, err = transferKeeper.EscrowedNFTs(ctx, &types.QueryEscrowedNFTsRequest{PortId: types.PortID, ChannelId: clientID, ClassId: classID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, escrowed.EscrowedNfts)
+
+	total, err := transferKeeper.TotalEscrow(ctx, &types.QueryTotalEscrowRequest{ClassId: classID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(tokenIDs)), total.Amount)
+
+	consistency, err := transferKeeper.EscrowConsistency(ctx, &types.QueryEscrowConsistencyRequest{PortId: types.PortID, ChannelId: clientID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(consistency.NotOwned)
+	suite.Require().Empty(consistency.NotIndexed)
+}
+
+func (suite *TransferTestSuite) TestEscrowIndexSendAndReturn() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2")
+	suite.requireEscrowIndex()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	packet, err := suite.transferV2(endpointA, classID, tokenID, "kitty2")
+	suite.Require().NoError(err)
+	suite.requireEscrowIndex(tokenID, "kitty2")
+
+	suite.relayPacket(endpointA, packet)
+	suite.requireEscrowIndex(tokenID, "kitty2")
+
+	escrowed, err := testutil.ChainApp(suite.chainA).NFTTransferKeeper.EscrowedNFTs(suite.chainA.GetContext(), &types.QueryEscrowedNFTsRequest{
+		PortId:     types.PortID,
+		ChannelId:  endpointA.ClientID,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowedNFT{types.NewEscrowedNFT(types.PortID, endpointA.ClientID, classID, tokenID)}, escrowed.EscrowedNfts)
+	suite.Require().NotEmpty(escrowed.Pagination.NextKey)
+
+	// the returned NFT leaves the escrow index of chain A
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID)
+	packet = suite.sendNFT(endpointB, voucherTrace.GetFullClassPath(), "uri", time.Hour)
+	suite.relayPacket(endpointB, packet)
+	suite.requireOwner(tokenID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.requireEscrowIndex("kitty2")
+}
+
+func (suite *TransferTestSuite) TestEscrowIndexTimeoutRefund() {
+	suite.mintNFT()
+
+	endpointA := suite.path.EndpointA
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Minute)
+	suite.requireEscrowIndex(tokenID)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.Require().NoError(endpointA.UpdateClient())
+	suite.Require().NoError(endpointA.MsgTimeoutPacket(packet))
+	suite.requireEscrowIndex()
+}
+
+func (suite *TransferTestSuite) TestEscrowIndexErrorAckRefund() {
+	suite.mintNFT()
+
+	// chain B refuses the NFTs
+	appB := testutil.ChainApp(suite.chainB)
+	params := appB.NFTTransferKeeper.GetParams(suite.chainB.GetContext())
+	params.ReceiveEnabled = false
+	suite.Require().NoError(appB.NFTTransferKeeper.SetParams(suite.chainB.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	endpointA := suite.path.EndpointA
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Hour)
+	suite.requireEscrowIndex(tokenID)
+
+	suite.Require().NoError(endpointA.Counterparty.MsgRecvPacket(packet))
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+	suite.Require().NoError(endpointA.MsgAcknowledgePacket(packet, errorAck))
+	suite.requireOwner(tokenID, suite.chainA.SenderAccount.GetAddress().String())
+	suite.requireEscrowIndex()
+}
+
+func (suite *TransferTestSuite) TestCheckEscrowConsistency() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2", "kitty3")
+
+	endpointA := suite.path.EndpointA
+	_, err := suite.transferV2(endpointA, classID, tokenID, "kitty2")
+	suite.Require().NoError(err)
+	suite.requireEscrowIndex(tokenID, "kitty2")
+
+	// NFTs moved in or out of the escrow account outside of the module
+	ctx := suite.chainA.GetContext()
+	appA := testutil.ChainApp(suite.chainA)
+	sender := suite.chainA.SenderAccount.GetAddress()
+	escrow := types.GetEscrowAddress(types.PortID, endpointA.ClientID)
+	suite.Require().NoError(appA.NFTKeeper.TransferOwner(ctx, classID, tokenID, escrow, sender))
+	suite.Require().NoError(appA.NFTKeeper.TransferOwner(ctx, classID, "kitty3", sender, escrow))
+
+	notOwned, notIndexed, err := appA.NFTTransferKeeper.CheckEscrowConsistency(ctx, types.PortID, endpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowedNFT{types.NewEscrowedNFT(types.PortID, endpointA.ClientID, classID, tokenID)}, notOwned)
+	suite.Require().Equal([]types.EscrowedNFT{types.NewEscrowedNFT(types.PortID, endpointA.ClientID, classID, "kitty3")}, notIndexed)
+
+	res, err := appA.NFTTransferKeeper.EscrowConsistency(ctx, &types.QueryEscrowConsistencyRequest{PortId: types.PortID, ChannelId: endpointA.ClientID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(notOwned, res.NotOwned)
+	suite.Require().Equal(notIndexed, res.NotIndexed)
+
+	// the escrow accounts of the other clients are unaffected
+	notOwned, notIndexed, err = appA.NFTTransferKeeper.CheckEscrowConsistency(ctx, types.PortID, "07-tendermint-9")
+	suite.Require().NoError(err)
+	suite.Require().Empty(notOwned)
+	suite.Require().Empty(notIndexed)
+}
@@ -0,0 +1,290 @@
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+
+	newsdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ api.IBCModule             = IBCModule{}
+	_ api.PacketDataUnmarshaler = IBCModule{}
+)
+
+// IBCModule implements the IBC v2 application callbacks for nft-transfer given the
+// nft-transfer keeper.
+//
+// IBC v2 packets are sent between light clients without a channel handshake, so the
+// client IDs take the place of the channel IDs: vouchers are prefixed with
+// {port}/{client-id}/ and NFTs are escrowed in the escrow account of the port and
+// client ID.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBC v2 IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBC v2 IBCModule interface. The NFTs described by the
+// payload are escrowed or burnt for the signer, who must be the sender.
+func (im IBCModule) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	if err := validatePayloadRoute(payload, sourceClient, destinationClient); err != nil {
+		return err
+	}
+
+	data, err := unmarshalPacketData(payload)
+	if err != nil {
+		return err
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if data.Sender != signer.String() {
+		return newsdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is different from signer %s", data.Sender, signer)
+	}
+
+	// without channel identifiers in the class path, a slash in the base class ID
+	// cannot be told apart from the trace
+	if strings.Contains(types.ParseClassTrace(data.ClassId).BaseClassId, "/") {
+		return newsdkerrors.Wrapf(types.ErrInvalidClassID, "base class %s cannot contain slashes for IBC v2 packets", data.ClassId)
+	}
+
+	if err := im.keeper.OnSendPacket(ctx, payload.SourcePort, sourceClient, sequence, data, signer); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// OnRecvPacket implements the IBC v2 IBCModule interface. A successful result is
+// returned if the payload is successfully decoded and the receive application logic
+// returns without error. An asynchronous result is returned if the NFTs are forwarded
+// as instructed by the memo, the acknowledgement is written once the forward completes.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	if err := validatePayloadRoute(payload, sourceClient, destinationClient); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	var forwarded bool
+	data, err := unmarshalPacketData(payload)
+	if err == nil {
+		forwarded, err = im.onRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, data)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	if err != nil {
+		ack = types.NewErrorAcknowledgement(err)
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+		),
+	)
+
+	if !ack.Success() {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+	if forwarded {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Async}
+	}
+
+	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: ack.Acknowledgement(),
+	}
+}
+
+// onRecvPacket receives the NFTs of a payload, and forwards them over another client
+// if instructed by the memo, in which case true is returned
+func (im IBCModule) onRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	data types.NonFungibleTokenPacketData,
+) (bool, error) {
+	forward, err := types.ParseForwardMetadata(data.Memo)
+	if err != nil {
+		return false, err
+	}
+	if forward == nil {
+		return false, im.keeper.OnRecvPacket(ctx, data, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient)
+	}
+
+	received := types.InFlightForward{
+		SourcePort:         payload.SourcePort,
+		SourceChannel:      sourceClient,
+		DestinationPort:    payload.DestinationPort,
+		DestinationChannel: destinationClient,
+		Sequence:           sequence,
+		Data:               data,
+	}
+	return true, im.keeper.OnRecvForwardPacket(ctx, received, *forward)
+}
+
+// OnTimeoutPacket implements the IBC v2 IBCModule interface and refunds the sender.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	data, err := unmarshalPacketData(payload)
+	if err != nil {
+		return err
+	}
+
+	// refund tokens
+	if err := im.keeper.OnTimeoutPacket(ctx, payload.SourcePort, sourceClient, sequence, data); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+		),
+	)
+
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBC v2 IBCModule interface and refunds the
+// sender if the packet failed on the receiving chain.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	// IBC v2 replaces error acknowledgements with a sentinel, any error will do to refund
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		ack = types.NewErrorAcknowledgement(types.ErrInvalidPacket)
+	} else {
+		if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+			return newsdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet acknowledgement: %v", err)
+		}
+		if !ack.Success() {
+			return newsdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot pass in a custom error acknowledgement with IBC v2")
+		}
+	}
+
+	data, err := unmarshalPacketData(payload)
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.OnAcknowledgementPacket(ctx, payload.SourcePort, sourceClient, sequence, data, ack); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, data.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(data.TokenIds, ",")),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+		),
+	)
+
+	return nil
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface
+func (IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return unmarshalPacketData(payload)
+}
+
+// validatePayloadRoute checks that a payload is sent between nft-transfer ports of
+// clients. The ports are not negotiated by a handshake in IBC v2, and the class trace
+// records the destination port only, so both must be the nft-transfer port.
+func validatePayloadRoute(payload channeltypesv2.Payload, sourceClient, destinationClient string) error {
+	if payload.SourcePort != types.PortID || payload.DestinationPort != types.PortID {
+		return newsdkerrors.Wrapf(
+			channeltypesv2.ErrInvalidPacket,
+			"payload port ID is invalid: expected %s, got sourcePort: %s destPort: %s",
+			types.PortID, payload.SourcePort, payload.DestinationPort,
+		)
+	}
+	if !clienttypes.IsValidClientID(sourceClient) || !clienttypes.IsValidClientID(destinationClient) {
+		return newsdkerrors.Wrap(channeltypesv2.ErrInvalidPacket, "client IDs must be in valid format: {string}-{number}")
+	}
+	return nil
+}
+
+// unmarshalPacketData decodes the ICS-721 packet data of a payload
+func unmarshalPacketData(payload channeltypesv2.Payload) (types.NonFungibleTokenPacketData, error) {
+	var data types.NonFungibleTokenPacketData
+	if payload.Version != types.Version {
+		return data, newsdkerrors.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", payload.Version, types.Version)
+	}
+	if payload.Encoding != types.EncodingJSON {
+		return data, newsdkerrors.Wrapf(types.ErrInvalidPacket, "unsupported encoding %s, expected %s", payload.Encoding, types.EncodingJSON)
+	}
+	if err := types.ModuleCdc.UnmarshalJSON(payload.Value, &data); err != nil {
+		return data, newsdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 transfer packet data: %s", err.Error())
+	}
+	return data, nil
+}
@@ -0,0 +1,531 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	hostv2 "github.com/cosmos/ibc-go/v10/modules/core/24-host/v2"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	classID = "cryptoCat"
+	tokenID = "kitty"
+)
+
+type TransferTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// path between chain A and chain B
+	path *ibctesting.Path
+	// path between chain B and chain C
+	pathBToC *ibctesting.Path
+}
+
+func (suite *TransferTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCustomAppCoordinator(suite.T(), 3, testutil.SetupTestingApp)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+	suite.pathBToC = ibctesting.NewPath(suite.chainB, suite.chainC)
+	suite.pathBToC.SetupV2()
+}
+
+func TestTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(TransferTestSuite))
+}
+
+// mintNFT issues the test class on chain A and mints the test NFT to its sender account
+func (suite *TransferTestSuite) mintNFT() {
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	nftKeeper := testutil.ChainApp(suite.chainA).NFTKeeper
+
+	suite.Require().NoError(nftKeeper.IssueDenom(ctx, classID, classID, "", "uri", sender))
+	suite.Require().NoError(nftKeeper.MintNFT(ctx, classID, tokenID, tokenID, "kitty_uri", "", sender, sender))
+	suite.coordinator.CommitBlock(suite.chainA)
+}
+
+// sendNFT sends the given class on the endpoint to the sender account of the counterparty
+func (suite *TransferTestSuite) sendNFT(endpoint *ibctesting.Endpoint, classID, classURI string, timeout time.Duration) channeltypesv2.Packet {
+	return suite.sendNFTWithMemo(endpoint, classID, classURI, timeout, "")
+}
+
+// sendNFTWithMemo sends the given class on the endpoint to the sender account of the
+// counterparty with a memo
+func (suite *TransferTestSuite) sendNFTWithMemo(endpoint *ibctesting.Endpoint, classID, classURI string, timeout time.Duration, memo string) channeltypesv2.Packet {
+	data := types.NewNonFungibleTokenPacketData(
+		classID, classURI, []string{tokenID}, []string{"kitty_uri"},
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		memo,
+	)
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, types.EncodingJSON, data.GetBytes())
+	timeoutTimestamp := uint64(endpoint.Chain.GetContext().BlockTime().Add(timeout).Unix())
+
+	packet, err := endpoint.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().NoError(err)
+	return packet
+}
+
+// relayPacket receives the packet on the counterparty and acknowledges it on the endpoint
+func (suite *TransferTestSuite) relayPacket(endpoint *ibctesting.Endpoint, packet channeltypesv2.Packet) {
+	suite.Require().NoError(endpoint.Counterparty.MsgRecvPacket(packet))
+
+	ack := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(endpoint.MsgAcknowledgePacket(packet, ack))
+}
+
+// recvPacketWithForward receives the packet on the counterparty of the endpoint, and
+// returns the packet it forwards
+func (suite *TransferTestSuite) recvPacketWithForward(endpoint *ibctesting.Endpoint, packet channeltypesv2.Packet) channeltypesv2.Packet {
+	counterparty := endpoint.Counterparty
+	proof, proofHeight := endpoint.Chain.QueryProof(hostv2.PacketCommitmentKey(packet.SourceClient, packet.Sequence))
+	msg := channeltypesv2.NewMsgRecvPacket(packet, proof, proofHeight, counterparty.Chain.SenderAccount.GetAddress().String())
+
+	res, err := counterparty.Chain.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().NoError(endpoint.UpdateClient())
+
+	packets, err := ibctesting.ParseIBCV2Packets(channeltypesv2.EventTypeSendPacket, res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	return packets[0]
+}
+
+func (suite *TransferTestSuite) TestTransferAndReturn() {
+	suite.mintNFT()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Hour)
+
+	// the NFT is escrowed on chain A under the client ID
+	escrowA := types.GetEscrowAddress(types.PortID, endpointA.ClientID)
+	transferKeeperA := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrowA, nft.GetOwner())
+	suite.Require().True(transferKeeperA.HasEscrowedNFT(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, classID, tokenID))
+
+	// the transfer is in flight until the packet is acknowledged
+	transfer, found := transferKeeperA.GetInFlightTransfer(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(classID, transfer.ClassId)
+	suite.Require().Equal([]string{tokenID}, transfer.TokenIds)
+	suite.Require().Equal(senderA.String(), transfer.Sender)
+
+	bySender, err := transferKeeperA.InFlightTransfersBySender(suite.chainA.GetContext(), &types.QueryInFlightTransfersBySenderRequest{Sender: senderA.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InFlightTransfer{transfer}, bySender.InFlightTransfers)
+
+	byClass, err := transferKeeperA.InFlightTransfersByClass(suite.chainA.GetContext(), &types.QueryInFlightTransfersByClassRequest{ClassId: classID, TokenId: tokenID})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.InFlightTransfer{transfer}, byClass.InFlightTransfers)
+
+	byClass, err = transferKeeperA.InFlightTransfersByClass(suite.chainA.GetContext(), &types.QueryInFlightTransfersByClassRequest{ClassId: classID, TokenId: "other"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byClass.InFlightTransfers)
+
+	suite.relayPacket(endpointA, packet)
+
+	_, found = transferKeeperA.GetInFlightTransfer(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+	bySender, err = transferKeeperA.InFlightTransfersBySender(suite.chainA.GetContext(), &types.QueryInFlightTransfersBySenderRequest{Sender: senderA.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(bySender.InFlightTransfers)
+
+	// the voucher class on chain B is prefixed with the client ID of chain B
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID)
+	voucherClassID := voucherTrace.IBCClassID()
+	nft, err = testutil.ChainApp(suite.chainB).NFTKeeper.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiverB, nft.GetOwner())
+
+	// send the voucher back to its origin
+	packet = suite.sendNFT(endpointB, voucherTrace.GetFullClassPath(), "uri", time.Hour)
+	suite.Require().False(testutil.ChainApp(suite.chainB).NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	suite.relayPacket(endpointB, packet)
+
+	nft, err = testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(senderA, nft.GetOwner())
+	suite.Require().False(transferKeeperA.HasEscrowedNFT(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, classID, tokenID))
+	suite.Require().Zero(transferKeeperA.GetTotalEscrow(suite.chainA.GetContext(), classID))
+}
+
+func (suite *TransferTestSuite) TestTimeoutRefund() {
+	suite.mintNFT()
+
+	endpointA := suite.path.EndpointA
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Minute)
+	transferKeeperA := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	_, found := transferKeeperA.GetInFlightTransfer(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, packet.Sequence)
+	suite.Require().True(found)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.Require().NoError(endpointA.UpdateClient())
+	suite.Require().NoError(endpointA.MsgTimeoutPacket(packet))
+
+	ctx := suite.chainA.GetContext()
+	_, found = transferKeeperA.GetInFlightTransfer(ctx, types.PortID, endpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(ctx, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nft.GetOwner())
+	suite.Require().False(transferKeeperA.HasEscrowedNFT(ctx, types.PortID, endpointA.ClientID, classID, tokenID))
+}
+
+func (suite *TransferTestSuite) TestSendRejectsInvalidPayload() {
+	suite.mintNFT()
+
+	data := types.NewNonFungibleTokenPacketData(
+		classID, "uri", []string{tokenID}, []string{"kitty_uri"},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+	)
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+
+	payloads := map[string]channeltypesv2.Payload{
+		"wrong version":  channeltypesv2.NewPayload(types.PortID, types.PortID, "ics721-2", types.EncodingJSON, data.GetBytes()),
+		"wrong encoding": channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, "application/x-protobuf", data.GetBytes()),
+		"wrong port":     channeltypesv2.NewPayload(types.PortID, "transfer", types.Version, types.EncodingJSON, data.GetBytes()),
+	}
+	for name, payload := range payloads {
+		_, err := suite.path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+		suite.Require().Error(err, name)
+	}
+
+	// a different class URI than the one on chain
+	data.ClassUri = "other"
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, types.EncodingJSON, data.GetBytes())
+	_, err := suite.path.EndpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().ErrorContains(err, types.ErrInvalidPacket.Error())
+
+	ctx := suite.chainA.GetContext()
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(ctx, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nft.GetOwner())
+}
+
+func (suite *TransferTestSuite) TestRecvValidatesReceivedClassID() {
+	suite.mintNFT()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID)
+	voucherClassID := voucherTrace.IBCClassID()
+
+	// the voucher class ID is longer than the class ID of the packet
+	appB := testutil.ChainApp(suite.chainB)
+	params := appB.NFTTransferKeeper.GetParams(suite.chainB.GetContext())
+	params.MaxClassIdLength = uint32(len(voucherClassID) - 1)
+	suite.Require().NoError(appB.NFTTransferKeeper.SetParams(suite.chainB.GetContext(), params))
+
+	const rejectedClassID = "cryptoDog"
+	sender := suite.chainA.SenderAccount.GetAddress()
+	appA := testutil.ChainApp(suite.chainA)
+	suite.Require().NoError(appA.NFTKeeper.IssueDenom(suite.chainA.GetContext(), rejectedClassID, rejectedClassID, "", "uri", sender))
+	suite.Require().NoError(appA.NFTKeeper.MintNFT(suite.chainA.GetContext(), rejectedClassID, tokenID, tokenID, "kitty_uri", "", sender, sender))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	rejectedVoucherClassID := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + rejectedClassID).IBCClassID()
+	packet := suite.sendNFT(endpointA, rejectedClassID, "uri", time.Hour)
+	suite.Require().NoError(endpointA.Counterparty.MsgRecvPacket(packet))
+	suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), rejectedVoucherClassID, tokenID))
+
+	params.MaxClassIdLength = uint32(len(voucherClassID))
+	suite.Require().NoError(appB.NFTTransferKeeper.SetParams(suite.chainB.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	packet = suite.sendNFT(endpointA, classID, "uri", time.Hour)
+	suite.relayPacket(endpointA, packet)
+	suite.Require().True(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	// the prefixed class path of a returning voucher is longer than the class ID
+	params = appA.NFTTransferKeeper.GetParams(suite.chainA.GetContext())
+	params.MaxClassIdLength = uint32(len(classID))
+	suite.Require().NoError(appA.NFTTransferKeeper.SetParams(suite.chainA.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	packet = suite.sendNFT(endpointB, voucherTrace.GetFullClassPath(), "uri", time.Hour)
+	suite.relayPacket(endpointB, packet)
+	nft, err := appA.NFTKeeper.GetNFT(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nft.GetOwner())
+}
+
+func (suite *TransferTestSuite) TestDeniedClient() {
+	suite.mintNFT()
+
+	endpointA := suite.path.EndpointA
+	appA := testutil.ChainApp(suite.chainA)
+	params := appA.NFTTransferKeeper.GetParams(suite.chainA.GetContext())
+	params.DeniedClients = []string{endpointA.ClientID}
+	suite.Require().NoError(appA.NFTTransferKeeper.SetParams(suite.chainA.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	data := types.NewNonFungibleTokenPacketData(
+		classID, "uri", []string{tokenID}, []string{"kitty_uri"},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+	)
+	payload := channeltypesv2.NewPayload(types.PortID, types.PortID, types.Version, types.EncodingJSON, data.GetBytes())
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix())
+	_, err := endpointA.MsgSendPacket(timeoutTimestamp, payload)
+	suite.Require().ErrorContains(err, types.ErrChannelDisabled.Error())
+
+	params.DeniedClients = nil
+	suite.Require().NoError(appA.NFTTransferKeeper.SetParams(suite.chainA.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.relayPacket(endpointA, suite.sendNFT(endpointA, classID, "uri", time.Hour))
+}
+
+func (suite *TransferTestSuite) forwardMemo() string {
+	forward := map[string]any{
+		"forward": types.ForwardMetadata{
+			Receiver: suite.chainC.SenderAccount.GetAddress().String(),
+			Port:     types.PortID,
+			Channel:  suite.pathBToC.EndpointA.ClientID,
+		},
+	}
+	memo, err := json.Marshal(forward)
+	suite.Require().NoError(err)
+	return string(memo)
+}
+
+func (suite *TransferTestSuite) TestForward() {
+	suite.mintNFT()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	endpointBToC, endpointC := suite.pathBToC.EndpointA, suite.pathBToC.EndpointB
+
+	packet := suite.sendNFTWithMemo(endpointA, classID, "uri", time.Hour, suite.forwardMemo())
+	forwarded := suite.recvPacketWithForward(endpointA, packet)
+
+	// chain B escrows the voucher while the forward is in flight
+	transferKeeperB := testutil.ChainApp(suite.chainB).NFTTransferKeeper
+	voucherB := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID).IBCClassID()
+	suite.Require().True(transferKeeperB.HasEscrowedNFT(suite.chainB.GetContext(), types.PortID, endpointBToC.ClientID, voucherB, tokenID))
+	_, found := transferKeeperB.GetInFlightForward(suite.chainB.GetContext(), types.PortID, endpointBToC.ClientID, forwarded.Sequence)
+	suite.Require().True(found)
+
+	// chain C receives a voucher traced through chain B
+	suite.Require().NoError(endpointC.UpdateClient())
+	suite.relayPacket(endpointBToC, forwarded)
+	voucherTraceC := types.ParseClassTrace(
+		types.GetClassPrefix(types.PortID, endpointC.ClientID) + types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID,
+	)
+	nft, err := testutil.ChainApp(suite.chainC).NFTKeeper.GetNFT(suite.chainC.GetContext(), voucherTraceC.IBCClassID(), tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainC.SenderAccount.GetAddress(), nft.GetOwner())
+
+	// chain B acknowledges the received packet once the forward completes
+	_, found = transferKeeperB.GetInFlightForward(suite.chainB.GetContext(), types.PortID, endpointBToC.ClientID, forwarded.Sequence)
+	suite.Require().False(found)
+
+	ack := channeltypesv2.NewAcknowledgement(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+	suite.Require().NoError(endpointA.UpdateClient())
+	suite.Require().NoError(endpointA.MsgAcknowledgePacket(packet, ack))
+
+	nft, err = testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GetEscrowAddress(types.PortID, endpointA.ClientID), nft.GetOwner())
+}
+
+func (suite *TransferTestSuite) TestForwardRefund() {
+	suite.mintNFT()
+
+	// chain C refuses the forwarded NFTs
+	appC := testutil.ChainApp(suite.chainC)
+	params := appC.NFTTransferKeeper.GetParams(suite.chainC.GetContext())
+	params.ReceiveEnabled = false
+	suite.Require().NoError(appC.NFTTransferKeeper.SetParams(suite.chainC.GetContext(), params))
+	suite.coordinator.CommitBlock(suite.chainC)
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	endpointBToC := suite.pathBToC.EndpointA
+
+	packet := suite.sendNFTWithMemo(endpointA, classID, "uri", time.Hour, suite.forwardMemo())
+	forwarded := suite.recvPacketWithForward(endpointA, packet)
+
+	suite.Require().NoError(suite.pathBToC.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.pathBToC.EndpointB.MsgRecvPacket(forwarded))
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+	suite.Require().NoError(endpointBToC.MsgAcknowledgePacket(forwarded, errorAck))
+
+	// chain B burns the voucher it minted and passes the failure on
+	ctxB := suite.chainB.GetContext()
+	voucherB := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID).IBCClassID()
+	suite.Require().False(testutil.ChainApp(suite.chainB).NFTKeeper.HasNFT(ctxB, voucherB, tokenID))
+	suite.Require().Empty(testutil.ChainApp(suite.chainB).NFTTransferKeeper.GetAllInFlightForwards(ctxB))
+
+	suite.Require().NoError(endpointA.UpdateClient())
+	suite.Require().NoError(endpointA.MsgAcknowledgePacket(packet, errorAck))
+
+	ctxA := suite.chainA.GetContext()
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(ctxA, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nft.GetOwner())
+	suite.Require().False(testutil.ChainApp(suite.chainA).NFTTransferKeeper.HasEscrowedNFT(ctxA, types.PortID, endpointA.ClientID, classID, tokenID))
+}
+
+func (suite *TransferTestSuite) TestRecoverInFlightTransfer() {
+	suite.mintNFT()
+
+	endpointA := suite.path.EndpointA
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Hour)
+	transferKeeperA := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	msg := &types.MsgRecoverEscrowedNFTs{
+		Authority: transferKeeperA.GetAuthority(),
+		PortId:    types.PortID,
+		ChannelId: endpointA.ClientID,
+	}
+
+	// the client of chain B is active
+	_, err := transferKeeperA.RecoverEscrowedNFTs(suite.chainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, types.ErrEscrowNotRecoverable)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+
+	ctx := suite.chainA.GetContext()
+	res, err := transferKeeperA.RecoverEscrowedNFTs(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Recovered)
+
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(ctx, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), nft.GetOwner())
+	suite.Require().False(transferKeeperA.HasEscrowedNFT(ctx, types.PortID, endpointA.ClientID, classID, tokenID))
+	_, found := transferKeeperA.GetInFlightTransfer(ctx, types.PortID, endpointA.ClientID, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *TransferTestSuite) TestRecoverMappedEscrow() {
+	suite.mintNFT()
+
+	endpointA := suite.path.EndpointA
+	packet := suite.sendNFT(endpointA, classID, "uri", time.Hour)
+	suite.relayPacket(endpointA, packet)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+
+	transferKeeperA := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	recipient := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	msg := &types.MsgRecoverEscrowedNFTs{
+		Authority:  transferKeeperA.GetAuthority(),
+		PortId:     types.PortID,
+		ChannelId:  endpointA.ClientID,
+		Recoveries: []types.EscrowRecovery{types.NewEscrowRecovery(classID, "other", recipient.String())},
+	}
+
+	ctx := suite.chainA.GetContext()
+	_, err := transferKeeperA.RecoverEscrowedNFTs(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNotEscrowed)
+
+	msg.Recoveries = []types.EscrowRecovery{types.NewEscrowRecovery(classID, tokenID, recipient.String())}
+	res, err := transferKeeperA.RecoverEscrowedNFTs(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Recovered)
+
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(ctx, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(recipient, nft.GetOwner())
+	suite.Require().Zero(transferKeeperA.GetTotalEscrow(ctx, classID))
+}
+
+func (suite *TransferTestSuite) TestRecoverBurntVoucher() {
+	suite.mintNFT()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	suite.relayPacket(endpointA, suite.sendNFT(endpointA, classID, "uri", time.Hour))
+
+	// chain B burns the voucher it sends back to chain A
+	voucherTrace := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID)
+	voucherClassID := voucherTrace.IBCClassID()
+	packet := suite.sendNFT(endpointB, voucherTrace.GetFullClassPath(), "uri", time.Hour)
+	appB := testutil.ChainApp(suite.chainB)
+	suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+
+	ctx := suite.chainB.GetContext()
+	msg := &types.MsgRecoverEscrowedNFTs{
+		Authority:  appB.NFTTransferKeeper.GetAuthority(),
+		PortId:     types.PortID,
+		ChannelId:  endpointB.ClientID,
+		Recoveries: []types.EscrowRecovery{types.NewEscrowRecovery(voucherClassID, tokenID, suite.chainB.SenderAccount.GetAddress().String())},
+	}
+
+	// the explicit mapping cannot take over an in-flight transfer
+	_, err := appB.NFTTransferKeeper.RecoverEscrowedNFTs(ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrEscrowInFlight)
+
+	msg.Recoveries = nil
+	res, err := appB.NFTTransferKeeper.RecoverEscrowedNFTs(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Recovered)
+
+	// the voucher is minted to the sender again
+	nft, err := appB.NFTKeeper.GetNFT(ctx, voucherClassID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress(), nft.GetOwner())
+	suite.Require().Equal("kitty_uri", nft.GetURI())
+	_, found := appB.NFTTransferKeeper.GetInFlightTransfer(ctx, types.PortID, endpointB.ClientID, packet.Sequence)
+	suite.Require().False(found)
+}
+
+func (suite *TransferTestSuite) TestRecoverForward() {
+	suite.mintNFT()
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	endpointBToC := suite.pathBToC.EndpointA
+
+	packet := suite.sendNFTWithMemo(endpointA, classID, "uri", time.Hour, suite.forwardMemo())
+	forwarded := suite.recvPacketWithForward(endpointA, packet)
+
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+
+	appB := testutil.ChainApp(suite.chainB)
+	ctx := suite.chainB.GetContext()
+	res, err := appB.NFTTransferKeeper.RecoverEscrowedNFTs(ctx, &types.MsgRecoverEscrowedNFTs{
+		Authority: appB.NFTTransferKeeper.GetAuthority(),
+		PortId:    types.PortID,
+		ChannelId: endpointBToC.ClientID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Recovered)
+
+	// chain B burns the voucher rather than leaving it to the forward address, and
+	// acknowledges the received packet with an error so that chain A refunds the sender
+	voucherB := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID).IBCClassID()
+	suite.Require().False(appB.NFTKeeper.HasNFT(ctx, voucherB, tokenID))
+	suite.Require().False(appB.NFTTransferKeeper.HasEscrowedNFT(ctx, types.PortID, endpointBToC.ClientID, voucherB, tokenID))
+	_, found := appB.NFTTransferKeeper.GetInFlightForward(ctx, types.PortID, endpointBToC.ClientID, forwarded.Sequence)
+	suite.Require().False(found)
+
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+	suite.Require().Equal(
+		channeltypesv2.CommitAcknowledgement(errorAck),
+		appB.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(ctx, endpointB.ClientID, packet.Sequence),
+	)
+}
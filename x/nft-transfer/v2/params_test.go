@@ -0,0 +1,172 @@
+package v2_test
+
+import (
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+)
+
+// updateParams updates the nft-transfer params of a chain
+func (suite *TransferTestSuite) updateParams(chain *ibctesting.TestChain, update func(*types.Params)) {
+	transferKeeper := testutil.ChainApp(chain).NFTTransferKeeper
+	params := transferKeeper.GetParams(chain.GetContext())
+	update(&params)
+	suite.Require().NoError(transferKeeper.SetParams(chain.GetContext(), params))
+	suite.coordinator.CommitBlock(chain)
+}
+
+func (suite *TransferTestSuite) TestSendRestrictions() {
+	testCases := []struct {
+		name   string
+		update func(params *types.Params, clientID string)
+		err    error
+	}{
+		{
+			"send disabled",
+			func(params *types.Params, _ string) { params.SendEnabled = false },
+			types.ErrSendDisabled,
+		},
+		{
+			"class send disabled",
+			func(params *types.Params, _ string) {
+				params.ClassSendEnabled = []types.ClassSendEnabled{{ClassId: classID, Enabled: false}}
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"client not allowed",
+			func(params *types.Params, _ string) { params.AllowedClients = []string{"07-tendermint-9"} },
+			types.ErrChannelDisabled,
+		},
+		{
+			"client denied",
+			func(params *types.Params, clientID string) { params.DeniedClients = []string{clientID} },
+			types.ErrChannelDisabled,
+		},
+		{
+			"too many tokens",
+			func(params *types.Params, _ string) { params.MaxTokenIds = 1 },
+			types.ErrInvalidTokenID,
+		},
+		{
+			"token id too long",
+			func(params *types.Params, _ string) { params.MaxTokenIdLength = uint32(len(tokenID)) },
+			types.ErrInvalidTokenID,
+		},
+		{
+			"class id too long",
+			func(params *types.Params, _ string) { params.MaxClassIdLength = uint32(len(classID) - 1) },
+			types.ErrInvalidClassID,
+		},
+		{
+			"class send enabled",
+			func(params *types.Params, _ string) {
+				params.SendEnabled = false
+				params.ClassSendEnabled = []types.ClassSendEnabled{{ClassId: classID, Enabled: true}}
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mintNFT()
+			suite.mintNFTs("kitty2")
+
+			endpointA := suite.path.EndpointA
+			suite.updateParams(suite.chainA, func(params *types.Params) { tc.update(params, endpointA.ClientID) })
+
+			_, err := suite.transferV2(endpointA, classID, tokenID, "kitty2")
+			if tc.err == nil {
+				suite.Require().NoError(err)
+				suite.requireEscrowIndex(tokenID, "kitty2")
+				return
+			}
+
+			suite.Require().ErrorContains(err, tc.err.Error())
+			suite.requireOwner(tokenID, suite.chainA.SenderAccount.GetAddress().String())
+			suite.requireOwner("kitty2", suite.chainA.SenderAccount.GetAddress().String())
+			suite.requireEscrowIndex()
+		})
+	}
+}
+
+func (suite *TransferTestSuite) TestRecvRestrictions() {
+	testCases := []struct {
+		name   string
+		update func(params *types.Params, clientID, voucherClassID string)
+		err    error
+	}{
+		{
+			"receive disabled",
+			func(params *types.Params, _, _ string) { params.ReceiveEnabled = false },
+			types.ErrReceiveDisabled,
+		},
+		{
+			"client not allowed",
+			func(params *types.Params, _, _ string) { params.AllowedClients = []string{"07-tendermint-9"} },
+			types.ErrChannelDisabled,
+		},
+		{
+			"client denied",
+			func(params *types.Params, clientID, _ string) { params.DeniedClients = []string{clientID} },
+			types.ErrChannelDisabled,
+		},
+		{
+			"too many tokens",
+			func(params *types.Params, _, _ string) { params.MaxTokenIds = 1 },
+			types.ErrInvalidTokenID,
+		},
+		{
+			"token id too long",
+			func(params *types.Params, _, _ string) { params.MaxTokenIdLength = uint32(len(tokenID)) },
+			types.ErrInvalidTokenID,
+		},
+		{
+			"voucher class id too long",
+			func(params *types.Params, _, voucherClassID string) {
+				params.MaxClassIdLength = uint32(len(voucherClassID) - 1)
+			},
+			types.ErrInvalidClassID,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.mintNFT()
+			suite.mintNFTs("kitty2")
+
+			endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+			appB := testutil.ChainApp(suite.chainB)
+			voucherClassID := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID).IBCClassID()
+			suite.updateParams(suite.chainB, func(params *types.Params) { tc.update(params, endpointB.ClientID, voucherClassID) })
+
+			packet, err := suite.transferV2(endpointA, classID, tokenID, "kitty2")
+			suite.Require().NoError(err)
+
+			// the keeper rejects the packet data
+			var data types.NonFungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.Payloads[0].Value, &data))
+			ctx, _ := suite.chainB.GetContext().CacheContext()
+			err = appB.NFTTransferKeeper.OnRecvPacket(ctx, data, types.PortID, endpointA.ClientID, types.PortID, endpointB.ClientID)
+			suite.Require().ErrorIs(err, tc.err)
+
+			// chain B acknowledges the packet with an error and chain A refunds the sender
+			suite.Require().NoError(endpointB.MsgRecvPacket(packet))
+			errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+			suite.Require().Equal(
+				channeltypesv2.CommitAcknowledgement(errorAck),
+				appB.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), endpointB.ClientID, packet.Sequence),
+			)
+			suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+
+			suite.Require().NoError(endpointA.MsgAcknowledgePacket(packet, errorAck))
+			suite.requireOwner(tokenID, suite.chainA.SenderAccount.GetAddress().String())
+			suite.requireOwner("kitty2", suite.chainA.SenderAccount.GetAddress().String())
+			suite.requireEscrowIndex()
+		})
+	}
+}
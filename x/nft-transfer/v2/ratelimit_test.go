@@ -0,0 +1,183 @@
+package v2_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+)
+
+// mintNFTs mints more NFTs of the test class to the sender account of chain A
+func (suite *TransferTestSuite) mintNFTs(tokenIDs ...string) {
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	nftKeeper := testutil.ChainApp(suite.chainA).NFTKeeper
+
+	for _, tokenID := range tokenIDs {
+		suite.Require().NoError(nftKeeper.MintNFT(ctx, classID, tokenID, tokenID, tokenID+"_uri", "", sender, sender))
+	}
+	suite.coordinator.CommitBlock(suite.chainA)
+}
+
+// transferV2 sends NFTs of a class on the endpoint to the sender account of the
+// counterparty with a MsgTransferV2, and returns the sent packet
+func (suite *TransferTestSuite) transferV2(endpoint *ibctesting.Endpoint, classID string, tokenIDs ...string) (channeltypesv2.Packet, error) {
+	msg := types.NewMsgTransferV2(
+		endpoint.ClientID, classID, tokenIDs,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+		endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+		uint64(endpoint.Chain.GetContext().BlockTime().Add(time.Hour).Unix()), "",
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return channeltypesv2.Packet{}, err
+	}
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+
+	packets, err := ibctesting.ParseIBCV2Packets(channeltypesv2.EventTypeSendPacket, res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Len(packets, 1)
+	return packets[0], nil
+}
+
+// setRateLimit sets the quota of a class on a channel, or client, of a chain
+func (suite *TransferTestSuite) setRateLimit(chain *ibctesting.TestChain, channelID, classID string, quota types.RateLimitQuota) {
+	transferKeeper := testutil.ChainApp(chain).NFTTransferKeeper
+	_, err := transferKeeper.SetRateLimit(chain.GetContext(), &types.MsgSetRateLimit{
+		Authority: transferKeeper.GetAuthority(),
+		ChannelId: channelID,
+		ClassId:   classID,
+		Quota:     quota,
+	})
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(chain)
+}
+
+// rateLimitFlow returns the flow of the rate limit of a class on a channel, or client,
+// of a chain
+func (suite *TransferTestSuite) rateLimitFlow(chain *ibctesting.TestChain, channelID, classID string) types.RateLimitFlow {
+	rateLimit, found := testutil.ChainApp(chain).NFTTransferKeeper.GetRateLimit(chain.GetContext(), channelID, classID)
+	suite.Require().True(found)
+	return rateLimit.Flow
+}
+
+// requireOwner checks the owner of an NFT of the test class on chain A
+func (suite *TransferTestSuite) requireOwner(tokenID string, owner string) {
+	nft, err := testutil.ChainApp(suite.chainA).NFTKeeper.GetNFT(suite.chainA.GetContext(), classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner, nft.GetOwner().String())
+}
+
+func (suite *TransferTestSuite) TestRateLimitSendConsumesQuota() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2", "kitty3")
+
+	endpointA := suite.path.EndpointA
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+	suite.setRateLimit(suite.chainA, endpointA.ClientID, classID, types.RateLimitQuota{MaxSend: 2, Window: time.Hour})
+
+	_, err := suite.transferV2(endpointA, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.rateLimitFlow(suite.chainA, endpointA.ClientID, classID).Sent)
+
+	// a transfer exceeding the quota is rejected and leaves the NFTs to the sender
+	_, err = suite.transferV2(endpointA, classID, "kitty2", "kitty3")
+	suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+	suite.requireOwner("kitty2", sender)
+	suite.requireOwner("kitty3", sender)
+	suite.Require().Equal(uint64(1), suite.rateLimitFlow(suite.chainA, endpointA.ClientID, classID).Sent)
+
+	_, err = suite.transferV2(endpointA, classID, "kitty2")
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.rateLimitFlow(suite.chainA, endpointA.ClientID, classID).Sent)
+
+	_, err = suite.transferV2(endpointA, classID, "kitty3")
+	suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+}
+
+func (suite *TransferTestSuite) TestRateLimitSendTransfer() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2")
+
+	// SendTransfer counts the NFTs against the quota of the channel before sending
+	const channelID = "channel-0"
+	suite.setRateLimit(suite.chainA, channelID, classID, types.RateLimitQuota{MaxSend: 1, Window: time.Hour})
+
+	transferKeeper := testutil.ChainApp(suite.chainA).NFTTransferKeeper
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+
+	_, err := transferKeeper.SendTransfer(
+		ctx, types.PortID, channelID, classID, []string{tokenID, "kitty2"}, sender,
+		suite.chainB.SenderAccount.GetAddress().String(), clienttypes.ZeroHeight(), timeoutTimestamp, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+	suite.Require().Zero(suite.rateLimitFlow(suite.chainA, channelID, classID).Sent)
+	suite.requireOwner(tokenID, sender.String())
+}
+
+func (suite *TransferTestSuite) TestRateLimitSendWindowRollover() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2")
+
+	endpointA := suite.path.EndpointA
+	suite.setRateLimit(suite.chainA, endpointA.ClientID, classID, types.RateLimitQuota{MaxSend: 1, Window: time.Hour})
+	windowStart := suite.rateLimitFlow(suite.chainA, endpointA.ClientID, classID).WindowStart
+
+	_, err := suite.transferV2(endpointA, classID, tokenID)
+	suite.Require().NoError(err)
+	_, err = suite.transferV2(endpointA, classID, "kitty2")
+	suite.Require().ErrorContains(err, types.ErrRateLimitExceeded.Error())
+
+	// the flow is reset once the window has elapsed
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	_, err = suite.transferV2(endpointA, classID, "kitty2")
+	suite.Require().NoError(err)
+
+	flow := suite.rateLimitFlow(suite.chainA, endpointA.ClientID, classID)
+	suite.Require().Equal(uint64(1), flow.Sent)
+	suite.Require().False(flow.WindowStart.Before(windowStart.Add(time.Hour)))
+}
+
+func (suite *TransferTestSuite) TestRateLimitRecvErrorAckRefunds() {
+	suite.mintNFT()
+	suite.mintNFTs("kitty2")
+
+	endpointA, endpointB := suite.path.EndpointA, suite.path.EndpointB
+	sender := suite.chainA.SenderAccount.GetAddress().String()
+
+	// the receive quota applies to the voucher class on chain B
+	appB := testutil.ChainApp(suite.chainB)
+	voucherClassID := types.ParseClassTrace(types.GetClassPrefix(types.PortID, endpointB.ClientID) + classID).IBCClassID()
+	suite.setRateLimit(suite.chainB, endpointB.ClientID, voucherClassID, types.RateLimitQuota{MaxReceive: 1, Window: time.Hour})
+
+	packet, err := suite.transferV2(endpointA, classID, tokenID, "kitty2")
+	suite.Require().NoError(err)
+	suite.requireOwner(tokenID, types.GetEscrowAddress(types.PortID, endpointA.ClientID).String())
+
+	// chain B acknowledges the packet with an error and does not count the NFTs
+	suite.Require().NoError(endpointB.MsgRecvPacket(packet))
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+	suite.Require().Equal(
+		channeltypesv2.CommitAcknowledgement(errorAck),
+		appB.GetIBCKeeper().ChannelKeeperV2.GetPacketAcknowledgement(suite.chainB.GetContext(), endpointB.ClientID, packet.Sequence),
+	)
+	suite.Require().False(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+	suite.Require().Zero(suite.rateLimitFlow(suite.chainB, endpointB.ClientID, voucherClassID).Received)
+
+	// chain A refunds the sender
+	suite.Require().NoError(endpointA.MsgAcknowledgePacket(packet, errorAck))
+	suite.requireOwner(tokenID, sender)
+	suite.requireOwner("kitty2", sender)
+	suite.Require().False(testutil.ChainApp(suite.chainA).NFTTransferKeeper.HasEscrowedNFT(suite.chainA.GetContext(), types.PortID, endpointA.ClientID, classID, tokenID))
+
+	packet, err = suite.transferV2(endpointA, classID, tokenID)
+	suite.Require().NoError(err)
+	suite.relayPacket(endpointA, packet)
+	suite.Require().True(appB.NFTKeeper.HasNFT(suite.chainB.GetContext(), voucherClassID, tokenID))
+	suite.Require().Equal(uint64(1), suite.rateLimitFlow(suite.chainB, endpointB.ClientID, voucherClassID).Received)
+}
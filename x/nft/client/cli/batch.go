@@ -0,0 +1,120 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+)
+
+// csvHeaderDenomID is the first column of an optional CSV header row
+const csvHeaderDenomID = "denom_id"
+
+// readBatchRecords reads the rows of a batch file. Files with a `.csv` extension are parsed as
+// CSV with exactly `columns` fields per row, anything else is decoded as a JSON array into `out`.
+func readBatchRecords(path string, columns int, out interface{}) ([][]string, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if !strings.EqualFold(filepath.Ext(path), ".csv") {
+		if err := json.NewDecoder(f).Decode(out); err != nil {
+			return nil, fmt.Errorf("failed to decode batch file %s: %w", path, err)
+		}
+		return nil, nil
+	}
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = columns
+	reader.TrimLeadingSpace = true
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read batch file %s: %w", path, err)
+		}
+		if len(records) == 0 && strings.TrimSpace(record[0]) == csvHeaderDenomID {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// ParseBatchMintFile parses the NFTs to mint from a JSON or CSV file. CSV rows are
+// `denom_id,token_id,name,uri,data,recipient`; an empty recipient defaults to the sender.
+func ParseBatchMintFile(path, sender string) ([]types.MintNFTItem, error) {
+	var items []types.MintNFTItem
+	records, err := readBatchRecords(path, 6, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		items = append(items, types.MintNFTItem{
+			DenomId:   strings.TrimSpace(record[0]),
+			Id:        strings.TrimSpace(record[1]),
+			Name:      record[2],
+			URI:       strings.TrimSpace(record[3]),
+			Data:      record[4],
+			Recipient: strings.TrimSpace(record[5]),
+		})
+	}
+
+	for i := range items {
+		if len(strings.TrimSpace(items[i].Recipient)) == 0 {
+			items[i].Recipient = sender
+		}
+	}
+	return items, nil
+}
+
+// ParseBatchTransferFile parses the NFTs to transfer from a JSON or CSV file. CSV rows are
+// `denom_id,token_id,recipient`.
+func ParseBatchTransferFile(path string) ([]types.TransferNFTItem, error) {
+	var items []types.TransferNFTItem
+	records, err := readBatchRecords(path, 3, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		items = append(items, types.TransferNFTItem{
+			DenomId:   strings.TrimSpace(record[0]),
+			Id:        strings.TrimSpace(record[1]),
+			Recipient: strings.TrimSpace(record[2]),
+		})
+	}
+	return items, nil
+}
+
+// ParseBatchBurnFile parses the NFTs to burn from a JSON or CSV file. CSV rows are
+// `denom_id,token_id`.
+func ParseBatchBurnFile(path string) ([]types.BurnNFTItem, error) {
+	var items []types.BurnNFTItem
+	records, err := readBatchRecords(path, 2, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		items = append(items, types.BurnNFTItem{
+			DenomId: strings.TrimSpace(record[0]),
+			Id:      strings.TrimSpace(record[1]),
+		})
+	}
+	return items, nil
+}
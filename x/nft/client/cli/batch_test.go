@@ -0,0 +1,76 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	nftcli "github.com/crypto-org-chain/chain-main/v8/x/nft/client/cli"
+	nfttypes "github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBatchFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestParseBatchMintFile(t *testing.T) {
+	sender := "cro1sender"
+
+	path := writeBatchFile(t, "nfts.json", `[
+  {"denom_id": "denoma", "id": "tokena", "name": "a", "uri": "uri-a", "data": "data-a", "recipient": "cro1recipient"},
+  {"denom_id": "denomb", "id": "tokenb"}
+]`)
+	items, err := nftcli.ParseBatchMintFile(path, sender)
+	require.NoError(t, err)
+	require.Equal(t, []nfttypes.MintNFTItem{
+		{DenomId: "denoma", Id: "tokena", Name: "a", URI: "uri-a", Data: "data-a", Recipient: "cro1recipient"},
+		{DenomId: "denomb", Id: "tokenb", Recipient: sender},
+	}, items)
+
+	path = writeBatchFile(t, "nfts.csv", `denom_id,token_id,name,uri,data,recipient
+denoma,tokena,a,uri-a,data-a,cro1recipient
+denomb,tokenb,,,,
+`)
+	items, err = nftcli.ParseBatchMintFile(path, sender)
+	require.NoError(t, err)
+	require.Equal(t, []nfttypes.MintNFTItem{
+		{DenomId: "denoma", Id: "tokena", Name: "a", URI: "uri-a", Data: "data-a", Recipient: "cro1recipient"},
+		{DenomId: "denomb", Id: "tokenb", Recipient: sender},
+	}, items)
+
+	path = writeBatchFile(t, "invalid.csv", "denoma,tokena\n")
+	_, err = nftcli.ParseBatchMintFile(path, sender)
+	require.Error(t, err)
+}
+
+func TestParseBatchTransferFile(t *testing.T) {
+	path := writeBatchFile(t, "nfts.csv", "denoma,tokena,cro1a\ndenomb,tokenb,cro1b\n")
+	items, err := nftcli.ParseBatchTransferFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []nfttypes.TransferNFTItem{
+		{DenomId: "denoma", Id: "tokena", Recipient: "cro1a"},
+		{DenomId: "denomb", Id: "tokenb", Recipient: "cro1b"},
+	}, items)
+
+	path = writeBatchFile(t, "nfts.json", `[{"denom_id": "denoma", "id": "tokena", "recipient": "cro1a"}]`)
+	items, err = nftcli.ParseBatchTransferFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []nfttypes.TransferNFTItem{{DenomId: "denoma", Id: "tokena", Recipient: "cro1a"}}, items)
+}
+
+func TestParseBatchBurnFile(t *testing.T) {
+	path := writeBatchFile(t, "nfts.csv", "denom_id,token_id\ndenoma,tokena\n")
+	items, err := nftcli.ParseBatchBurnFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []nfttypes.BurnNFTItem{{DenomId: "denoma", Id: "tokena"}}, items)
+
+	path = writeBatchFile(t, "nfts.json", `{"denom_id": "denoma"}`)
+	_, err = nftcli.ParseBatchBurnFile(path)
+	require.Error(t, err)
+}
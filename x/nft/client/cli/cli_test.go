@@ -191,6 +191,17 @@ func (s *IntegrationTestSuite) TestNft() {
 	s.Require().Equal(denom, ownerResp.Owner.IDCollections[0].DenomId)
 	s.Require().Equal(tokenID, ownerResp.Owner.IDCollections[0].TokenIds[0])
 
+	//------test GetCmdQueryOwnerNFTs()-------------
+	respType = proto.Message(&nfttypes.QueryOwnerNFTsResponse{})
+	bz, err = nfttestutil.QueryOwnerNFTsExec(val.ClientCtx, from.String(), fmt.Sprintf("--%s=%s", nftcli.FlagTokenIDPrefix, "kit"))
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(bz.Bytes(), respType))
+	ownerNFTsResp := respType.(*nfttypes.QueryOwnerNFTsResponse)
+	s.Require().Len(ownerNFTsResp.NFTs, 1)
+	s.Require().Equal(denomID, ownerNFTsResp.NFTs[0].DenomId)
+	s.Require().Equal(tokenID, ownerNFTsResp.NFTs[0].NFT.Id)
+	s.Require().Equal(tokenName, ownerNFTsResp.NFTs[0].NFT.Name)
+
 	//------test GetCmdQueryCollection()-------------
 	respType = proto.Message(&nfttypes.QueryCollectionResponse{})
 	bz, err = nfttestutil.QueryCollectionExec(val.ClientCtx, denomID)
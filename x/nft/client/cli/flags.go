@@ -13,10 +13,14 @@ const (
 	FlagRecipient = "recipient"
 	FlagOwner     = "owner"
 
+	FlagTokenIDPrefix = "token-id-prefix"
+
 	FlagDenomName = "name"
 	FlagDenomID   = "denom-id"
 	FlagSchema    = "schema"
 	FlagDenomURI  = "uri"
+
+	FlagEnforceSchema = "enforce-schema"
 )
 
 var (
@@ -26,12 +30,15 @@ var (
 	FsTransferNFT = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQuerySupply = flag.NewFlagSet("", flag.ContinueOnError)
 	FsQueryOwner  = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsQueryOwnerNFTs = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsIssueDenom.String(FlagSchema, "", "Denom data structure definition")
 	FsIssueDenom.String(FlagDenomName, "", "The name of the denom")
 	FsIssueDenom.String(FlagDenomURI, "", "URI of the denom")
+	FsIssueDenom.Bool(FlagEnforceSchema, false, "Reject mints and edits whose data does not match the schema")
 
 	FsMintNFT.String(FlagTokenURI, "", "URI for supplemental off-chain tokenData (should return a JSON object)")
 	FsMintNFT.String(FlagRecipient, "", "Receiver of the nft, if not filled, the default is the sender of the transaction")
@@ -45,4 +52,7 @@ func init() {
 	FsQuerySupply.String(FlagOwner, "", "The owner of the nft")
 
 	FsQueryOwner.String(FlagDenomID, "", "The name of the collection")
+
+	FsQueryOwnerNFTs.String(FlagDenomID, "", "Only return NFTs of the given collection")
+	FsQueryOwnerNFTs.String(FlagTokenIDPrefix, "", "Only return NFTs whose token id starts with the given prefix")
 }
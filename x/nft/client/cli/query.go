@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryCollection(),
 		GetCmdQuerySupply(),
 		GetCmdQueryOwner(),
+		GetCmdQueryOwnerNFTs(),
 		GetCmdQueryNFT(),
 	)
 
@@ -125,6 +126,58 @@ func GetCmdQueryOwner() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryOwnerNFTs queries the full NFTs owned by an account across all denoms
+func GetCmdQueryOwnerNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner-nfts [address]",
+		Long:    "Get the NFTs owned by an account address across all denoms, optionally filtered by denom and token id prefix.",
+		Example: fmt.Sprintf("$ %s query nft owner-nfts <address> --denom-id=<denom-id> --token-id-prefix=<prefix>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			denomID, err := cmd.Flags().GetString(FlagDenomID)
+			if err != nil {
+				return err
+			}
+			if len(denomID) > 0 {
+				if err := types.ValidateDenomIDWithIBC(denomID); err != nil {
+					return err
+				}
+			}
+			tokenIDPrefix, err := cmd.Flags().GetString(FlagTokenIDPrefix)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.OwnerNFTs(context.Background(), &types.QueryOwnerNFTsRequest{
+				Owner:         args[0],
+				DenomId:       denomID,
+				TokenIdPrefix: tokenIDPrefix,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryOwnerNFTs)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owner nfts")
+
+	return cmd
+}
+
 // GetCmdQueryCollection queries all the NFTs from a collection
 func GetCmdQueryCollection() *cobra.Command {
 	cmd := &cobra.Command{
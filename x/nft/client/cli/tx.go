@@ -34,6 +34,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdEditNFT(),
 		GetCmdTransferNFT(),
 		GetCmdBurnNFT(),
+		GetCmdBatchMintNFT(),
+		GetCmdBatchTransferNFT(),
+		GetCmdBatchBurnNFT(),
 	)
 
 	return txCmd
@@ -49,6 +52,7 @@ func GetCmdIssueDenom() *cobra.Command {
   --name=<denom-name>
   --schema=<schema-content or path to schema.json>
   --uri=<uri of denom>
+  --enforce-schema
   --chain-id=<chain-id>
   --fees=<fee>`, version.AppName),
 		Args: cobra.ExactArgs(1),
@@ -70,6 +74,10 @@ func GetCmdIssueDenom() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			enforceSchema, err := cmd.Flags().GetBool(FlagEnforceSchema)
+			if err != nil {
+				return err
+			}
 			optionsContent, err := os.ReadFile(filepath.Clean(schema))
 			if err == nil {
 				schema = string(optionsContent)
@@ -82,6 +90,7 @@ func GetCmdIssueDenom() *cobra.Command {
 				uri,
 				clientCtx.GetFromAddress().String(),
 			)
+			msg.EnforceSchema = enforceSchema
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -281,3 +290,129 @@ func GetCmdBurnNFT() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdBatchMintNFT is the CLI command for a BatchMintNFT transaction
+func GetCmdBatchMintNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "batch-mint [file]",
+		Long: "Mint multiple NFTs, possibly across denoms, from a JSON or CSV file.",
+		Example: fmt.Sprintf(`$ %s tx nft batch-mint <path/to/nfts.json>
+  --from=<key-name>
+  --chain-id=<chain-id>
+  --fees=<fee>
+
+Where nfts.json contains:
+
+[{"denom_id": "<denom-id>", "id": "<token-id>", "name": "<name>", "uri": "<uri>", "data": "<data>", "recipient": "<recipient>"}]
+
+Or nfts.csv contains rows of:
+
+denom_id,token_id,name,uri,data,recipient
+
+An empty recipient defaults to the sender of the transaction.`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			items, err := ParseBatchMintFile(args[0], sender)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchMintNFT(sender, items)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBatchTransferNFT is the CLI command for a BatchTransferNFT transaction
+func GetCmdBatchTransferNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "batch-transfer [file]",
+		Long: "Transfer multiple NFTs, possibly across denoms, from a JSON or CSV file.",
+		Example: fmt.Sprintf(`$ %s tx nft batch-transfer <path/to/nfts.json>
+  --from=<key-name>
+  --chain-id=<chain-id>
+  --fees=<fee>
+
+Where nfts.json contains:
+
+[{"denom_id": "<denom-id>", "id": "<token-id>", "recipient": "<recipient>"}]
+
+Or nfts.csv contains rows of:
+
+denom_id,token_id,recipient`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := ParseBatchTransferFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchTransferNFT(clientCtx.GetFromAddress().String(), items)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBatchBurnNFT is the CLI command for a BatchBurnNFT transaction
+func GetCmdBatchBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "batch-burn [file]",
+		Long: "Burn multiple NFTs, possibly across denoms, from a JSON or CSV file.",
+		Example: fmt.Sprintf(`$ %s tx nft batch-burn <path/to/nfts.json>
+  --from=<key-name>
+  --chain-id=<chain-id>
+  --fees=<fee>
+
+Where nfts.json contains:
+
+[{"denom_id": "<denom-id>", "id": "<token-id>"}]
+
+Or nfts.csv contains rows of:
+
+denom_id,token_id`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := ParseBatchBurnFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBatchBurnNFT(clientCtx.GetFromAddress().String(), items)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -171,6 +171,16 @@ func QueryOwnerExec(clientCtx client.Context, address string, extraArgs ...strin
 	return clitestutil.ExecTestCLICmd(clientCtx, nftcli.GetCmdQueryOwner(), args)
 }
 
+func QueryOwnerNFTsExec(clientCtx client.Context, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, nftcli.GetCmdQueryOwnerNFTs(), args)
+}
+
 func QueryNFTExec(clientCtx client.Context, denomID, tokenID string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		denomID,
@@ -3,14 +3,15 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/exported"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
-	"cosmossdk.io/store/prefix"
-	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -72,15 +73,15 @@ func (k Keeper) GetPaginateCollection(ctx sdk.Context, request *types.QueryColle
 	if err != nil {
 		return types.Collection{}, nil, sdkerrors.Wrapf(types.ErrInvalidDenom, "denomID %s not existed ", denomID)
 	}
-	var nfts []exported.NFT
-	store := ctx.KVStore(k.storeKey)
-	nftStore := prefix.NewStore(store, types.KeyNFT(denomID, ""))
-	pageRes, err := query.Paginate(nftStore, request.Pagination, func(key, value []byte) error {
-		var baseNFT types.BaseNFT
-		k.cdc.MustUnmarshal(value, &baseNFT)
-		nfts = append(nfts, baseNFT)
-		return nil
-	})
+	nfts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.NFTs,
+		request.Pagination,
+		func(_ collections.Pair[string, string], baseNFT types.BaseNFT) (exported.NFT, error) {
+			return baseNFT, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](denomID),
+	)
 	if err != nil {
 		return types.Collection{}, nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
@@ -99,44 +100,42 @@ func (k Keeper) GetCollections(ctx sdk.Context) (cs []types.Collection) {
 
 // GetTotalSupply returns the number of NFTs by the specified denom ID
 func (k Keeper) GetTotalSupply(ctx sdk.Context, denomID string) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyCollection(denomID))
-	if len(bz) == 0 {
+	supply, err := k.NFTSupply.Get(ctx, denomID)
+	if errors.Is(err, collections.ErrNotFound) {
 		return 0
 	}
-	return types.MustUnMarshalSupply(k.cdc, bz)
+	if err != nil {
+		panic(err)
+	}
+	return supply
 }
 
 // GetTotalSupplyOfOwner returns the amount of NFTs by the specified conditions
 func (k Keeper) GetTotalSupplyOfOwner(ctx sdk.Context, id string, owner sdk.AccAddress) (supply uint64) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.KeyOwner(owner, id, ""))
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
+	err := k.NFTs.Indexes.ByOwner.Walk(ctx, ownerRange(owner, id), func(_ collections.Triple[sdk.AccAddress, string, string]) (bool, error) {
 		supply++
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 	return supply
 }
 
-func (k Keeper) increaseSupply(ctx sdk.Context, denomID string) {
+func (k Keeper) increaseSupply(ctx sdk.Context, denomID string) error {
 	supply := k.GetTotalSupply(ctx, denomID)
 	supply++
 
-	store := ctx.KVStore(k.storeKey)
-	bz := types.MustMarshalSupply(k.cdc, supply)
-	store.Set(types.KeyCollection(denomID), bz)
+	return k.NFTSupply.Set(ctx, denomID, supply)
 }
 
-func (k Keeper) decreaseSupply(ctx sdk.Context, denomID string) {
+func (k Keeper) decreaseSupply(ctx sdk.Context, denomID string) error {
 	supply := k.GetTotalSupply(ctx, denomID)
 	supply--
 
-	store := ctx.KVStore(k.storeKey)
 	if supply == 0 {
-		store.Delete(types.KeyCollection(denomID))
-		return
+		return k.NFTSupply.Remove(ctx, denomID)
 	}
 
-	bz := types.MustMarshalSupply(k.cdc, supply)
-	store.Set(types.KeyCollection(denomID), bz)
+	return k.NFTSupply.Set(ctx, denomID, supply)
 }
@@ -3,24 +3,26 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
-	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // HasDenomID returns whether the specified denom ID exists
 func (k Keeper) HasDenomID(ctx sdk.Context, id string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.KeyDenomID(id))
+	has, err := k.NFTDenoms.Has(ctx, id)
+	return err == nil && has
 }
 
 // HasDenomNm returns whether the specified denom name exists
 func (k Keeper) HasDenomNm(ctx sdk.Context, name string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.KeyDenomName(name))
+	_, err := k.NFTDenoms.Indexes.ByName.MatchExact(ctx, name)
+	return err == nil
 }
 
 // SetDenom is responsible for saving the definition of denom
@@ -33,46 +35,37 @@ func (k Keeper) SetDenom(ctx sdk.Context, denom types.Denom) error {
 		return sdkerrors.Wrapf(types.ErrInvalidDenom, "denomName %s has already exists", denom.Name)
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&denom)
-	store.Set(types.KeyDenomID(denom.Id), bz)
-	store.Set(types.KeyDenomName(denom.Name), []byte(denom.Id))
-	return nil
+	return k.NFTDenoms.Set(ctx, denom.Id, denom)
 }
 
 // GetDenom returns the denom by id
 func (k Keeper) GetDenom(ctx sdk.Context, id string) (denom types.Denom, err error) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(types.KeyDenomID(id))
-	if len(bz) == 0 {
+	denom, err = k.NFTDenoms.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
 		return denom, sdkerrors.Wrapf(types.ErrInvalidDenom, "not found denomID: %s", id)
 	}
-
-	k.cdc.MustUnmarshal(bz, &denom)
-	return denom, nil
+	return denom, err
 }
 
 // GetDenomByName returns the denom by name
 func (k Keeper) GetDenomByName(ctx sdk.Context, name string) (denom types.Denom, err error) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(types.KeyDenomName(name))
-	denomID := string(bz)
+	denomID, err := k.NFTDenoms.Indexes.ByName.MatchExact(ctx, name)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return denom, err
+	}
 
 	return k.GetDenom(ctx, denomID)
 }
 
 // GetDenoms returns all the denoms
 func (k Keeper) GetDenoms(ctx sdk.Context) (denoms []types.Denom) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDenomID(""))
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var denom types.Denom
-		k.cdc.MustUnmarshal(iterator.Value(), &denom)
-		denoms = append(denoms, denom)
+	iterator, err := k.NFTDenoms.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	denoms, err = iterator.Values()
+	if err != nil {
+		panic(err)
 	}
 	return denoms
 }
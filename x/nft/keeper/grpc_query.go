@@ -4,13 +4,14 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
-	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -18,6 +19,18 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// withOwnerPaginationPrefix restricts a pagination over the owner index to the NFTs of owner,
+// and to a denom and token ID prefix when a denom is given
+func withOwnerPaginationPrefix(owner sdk.AccAddress, denomID, tokenIDPrefix string) func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, string, string]]) {
+	return func(o *query.CollectionsPaginateOptions[collections.Triple[sdk.AccAddress, string, string]]) {
+		prefix := collections.TriplePrefix[sdk.AccAddress, string, string](owner)
+		if len(denomID) > 0 {
+			prefix = collections.Join3(owner, denomID, tokenIDPrefix)
+		}
+		o.Prefix = &prefix
+	}
+}
+
 func (k Keeper) Supply(c context.Context, request *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -48,28 +61,25 @@ func (k Keeper) Owner(c context.Context, request *types.QueryOwnerRequest) (*typ
 		IDCollections: types.IDCollections{},
 	}
 	idsMap := make(map[string][]string)
-	store := ctx.KVStore(k.storeKey)
-	nftStore := prefix.NewStore(store, types.KeyOwner(ownerAddress, request.DenomId, ""))
-	pageRes, err := query.Paginate(nftStore, request.Pagination, func(key, value []byte) error {
-		denomID := request.DenomId
-		tokenID := string(key)
-		if len(request.DenomId) == 0 {
-			denomID, tokenID, err = types.SplitKeyDenom(key)
-			if err != nil {
-				return err
+	_, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.NFTs.Indexes.ByOwner,
+		request.Pagination,
+		func(key collections.Triple[sdk.AccAddress, string, string], _ collections.NoValue) (struct{}, error) {
+			denomID, tokenID := key.K2(), key.K3()
+			if ids, ok := idsMap[denomID]; ok {
+				idsMap[denomID] = append(ids, tokenID)
+			} else {
+				idsMap[denomID] = []string{tokenID}
+				owner.IDCollections = append(
+					owner.IDCollections,
+					types.IDCollection{DenomId: denomID},
+				)
 			}
-		}
-		if ids, ok := idsMap[denomID]; ok {
-			idsMap[denomID] = append(ids, tokenID)
-		} else {
-			idsMap[denomID] = []string{tokenID}
-			owner.IDCollections = append(
-				owner.IDCollections,
-				types.IDCollection{DenomId: denomID},
-			)
-		}
-		return nil
-	})
+			return struct{}{}, nil
+		},
+		withOwnerPaginationPrefix(ownerAddress, request.DenomId, ""),
+	)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
@@ -80,6 +90,43 @@ func (k Keeper) Owner(c context.Context, request *types.QueryOwnerRequest) (*typ
 	return &types.QueryOwnerResponse{Owner: &owner, Pagination: pageRes}, nil
 }
 
+func (k Keeper) OwnerNFTs(c context.Context, request *types.QueryOwnerNFTsRequest) (*types.QueryOwnerNFTsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ownerAddress, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", request.Owner)
+	}
+
+	// with a denom the token ID prefix becomes part of the key prefix, otherwise it is filtered per key
+	var predicate func(collections.Triple[sdk.AccAddress, string, string], collections.NoValue) (bool, error)
+	if len(request.DenomId) == 0 && len(request.TokenIdPrefix) > 0 {
+		predicate = func(key collections.Triple[sdk.AccAddress, string, string], _ collections.NoValue) (bool, error) {
+			return strings.HasPrefix(key.K3(), request.TokenIdPrefix), nil
+		}
+	}
+
+	nfts, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.NFTs.Indexes.ByOwner,
+		request.Pagination,
+		predicate,
+		func(key collections.Triple[sdk.AccAddress, string, string], _ collections.NoValue) (types.OwnedNFT, error) {
+			nft, err := k.NFTs.Get(ctx, collections.Join(key.K2(), key.K3()))
+			if err != nil {
+				return types.OwnedNFT{}, err
+			}
+			return types.OwnedNFT{DenomId: key.K2(), NFT: nft}, nil
+		},
+		withOwnerPaginationPrefix(ownerAddress, request.DenomId, request.TokenIdPrefix),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryOwnerNFTsResponse{NFTs: nfts, Pagination: pageRes}, nil
+}
+
 func (k Keeper) Collection(c context.Context, request *types.QueryCollectionRequest) (*types.QueryCollectionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -115,15 +162,14 @@ func (k Keeper) DenomByName(c context.Context, request *types.QueryDenomByNameRe
 func (k Keeper) Denoms(c context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var denoms []types.Denom
-	store := ctx.KVStore(k.storeKey)
-	denomStore := prefix.NewStore(store, types.KeyDenomID(""))
-	pageRes, err := query.Paginate(denomStore, req.Pagination, func(key, value []byte) error {
-		var denom types.Denom
-		k.cdc.MustUnmarshal(value, &denom)
-		denoms = append(denoms, denom)
-		return nil
-	})
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.NFTDenoms,
+		req.Pagination,
+		func(_ string, denom types.Denom) (types.Denom, error) {
+			return denom, nil
+		},
+	)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
 	}
@@ -6,6 +6,8 @@ import (
 	gocontext "context"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (suite *KeeperSuite) TestSupply() {
@@ -35,6 +37,79 @@ func (suite *KeeperSuite) TestOwner() {
 	suite.Contains(response.Owner.IDCollections[0].TokenIds, tokenID)
 }
 
+func (suite *KeeperSuite) TestOwnerNFTs() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI2, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, "othertoken", tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID, tokenNm, tokenURI, tokenData, address, address2)
+	suite.NoError(err)
+
+	// all denoms
+	response, err := suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner: address.String(),
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 4)
+
+	// filtered by denom
+	response, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner:   address.String(),
+		DenomId: denomID2,
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 2)
+	for _, nft := range response.NFTs {
+		suite.Equal(denomID2, nft.DenomId)
+	}
+
+	// filtered by token id prefix across denoms
+	response, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner:         address.String(),
+		TokenIdPrefix: "token",
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 3)
+
+	// filtered by denom and token id prefix
+	response, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner:         address.String(),
+		DenomId:       denomID2,
+		TokenIdPrefix: "token",
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 1)
+	suite.Equal(tokenID3, response.NFTs[0].NFT.Id)
+	suite.Equal(tokenNm3, response.NFTs[0].NFT.Name)
+	suite.Equal(tokenURI2, response.NFTs[0].NFT.URI)
+	suite.Equal(address.String(), response.NFTs[0].NFT.Owner)
+
+	// paginated
+	response, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner:      address.String(),
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 3)
+	suite.Equal(uint64(4), response.Pagination.Total)
+
+	response, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner:      address.String(),
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey},
+	})
+	suite.NoError(err)
+	suite.Len(response.NFTs, 1)
+
+	_, err = suite.queryClient.OwnerNFTs(gocontext.Background(), &types.QueryOwnerNFTsRequest{
+		Owner: "invalid",
+	})
+	suite.Error(err)
+}
+
 func (suite *KeeperSuite) TestCollection() {
 	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
 	suite.NoError(err)
@@ -0,0 +1,116 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomsIndexes defines secondary indexes on Denoms.
+// ByName is a denom_name -> denom_id unique index.
+type DenomsIndexes struct {
+	ByName *indexes.Unique[string, string, types.Denom]
+}
+
+func (i DenomsIndexes) IndexesList() []collections.Index[string, types.Denom] {
+	return []collections.Index[string, types.Denom]{i.ByName}
+}
+
+func newDenomsIndexes(sb *collections.SchemaBuilder) DenomsIndexes {
+	return DenomsIndexes{
+		ByName: indexes.NewUnique(
+			sb,
+			types.DenomsByNameKey,
+			"denoms_by_name",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, denom types.Denom) (string, error) {
+				return denom.Name, nil
+			},
+		),
+	}
+}
+
+// NFTsIndexes defines secondary indexes on NFTs.
+// ByOwner is a (owner, denom_id, token_id) index.
+type NFTsIndexes struct {
+	ByOwner *OwnerIndex
+}
+
+func (i NFTsIndexes) IndexesList() []collections.Index[collections.Pair[string, string], types.BaseNFT] {
+	return []collections.Index[collections.Pair[string, string], types.BaseNFT]{i.ByOwner}
+}
+
+func newNFTsIndexes(sb *collections.SchemaBuilder) NFTsIndexes {
+	return NFTsIndexes{
+		ByOwner: &OwnerIndex{
+			KeySet: collections.NewKeySet(
+				sb,
+				types.NFTsByOwnerKey,
+				"nfts_by_owner",
+				collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.StringKey),
+				collections.WithKeySetSecondaryIndex(),
+			),
+		},
+	}
+}
+
+// OwnerIndex indexes NFTs by owner. Unlike indexes.Multi it flattens the primary key
+// into a triple and exposes the key set, so that the tokens of an owner can be
+// paginated by owner or (owner, denom ID) prefix.
+type OwnerIndex struct {
+	collections.KeySet[collections.Triple[sdk.AccAddress, string, string]]
+}
+
+// Reference implements collections.Index.
+func (i *OwnerIndex) Reference(
+	ctx context.Context,
+	pk collections.Pair[string, string],
+	newValue types.BaseNFT,
+	lazyOldValue func() (types.BaseNFT, error),
+) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if oldValue.Owner == newValue.Owner {
+			return nil
+		}
+		if err := i.unreference(ctx, pk, oldValue); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(newValue.Owner)
+	if err != nil {
+		return err
+	}
+	return i.Set(ctx, collections.Join3(owner, pk.K1(), pk.K2()))
+}
+
+// Unreference implements collections.Index.
+func (i *OwnerIndex) Unreference(ctx context.Context, pk collections.Pair[string, string], getValue func() (types.BaseNFT, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	return i.unreference(ctx, pk, value)
+}
+
+func (i *OwnerIndex) unreference(ctx context.Context, pk collections.Pair[string, string], value types.BaseNFT) error {
+	owner, err := sdk.AccAddressFromBech32(value.Owner)
+	if err != nil {
+		return err
+	}
+	return i.Remove(ctx, collections.Join3(owner, pk.K1(), pk.K2()))
+}
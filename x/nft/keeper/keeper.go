@@ -7,9 +7,10 @@ import (
 
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/core/store"
 	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,16 +18,37 @@ import (
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	storeKey storetypes.StoreKey // Unexposed key to access store from sdk.Context
-	cdc      codec.Codec
+	storeService storetypes.KVStoreService
+	cdc          codec.Codec
+
+	Schema collections.Schema
+
+	// NFTDenoms maps a denom ID to its definition, indexed by the unique denom name.
+	NFTDenoms *collections.IndexedMap[string, types.Denom, DenomsIndexes]
+	// NFTs maps (denom ID, token ID) to the token, indexed by owner.
+	NFTs *collections.IndexedMap[collections.Pair[string, string], types.BaseNFT, NFTsIndexes]
+	// NFTSupply holds the number of NFTs of each denom.
+	NFTSupply collections.Map[string, uint64]
 }
 
 // NewKeeper creates a new instance of the NFT Keeper
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) Keeper {
-	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
+func NewKeeper(cdc codec.Codec, storeService storetypes.KVStoreService) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		NFTDenoms:    collections.NewIndexedMap(sb, types.DenomsKey, "denoms", collections.StringKey, codec.CollValue[types.Denom](cdc), newDenomsIndexes(sb)),
+		NFTs:         collections.NewIndexedMap(sb, types.NFTsKey, "nfts", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.BaseNFT](cdc), newNFTsIndexes(sb)),
+		NFTSupply:    collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -39,7 +61,35 @@ func (k Keeper) IssueDenom(ctx sdk.Context,
 	id, name, schema, uri string,
 	creator sdk.AccAddress,
 ) error {
-	return k.SetDenom(ctx, types.NewDenom(id, name, schema, uri, creator))
+	denom := types.NewDenom(id, name, schema, uri, creator)
+	if err := k.SetDenom(ctx, denom); err != nil {
+		return err
+	}
+	return emitIssueDenomEvent(ctx, denom)
+}
+
+// emitIssueDenomEvent emits the typed event of an issued denom
+func emitIssueDenomEvent(ctx sdk.Context, denom types.Denom) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventIssueDenom{
+		DenomId:   denom.Id,
+		DenomName: denom.Name,
+		Creator:   denom.Creator,
+		UriHash:   types.URIHash(denom.Uri),
+	})
+}
+
+// validateNFTData checks the NFT data against the denom schema if the denom enforces it
+func (k Keeper) validateNFTData(ctx sdk.Context, denom types.Denom, tokenData string) error {
+	if !denom.EnforceSchema {
+		return nil
+	}
+
+	ctx.GasMeter().ConsumeGas(types.GasPerSchemaByte*uint64(len(denom.Schema)), "nft schema decoding")
+	schema, err := types.ParseSchema(denom.Schema)
+	if err != nil {
+		return err
+	}
+	return schema.ValidateData(ctx.GasMeter(), tokenData)
 }
 
 // MintNFTUnverified mints an NFT without verifying if the owner is the creator of denom
@@ -53,7 +103,7 @@ func (k Keeper) MintNFTUnverified(ctx sdk.Context, denomID, tokenID, tokenNm, to
 		return sdkerrors.Wrapf(types.ErrNFTAlreadyExists, "NFT %s already exists in collection %s", tokenID, denomID)
 	}
 
-	k.setNFT(
+	if err := k.setNFT(
 		ctx, denomID,
 		types.NewBaseNFT(
 			tokenID,
@@ -62,11 +112,11 @@ func (k Keeper) MintNFTUnverified(ctx sdk.Context, denomID, tokenID, tokenNm, to
 			tokenURI,
 			tokenData,
 		),
-	)
-	k.setOwner(ctx, denomID, tokenID, owner)
-	k.increaseSupply(ctx, denomID)
+	); err != nil {
+		return err
+	}
 
-	return nil
+	return k.increaseSupply(ctx, denomID)
 }
 
 // MintNFT mints an NFT and manages the NFT's existence within Collections and Owners
@@ -74,12 +124,26 @@ func (k Keeper) MintNFT(
 	ctx sdk.Context, denomID, tokenID, tokenNm,
 	tokenURI, tokenData string, sender, owner sdk.AccAddress,
 ) error {
-	_, err := k.IsDenomCreator(ctx, denomID, sender)
+	denom, err := k.IsDenomCreator(ctx, denomID, sender)
 	if err != nil {
 		return err
 	}
 
-	return k.MintNFTUnverified(ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, owner)
+	if err := k.validateNFTData(ctx, denom, tokenData); err != nil {
+		return err
+	}
+
+	if err := k.MintNFTUnverified(ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, owner); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMintNFT{
+		DenomId:   denomID,
+		TokenId:   tokenID,
+		TokenUri:  tokenURI,
+		Recipient: owner.String(),
+		UriHash:   types.URIHash(tokenURI),
+	})
 }
 
 // EditNFT updates an already existing NFT
@@ -96,7 +160,7 @@ func (k Keeper) EditNFT(
 		return err
 	}
 
-	_, err = k.IsDenomCreator(ctx, denomID, owner)
+	denom, err := k.IsDenomCreator(ctx, denomID, owner)
 	if err != nil {
 		return err
 	}
@@ -110,12 +174,23 @@ func (k Keeper) EditNFT(
 	}
 
 	if types.Modified(tokenData) {
+		if err := k.validateNFTData(ctx, denom, tokenData); err != nil {
+			return err
+		}
 		nft.Data = tokenData
 	}
 
-	k.setNFT(ctx, denomID, nft)
+	if err := k.setNFT(ctx, denomID, nft); err != nil {
+		return err
+	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventEditNFT{
+		DenomId:  denomID,
+		TokenId:  tokenID,
+		TokenUri: nft.URI,
+		Owner:    nft.Owner,
+		UriHash:  types.URIHash(nft.URI),
+	})
 }
 
 // TransferOwner transfers the ownership of the given NFT to the new owner
@@ -133,9 +208,18 @@ func (k Keeper) TransferOwner(
 
 	nft.Owner = dstOwner.String()
 
-	k.setNFT(ctx, denomID, nft)
-	k.swapOwner(ctx, denomID, tokenID, srcOwner, dstOwner)
-	return nil
+	// the owner index is updated by the NFTs indexed map
+	if err := k.setNFT(ctx, denomID, nft); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventTransferNFT{
+		DenomId:   denomID,
+		TokenId:   tokenID,
+		Sender:    srcOwner.String(),
+		Recipient: nft.Owner,
+		UriHash:   types.URIHash(nft.URI),
+	})
 }
 
 // BurnNFT deletes a specified NFT
@@ -154,11 +238,7 @@ func (k Keeper) BurnNFT(ctx sdk.Context, denomID, tokenID string, owner sdk.AccA
 		return err
 	}
 
-	k.deleteNFT(ctx, denomID, nft)
-	k.deleteOwner(ctx, denomID, tokenID, owner)
-	k.decreaseSupply(ctx, denomID)
-
-	return nil
+	return k.burnNFT(ctx, denomID, nft)
 }
 
 // BurnNFTUnverified deletes a specified NFT without verifying if the owner is the creator of denom
@@ -173,9 +253,23 @@ func (k Keeper) BurnNFTUnverified(ctx sdk.Context, denomID, tokenID string, owne
 		return err
 	}
 
-	k.deleteNFT(ctx, denomID, nft)
-	k.deleteOwner(ctx, denomID, tokenID, owner)
-	k.decreaseSupply(ctx, denomID)
+	return k.burnNFT(ctx, denomID, nft)
+}
+
+// burnNFT deletes an NFT, decreases the supply of its denom and emits the typed event
+func (k Keeper) burnNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) error {
+	if err := k.deleteNFT(ctx, denomID, nft); err != nil {
+		return err
+	}
+
+	if err := k.decreaseSupply(ctx, denomID); err != nil {
+		return err
+	}
 
-	return nil
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurnNFT{
+		DenomId: denomID,
+		TokenId: nft.Id,
+		Owner:   nft.Owner,
+		UriHash: types.URIHash(nft.URI),
+	})
 }
@@ -193,6 +193,36 @@ func (suite *KeeperSuite) TestBurnNFT() {
 	suite.False(isNFT)
 }
 
+func (suite *KeeperSuite) TestEnforceSchema() {
+	denom := types.NewDenom("schemadenom", "schemadenom", `{"type": "object", "required": ["level"], "properties": {"level": {"type": "integer"}}}`, "", address)
+	denom.EnforceSchema = true
+	suite.NoError(suite.keeper.SetDenom(suite.ctx, denom))
+
+	// MintNFT should fail when data does not match the schema
+	err := suite.keeper.MintNFT(suite.ctx, denom.Id, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.ErrorIs(err, types.ErrInvalidTokenData)
+	suite.False(suite.keeper.HasNFT(suite.ctx, denom.Id, tokenID))
+
+	// MintNFT shouldn't fail when data matches the schema
+	err = suite.keeper.MintNFT(suite.ctx, denom.Id, tokenID, tokenNm, tokenURI, `{"level": 1}`, address, address)
+	suite.NoError(err)
+
+	// EditNFT should fail when data does not match the schema
+	err = suite.keeper.EditNFT(suite.ctx, denom.Id, tokenID, tokenNm, tokenURI, `{"level": "1"}`, address)
+	suite.ErrorIs(err, types.ErrInvalidTokenData)
+
+	// EditNFT shouldn't validate data it does not modify
+	err = suite.keeper.EditNFT(suite.ctx, denom.Id, tokenID, tokenNm2, tokenURI, types.DoNotModify, address)
+	suite.NoError(err)
+
+	err = suite.keeper.EditNFT(suite.ctx, denom.Id, tokenID, tokenNm, tokenURI, `{"level": 2}`, address)
+	suite.NoError(err)
+
+	// denoms not enforcing their schema accept any data
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+}
+
 // CreateTestAddrs creates test addresses
 func CreateTestAddrs(numAddrs int) []sdk.AccAddress {
 	var addresses []sdk.AccAddress //nolint: prealloc
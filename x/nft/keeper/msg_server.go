@@ -30,7 +30,12 @@ func (m msgServer) IssueDenom(goCtx context.Context, msg *types.MsgIssueDenom) (
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := m.Keeper.IssueDenom(ctx, msg.Id, msg.Name, msg.Schema, msg.Uri, sender); err != nil {
+	denom := types.NewDenom(msg.Id, msg.Name, msg.Schema, msg.Uri, sender)
+	denom.EnforceSchema = msg.EnforceSchema
+	if err := m.Keeper.SetDenom(ctx, denom); err != nil {
+		return nil, err
+	}
+	if err := emitIssueDenomEvent(ctx, denom); err != nil {
 		return nil, err
 	}
 
@@ -191,3 +196,94 @@ func (m msgServer) BurnNFT(goCtx context.Context, msg *types.MsgBurnNFT) (*types
 
 	return &types.MsgBurnNFTResponse{}, nil
 }
+
+func (m msgServer) BatchMintNFT(goCtx context.Context, msg *types.MsgBatchMintNFT) (*types.MsgBatchMintNFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, item := range msg.Items {
+		recipient, err := sdk.AccAddressFromBech32(item.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.Keeper.MintNFT(ctx, item.DenomId, item.Id,
+			item.Name,
+			item.URI,
+			item.Data,
+			sender,
+			recipient,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgBatchMintNFTResponse{}, nil
+}
+
+func (m msgServer) BatchTransferNFT(goCtx context.Context, msg *types.MsgBatchTransferNFT) (*types.MsgBatchTransferNFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, item := range msg.Items {
+		recipient, err := sdk.AccAddressFromBech32(item.Recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := m.TransferOwner(ctx, item.DenomId, item.Id,
+			sender,
+			recipient,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgBatchTransferNFTResponse{}, nil
+}
+
+func (m msgServer) BatchBurnNFT(goCtx context.Context, msg *types.MsgBatchBurnNFT) (*types.MsgBatchBurnNFTResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, item := range msg.Items {
+		if err := m.Keeper.BurnNFT(ctx, item.DenomId, item.Id, sender); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	)
+
+	return &types.MsgBatchBurnNFTResponse{}, nil
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperSuite) countEvents(eventType string) int {
+	count := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func (suite *KeeperSuite) TestBatchMintNFT() {
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	_, err := msgServer.BatchMintNFT(suite.ctx, types.NewMsgBatchMintNFT(address.String(), []types.MintNFTItem{
+		{Id: tokenID, DenomId: denomID, Name: tokenNm, URI: tokenURI, Data: tokenData, Recipient: address.String()},
+		{Id: tokenID2, DenomId: denomID, Name: tokenNm2, URI: tokenURI, Data: tokenData, Recipient: address2.String()},
+		{Id: tokenID, DenomId: denomID2, Name: tokenNm, URI: tokenURI2, Data: tokenData, Recipient: address3.String()},
+	}))
+	suite.NoError(err)
+
+	suite.Equal(uint64(2), suite.keeper.GetTotalSupply(suite.ctx, denomID))
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupply(suite.ctx, denomID2))
+	suite.Equal(3, suite.countEvents("chainmain.nft.v1.EventMintNFT"))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID2, tokenID)
+	suite.NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+
+	// the whole batch fails when a single item can't be minted
+	_, err = msgServer.BatchMintNFT(suite.ctx, types.NewMsgBatchMintNFT(address.String(), []types.MintNFTItem{
+		{Id: tokenID3, DenomId: denomID, Name: tokenNm3, URI: tokenURI, Data: tokenData, Recipient: address.String()},
+		{Id: tokenID, DenomId: denomID, Name: tokenNm, URI: tokenURI, Data: tokenData, Recipient: address.String()},
+	}))
+	suite.ErrorIs(err, types.ErrNFTAlreadyExists)
+
+	// only the denom creator can mint
+	_, err = msgServer.BatchMintNFT(suite.ctx, types.NewMsgBatchMintNFT(address2.String(), []types.MintNFTItem{
+		{Id: tokenID3, DenomId: denomID, Name: tokenNm3, URI: tokenURI, Data: tokenData, Recipient: address2.String()},
+	}))
+	suite.ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperSuite) TestBatchTransferNFT() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	// the sender must own every NFT in the batch
+	_, err = msgServer.BatchTransferNFT(suite.ctx, types.NewMsgBatchTransferNFT(address2.String(), []types.TransferNFTItem{
+		{Id: tokenID, DenomId: denomID, Recipient: address3.String()},
+	}))
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = msgServer.BatchTransferNFT(suite.ctx, types.NewMsgBatchTransferNFT(address.String(), []types.TransferNFTItem{
+		{Id: tokenID, DenomId: denomID, Recipient: address2.String()},
+		{Id: tokenID2, DenomId: denomID2, Recipient: address3.String()},
+	}))
+	suite.NoError(err)
+	suite.Equal(2, suite.countEvents("chainmain.nft.v1.EventTransferNFT"))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address2, nft.GetOwner())
+
+	nft, err = suite.keeper.GetNFT(suite.ctx, denomID2, tokenID2)
+	suite.NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+}
+
+func (suite *KeeperSuite) TestBatchBurnNFT() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID2, tokenNm2, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	_, err = msgServer.BatchBurnNFT(suite.ctx, types.NewMsgBatchBurnNFT(address.String(), []types.BurnNFTItem{
+		{Id: tokenID, DenomId: denomID},
+		{Id: tokenID2, DenomId: denomID2},
+	}))
+	suite.NoError(err)
+	suite.Equal(2, suite.countEvents("chainmain.nft.v1.EventBurnNFT"))
+
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID, tokenID))
+	suite.False(suite.keeper.HasNFT(suite.ctx, denomID2, tokenID2))
+
+	// burning an NFT that doesn't exist fails
+	_, err = msgServer.BatchBurnNFT(suite.ctx, types.NewMsgBatchBurnNFT(address.String(), []types.BurnNFTItem{
+		{Id: tokenID, DenomId: denomID},
+	}))
+	suite.Error(err)
+}
+
+func (suite *KeeperSuite) typedEvent(eventType string) proto.Message {
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == eventType {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			suite.Require().NoError(err)
+			return msg
+		}
+	}
+	suite.FailNow("event not found", eventType)
+	return nil
+}
+
+func (suite *KeeperSuite) TestTypedEvents() {
+	denomID3, denomNm3 := "denomid3", "denom3nm"
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+
+	_, err := msgServer.IssueDenom(suite.ctx, types.NewMsgIssueDenom(denomID3, denomNm3, schema, tokenURI, address.String()))
+	suite.NoError(err)
+	suite.Equal(1, suite.countEvents(types.EventTypeIssueDenom))
+	suite.Equal(&types.EventIssueDenom{
+		DenomId:   denomID3,
+		DenomName: denomNm3,
+		Creator:   address.String(),
+		UriHash:   types.URIHash(tokenURI),
+	}, suite.typedEvent("chainmain.nft.v1.EventIssueDenom"))
+
+	_, err = msgServer.MintNFT(suite.ctx, types.NewMsgMintNFT(tokenID, denomID3, tokenNm, tokenURI, tokenData, address.String(), address.String()))
+	suite.NoError(err)
+	suite.Equal(1, suite.countEvents(types.EventTypeMintNFT))
+	suite.Equal(&types.EventMintNFT{
+		DenomId:   denomID3,
+		TokenId:   tokenID,
+		TokenUri:  tokenURI,
+		Recipient: address.String(),
+		UriHash:   types.URIHash(tokenURI),
+	}, suite.typedEvent("chainmain.nft.v1.EventMintNFT"))
+
+	_, err = msgServer.EditNFT(suite.ctx, types.NewMsgEditNFT(tokenID, denomID3, tokenNm, tokenURI2, tokenData, address.String()))
+	suite.NoError(err)
+	suite.Equal(1, suite.countEvents(types.EventTypeEditNFT))
+	suite.Equal(&types.EventEditNFT{
+		DenomId:  denomID3,
+		TokenId:  tokenID,
+		TokenUri: tokenURI2,
+		Owner:    address.String(),
+		UriHash:  types.URIHash(tokenURI2),
+	}, suite.typedEvent("chainmain.nft.v1.EventEditNFT"))
+
+	_, err = msgServer.TransferNFT(suite.ctx, types.NewMsgTransferNFT(tokenID, denomID3, address.String(), address2.String()))
+	suite.NoError(err)
+	suite.Equal(1, suite.countEvents(types.EventTypeTransfer))
+	suite.Equal(&types.EventTransferNFT{
+		DenomId:   denomID3,
+		TokenId:   tokenID,
+		Sender:    address.String(),
+		Recipient: address2.String(),
+		UriHash:   types.URIHash(tokenURI2),
+	}, suite.typedEvent("chainmain.nft.v1.EventTransferNFT"))
+
+	// NFTs burned by IBC transfers only emit the typed event
+	suite.NoError(suite.keeper.BurnNFTUnverified(suite.ctx, denomID3, tokenID, address2))
+	suite.Equal(0, suite.countEvents(types.EventTypeBurnNFT))
+	suite.Equal(&types.EventBurnNFT{
+		DenomId: denomID3,
+		TokenId: tokenID,
+		Owner:   address2.String(),
+		UriHash: types.URIHash(tokenURI2),
+	}, suite.typedEvent("chainmain.nft.v1.EventBurnNFT"))
+
+	suite.Empty(types.URIHash(""))
+}
+
+func (suite *KeeperSuite) TestNFTTransferAuthorization() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+
+	// address2 can transfer a single NFT of the denom of address to address3
+	authorization := types.NewNFTTransferAuthorization([]string{denomID}, nil, 1, []sdk.AccAddress{address3})
+	suite.NoError(suite.app.AuthzKeeper.SaveGrant(suite.ctx, address2, address, authorization, nil))
+
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, address2, []sdk.Msg{
+		types.NewMsgTransferNFT(tokenID, denomID, address.String(), address2.String()),
+	})
+	suite.ErrorIs(err, errortypes.ErrUnauthorized)
+
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, address2, []sdk.Msg{
+		types.NewMsgTransferNFT(tokenID, denomID, address.String(), address3.String()),
+	})
+	suite.NoError(err)
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address3, nft.GetOwner())
+
+	// the grant is deleted once the spend limit is exhausted
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, address2, []sdk.Msg{
+		types.NewMsgTransferNFT(tokenID2, denomID, address.String(), address3.String()),
+	})
+	suite.Error(err)
+}
@@ -3,39 +3,38 @@
 package keeper
 
 import (
+	"errors"
+
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/exported"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
-	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetNFT gets the specified NFT
 func (k Keeper) GetNFT(ctx sdk.Context, denomID, tokenID string) (nft exported.NFT, err error) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(types.KeyNFT(denomID, tokenID))
-	if bz == nil {
+	baseNFT, err := k.NFTs.Get(ctx, collections.Join(denomID, tokenID))
+	if errors.Is(err, collections.ErrNotFound) {
 		return nil, sdkerrors.Wrapf(types.ErrUnknownCollection, "not found NFT: %s", denomID)
 	}
-
-	var baseNFT types.BaseNFT
-	k.cdc.MustUnmarshal(bz, &baseNFT)
+	if err != nil {
+		return nil, err
+	}
 
 	return baseNFT, nil
 }
 
 // GetNFTs returns all NFTs by the specified denom ID
 func (k Keeper) GetNFTs(ctx sdk.Context, denom string) (nfts []exported.NFT) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.KeyNFT(denom, ""))
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var baseNFT types.BaseNFT
-		k.cdc.MustUnmarshal(iterator.Value(), &baseNFT)
+	err := k.NFTs.Walk(ctx, collections.NewPrefixedPairRange[string, string](denom), func(_ collections.Pair[string, string], baseNFT types.BaseNFT) (bool, error) {
 		nfts = append(nfts, baseNFT)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
 	}
 
 	return nfts
@@ -58,19 +57,16 @@ func (k Keeper) IsOwner(ctx sdk.Context, denomID, tokenID string, owner sdk.AccA
 
 // HasNFT checks if the specified NFT exists
 func (k Keeper) HasNFT(ctx sdk.Context, denomID, tokenID string) bool {
-	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.KeyNFT(denomID, tokenID))
+	has, err := k.NFTs.Has(ctx, collections.Join(denomID, tokenID))
+	return err == nil && has
 }
 
-func (k Keeper) setNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := k.cdc.MustMarshal(&nft)
-	store.Set(types.KeyNFT(denomID, nft.GetID()), bz)
+// setNFT stores the NFT, the owner index is kept in sync by the NFTs indexed map
+func (k Keeper) setNFT(ctx sdk.Context, denomID string, nft types.BaseNFT) error {
+	return k.NFTs.Set(ctx, collections.Join(denomID, nft.GetID()), nft)
 }
 
 // deleteNFT deletes an existing NFT from store
-func (k Keeper) deleteNFT(ctx sdk.Context, denomID string, nft exported.NFT) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.KeyNFT(denomID, nft.GetID()))
+func (k Keeper) deleteNFT(ctx sdk.Context, denomID string, nft exported.NFT) error {
+	return k.NFTs.Remove(ctx, collections.Join(denomID, nft.GetID()))
 }
@@ -5,29 +5,29 @@ package keeper
 import (
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
 
-	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ownerRange returns the owner index range of all NFTs held by address, restricted to a denom if one is given
+func ownerRange(address sdk.AccAddress, denomID string) collections.Ranger[collections.Triple[sdk.AccAddress, string, string]] {
+	if len(denomID) > 0 {
+		return collections.NewSuperPrefixedTripleRange[sdk.AccAddress, string, string](address, denomID)
+	}
+	return collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](address)
+}
+
 // GetOwner gets all the ID collections owned by an address and denom ID
 func (k Keeper) GetOwner(ctx sdk.Context, address sdk.AccAddress, denom string) (types.Owner, error) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.KeyOwner(address, denom, ""))
-	defer iterator.Close()
-
 	owner := types.Owner{
 		Address:       address.String(),
 		IDCollections: types.IDCollections{},
 	}
 	idsMap := make(map[string][]string)
 
-	for ; iterator.Valid(); iterator.Next() {
-		_, denomID, tokenID, err := types.SplitKeyOwner(iterator.Key())
-		if err != nil {
-			return types.Owner{}, err
-		}
-
+	err := k.NFTs.Indexes.ByOwner.Walk(ctx, ownerRange(address, denom), func(key collections.Triple[sdk.AccAddress, string, string]) (bool, error) {
+		denomID, tokenID := key.K2(), key.K3()
 		if ids, ok := idsMap[denomID]; ok {
 			idsMap[denomID] = append(ids, tokenID)
 		} else {
@@ -37,6 +37,10 @@ func (k Keeper) GetOwner(ctx sdk.Context, address sdk.AccAddress, denom string)
 				types.IDCollection{DenomId: denomID},
 			)
 		}
+		return false, nil
+	})
+	if err != nil {
+		return types.Owner{}, err
 	}
 
 	for i := 0; i < len(owner.IDCollections); i++ {
@@ -48,55 +52,26 @@ func (k Keeper) GetOwner(ctx sdk.Context, address sdk.AccAddress, denom string)
 
 // GetOwners gets all the ID collections
 func (k Keeper) GetOwners(ctx sdk.Context) (owners types.Owners, err error) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStoreReversePrefixIterator(store, types.KeyOwner(nil, "", ""))
-	defer iterator.Close()
-
 	idcsMap := make(map[string]types.IDCollections)
-	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		address, denom, id, err := types.SplitKeyOwner(key)
-		if err != nil {
-			return types.Owners{}, err
-		}
-
-		if _, ok := idcsMap[address.String()]; !ok {
-			idcsMap[address.String()] = types.IDCollections{}
+	err = k.NFTs.Indexes.ByOwner.Walk(ctx, nil, func(key collections.Triple[sdk.AccAddress, string, string]) (bool, error) {
+		address := key.K1().String()
+		if _, ok := idcsMap[address]; !ok {
+			idcsMap[address] = types.IDCollections{}
 			owners = append(
 				owners,
-				types.Owner{Address: address.String()},
+				types.Owner{Address: address},
 			)
 		}
-		idcs := idcsMap[address.String()]
-		idcs = idcs.Add(denom, id)
-		idcsMap[address.String()] = idcs
+		idcsMap[address] = idcsMap[address].Add(key.K2(), key.K3())
+		return false, nil
+	})
+	if err != nil {
+		return types.Owners{}, err
 	}
+
 	for i, owner := range owners {
 		owners[i].IDCollections = idcsMap[owner.Address]
 	}
 
 	return owners, nil
 }
-
-func (k Keeper) deleteOwner(ctx sdk.Context, denomID, tokenID string, owner sdk.AccAddress) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.KeyOwner(owner, denomID, tokenID))
-}
-
-func (k Keeper) setOwner(ctx sdk.Context,
-	denomID, tokenID string,
-	owner sdk.AccAddress,
-) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := types.MustMarshalTokenID(k.cdc, tokenID)
-	store.Set(types.KeyOwner(owner, denomID, tokenID), bz)
-}
-
-func (k Keeper) swapOwner(ctx sdk.Context, denomID, tokenID string, srcOwner, dstOwner sdk.AccAddress) {
-	// delete old owner key
-	k.deleteOwner(ctx, denomID, tokenID, srcOwner)
-
-	// set new owner key
-	k.setOwner(ctx, denomID, tokenID, dstOwner)
-}
@@ -0,0 +1,291 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	sdknft "cosmossdk.io/x/nft"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var (
+	_ sdknft.QueryServer = SDKAdapter{}
+	_ sdknft.MsgServer   = SDKAdapter{}
+)
+
+// SDKAdapter serves the query service and MsgSend of the Cosmos SDK x/nft module from
+// the NFT keeper, so that clients of the SDK Class/NFT API can use chain-main NFTs.
+//
+// A denom is presented as a class and a token as an NFT. The fields without an SDK
+// counterpart (the denom schema and creator, the token name and data) are kept by
+// packing the original Denom or BaseNFT into the data of the class or NFT.
+type SDKAdapter struct {
+	keeper Keeper
+}
+
+// NewSDKAdapter returns the SDK x/nft query and msg server for the provided Keeper.
+func NewSDKAdapter(keeper Keeper) SDKAdapter {
+	return SDKAdapter{keeper: keeper}
+}
+
+// Balance returns the number of NFTs of a denom owned by the owner
+func (a SDKAdapter) Balance(c context.Context, request *sdknft.QueryBalanceRequest) (*sdknft.QueryBalanceResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(request.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", request.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &sdknft.QueryBalanceResponse{Amount: a.keeper.GetTotalSupplyOfOwner(ctx, request.ClassId, owner)}, nil
+}
+
+// Owner returns the owner of an NFT, or an empty owner if the NFT does not exist
+func (a SDKAdapter) Owner(c context.Context, request *sdknft.QueryOwnerRequest) (*sdknft.QueryOwnerResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(request.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+	if len(request.Id) == 0 {
+		return nil, sdknft.ErrEmptyNFTID
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	baseNFT, err := a.keeper.NFTs.Get(ctx, collections.Join(request.ClassId, request.Id))
+	if errors.Is(err, collections.ErrNotFound) {
+		return &sdknft.QueryOwnerResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sdknft.QueryOwnerResponse{Owner: baseNFT.Owner}, nil
+}
+
+// Supply returns the number of NFTs of a denom
+func (a SDKAdapter) Supply(c context.Context, request *sdknft.QuerySupplyRequest) (*sdknft.QuerySupplyResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(request.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &sdknft.QuerySupplyResponse{Amount: a.keeper.GetTotalSupply(ctx, request.ClassId)}, nil
+}
+
+// NFTs returns the NFTs of a denom, of an owner, or of an owner in a denom
+func (a SDKAdapter) NFTs(c context.Context, request *sdknft.QueryNFTsRequest) (*sdknft.QueryNFTsResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		nfts    []*sdknft.NFT
+		pageRes *query.PageResponse
+		err     error
+	)
+	switch {
+	case len(request.Owner) > 0:
+		owner, err := sdk.AccAddressFromBech32(request.Owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s", request.Owner)
+		}
+		nfts, pageRes, err = query.CollectionPaginate(
+			ctx,
+			a.keeper.NFTs.Indexes.ByOwner,
+			request.Pagination,
+			func(key collections.Triple[sdk.AccAddress, string, string], _ collections.NoValue) (*sdknft.NFT, error) {
+				baseNFT, err := a.keeper.NFTs.Get(ctx, collections.Join(key.K2(), key.K3()))
+				if err != nil {
+					return nil, err
+				}
+				return toSDKNFT(key.K2(), baseNFT)
+			},
+			withOwnerPaginationPrefix(owner, request.ClassId, ""),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+		}
+	case len(request.ClassId) > 0:
+		nfts, pageRes, err = query.CollectionPaginate(
+			ctx,
+			a.keeper.NFTs,
+			request.Pagination,
+			func(key collections.Pair[string, string], baseNFT types.BaseNFT) (*sdknft.NFT, error) {
+				return toSDKNFT(key.K1(), baseNFT)
+			},
+			query.WithCollectionPaginationPairPrefix[string, string](request.ClassId),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+		}
+	default:
+		return nil, errortypes.ErrInvalidRequest.Wrap("must provide at least one of classID or owner")
+	}
+
+	return &sdknft.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// NFT returns an NFT by its denom and token ID
+func (a SDKAdapter) NFT(c context.Context, request *sdknft.QueryNFTRequest) (*sdknft.QueryNFTResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(request.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+	if len(request.Id) == 0 {
+		return nil, sdknft.ErrEmptyNFTID
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	baseNFT, err := a.keeper.NFTs.Get(ctx, collections.Join(request.ClassId, request.Id))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdknft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", request.ClassId, request.Id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	nft, err := toSDKNFT(request.ClassId, baseNFT)
+	if err != nil {
+		return nil, err
+	}
+	return &sdknft.QueryNFTResponse{Nft: nft}, nil
+}
+
+// Class returns a denom by its ID
+func (a SDKAdapter) Class(c context.Context, request *sdknft.QueryClassRequest) (*sdknft.QueryClassResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+	if len(request.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, err := a.keeper.NFTDenoms.Get(ctx, request.ClassId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, sdknft.ErrClassNotExists.Wrapf("not found class: %s", request.ClassId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := toSDKClass(denom)
+	if err != nil {
+		return nil, err
+	}
+	return &sdknft.QueryClassResponse{Class: class}, nil
+}
+
+// Classes returns all denoms
+func (a SDKAdapter) Classes(c context.Context, request *sdknft.QueryClassesRequest) (*sdknft.QueryClassesResponse, error) {
+	if request == nil {
+		return nil, errortypes.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	classes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		a.keeper.NFTDenoms,
+		request.Pagination,
+		func(_ string, denom types.Denom) (*sdknft.Class, error) {
+			return toSDKClass(denom)
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &sdknft.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+// Send transfers an NFT from its owner to the receiver, like MsgTransferNFT
+func (a SDKAdapter) Send(c context.Context, msg *sdknft.MsgSend) (*sdknft.MsgSendResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, sdknft.ErrEmptyClassID
+	}
+	if len(msg.Id) == 0 {
+		return nil, sdknft.ErrEmptyNFTID
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	// the module event is emitted alongside the SDK one, so that indexers of either
+	// see the transfer
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := a.keeper.TransferOwner(ctx, msg.ClassId, msg.Id, sender, receiver); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&sdknft.EventSend{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &sdknft.MsgSendResponse{}, nil
+}
+
+// toSDKClass presents a denom as an SDK x/nft class
+func toSDKClass(denom types.Denom) (*sdknft.Class, error) {
+	data, err := codectypes.NewAnyWithValue(&denom)
+	if err != nil {
+		return nil, err
+	}
+	return &sdknft.Class{
+		Id:   denom.Id,
+		Name: denom.Name,
+		Uri:  denom.Uri,
+		Data: data,
+	}, nil
+}
+
+// toSDKNFT presents a token of the given denom as an SDK x/nft NFT
+func toSDKNFT(denomID string, baseNFT types.BaseNFT) (*sdknft.NFT, error) {
+	data, err := codectypes.NewAnyWithValue(&baseNFT)
+	if err != nil {
+		return nil, err
+	}
+	return &sdknft.NFT{
+		ClassId: denomID,
+		Id:      baseNFT.Id,
+		Uri:     baseNFT.URI,
+		Data:    data,
+	}, nil
+}
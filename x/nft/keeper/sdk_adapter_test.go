@@ -0,0 +1,137 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	sdknft "cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (suite *KeeperSuite) sdkQueryClient() sdknft.QueryClient {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	sdknft.RegisterQueryServer(queryHelper, keeper.NewSDKAdapter(suite.keeper))
+	return sdknft.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperSuite) TestSDKAdapterQueries() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID, tokenID2, tokenNm2, tokenURI2, tokenData, address, address2)
+	suite.NoError(err)
+	err = suite.keeper.MintNFT(suite.ctx, denomID2, tokenID3, tokenNm3, tokenURI, tokenData, address, address2)
+	suite.NoError(err)
+
+	queryClient := suite.sdkQueryClient()
+
+	class, err := queryClient.Class(gocontext.Background(), &sdknft.QueryClassRequest{ClassId: denomID})
+	suite.NoError(err)
+	suite.Equal(denomID, class.Class.Id)
+	suite.Equal(denomNm, class.Class.Name)
+	var denom types.Denom
+	suite.NoError(suite.app.AppCodec().Unmarshal(class.Class.Data.Value, &denom))
+	suite.Equal(schema, denom.Schema)
+	suite.Equal(address.String(), denom.Creator)
+
+	_, err = queryClient.Class(gocontext.Background(), &sdknft.QueryClassRequest{ClassId: "nonexistent"})
+	suite.ErrorContains(err, sdknft.ErrClassNotExists.Error())
+
+	classes, err := queryClient.Classes(gocontext.Background(), &sdknft.QueryClassesRequest{})
+	suite.NoError(err)
+	suite.Len(classes.Classes, 2)
+
+	nft, err := queryClient.NFT(gocontext.Background(), &sdknft.QueryNFTRequest{ClassId: denomID, Id: tokenID2})
+	suite.NoError(err)
+	suite.Equal(denomID, nft.Nft.ClassId)
+	suite.Equal(tokenID2, nft.Nft.Id)
+	suite.Equal(tokenURI2, nft.Nft.Uri)
+	var baseNFT types.BaseNFT
+	suite.NoError(suite.app.AppCodec().Unmarshal(nft.Nft.Data.Value, &baseNFT))
+	suite.Equal(tokenNm2, baseNFT.Name)
+	suite.Equal(tokenData, baseNFT.Data)
+
+	_, err = queryClient.NFT(gocontext.Background(), &sdknft.QueryNFTRequest{ClassId: denomID, Id: tokenID3})
+	suite.ErrorContains(err, sdknft.ErrNFTNotExists.Error())
+
+	owner, err := queryClient.Owner(gocontext.Background(), &sdknft.QueryOwnerRequest{ClassId: denomID, Id: tokenID2})
+	suite.NoError(err)
+	suite.Equal(address2.String(), owner.Owner)
+
+	owner, err = queryClient.Owner(gocontext.Background(), &sdknft.QueryOwnerRequest{ClassId: denomID, Id: tokenID3})
+	suite.NoError(err)
+	suite.Empty(owner.Owner)
+
+	supply, err := queryClient.Supply(gocontext.Background(), &sdknft.QuerySupplyRequest{ClassId: denomID})
+	suite.NoError(err)
+	suite.Equal(uint64(2), supply.Amount)
+
+	balance, err := queryClient.Balance(gocontext.Background(), &sdknft.QueryBalanceRequest{ClassId: denomID, Owner: address2.String()})
+	suite.NoError(err)
+	suite.Equal(uint64(1), balance.Amount)
+
+	// by denom
+	nfts, err := queryClient.NFTs(gocontext.Background(), &sdknft.QueryNFTsRequest{ClassId: denomID})
+	suite.NoError(err)
+	suite.Len(nfts.Nfts, 2)
+
+	// by owner
+	nfts, err = queryClient.NFTs(gocontext.Background(), &sdknft.QueryNFTsRequest{Owner: address2.String()})
+	suite.NoError(err)
+	suite.Len(nfts.Nfts, 2)
+
+	// by owner and denom, paginated
+	nfts, err = queryClient.NFTs(gocontext.Background(), &sdknft.QueryNFTsRequest{
+		ClassId:    denomID2,
+		Owner:      address2.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(nfts.Nfts, 1)
+	suite.Equal(tokenID3, nfts.Nfts[0].Id)
+	suite.Equal(uint64(1), nfts.Pagination.Total)
+
+	_, err = queryClient.NFTs(gocontext.Background(), &sdknft.QueryNFTsRequest{})
+	suite.Error(err)
+}
+
+func (suite *KeeperSuite) TestSDKAdapterSend() {
+	err := suite.keeper.MintNFT(suite.ctx, denomID, tokenID, tokenNm, tokenURI, tokenData, address, address)
+	suite.NoError(err)
+
+	adapter := keeper.NewSDKAdapter(suite.keeper)
+
+	// only the owner can send
+	_, err = adapter.Send(suite.ctx, &sdknft.MsgSend{ClassId: denomID, Id: tokenID, Sender: address2.String(), Receiver: address3.String()})
+	suite.ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = adapter.Send(suite.ctx, &sdknft.MsgSend{ClassId: denomID, Id: tokenID, Sender: address.String(), Receiver: "invalid"})
+	suite.Error(err)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = adapter.Send(suite.ctx, &sdknft.MsgSend{ClassId: denomID, Id: tokenID, Sender: address.String(), Receiver: address2.String()})
+	suite.NoError(err)
+
+	// the module event is emitted alongside the SDK event
+	suite.Len(suite.ctx.EventManager().Events(), 2)
+	suite.Equal(&types.EventTransferNFT{
+		DenomId:   denomID,
+		TokenId:   tokenID,
+		Sender:    address.String(),
+		Recipient: address2.String(),
+		UriHash:   types.URIHash(tokenURI),
+	}, suite.typedEvent("chainmain.nft.v1.EventTransferNFT"))
+	suite.Equal(&sdknft.EventSend{ClassId: denomID, Id: tokenID, Sender: address.String(), Receiver: address2.String()}, suite.typedEvent("cosmos.nft.v1beta1.EventSend"))
+
+	nft, err := suite.keeper.GetNFT(suite.ctx, denomID, tokenID)
+	suite.NoError(err)
+	suite.Equal(address2, nft.GetOwner())
+	suite.Equal(uint64(1), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address2))
+	suite.Equal(uint64(0), suite.keeper.GetTotalSupplyOfOwner(suite.ctx, denomID, address))
+}
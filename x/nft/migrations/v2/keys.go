@@ -0,0 +1,116 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package v2
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Legacy store prefixes of the `/`-delimited layout used before consensus version 2.
+var (
+	PrefixNFT        = []byte{0x01}
+	PrefixOwners     = []byte{0x02} // key for a owner
+	PrefixCollection = []byte{0x03} // key for balance of NFTs held by the denom
+	PrefixDenom      = []byte{0x04} // key for denom of the nft
+	PrefixDenomName  = []byte{0x05} // key for denom name of the nft
+
+	delimiter = []byte("/")
+)
+
+// SplitKeyOwner return the address,denom,id from the key of stored owner
+func SplitKeyOwner(key []byte) (address sdk.AccAddress, denomID, tokenID string, err error) {
+	key = key[len(PrefixOwners)+len(delimiter):]
+	keys := bytes.SplitN(key, delimiter, 2)
+	if len(keys) < 2 {
+		return address, denomID, tokenID, errors.New("wrong KeyOwner")
+	}
+
+	address, err = sdk.AccAddressFromBech32(string(keys[0]))
+	if err != nil {
+		return address, denomID, tokenID, err
+	}
+
+	denomID, tokenID, err = SplitKeyDenom(keys[1])
+
+	return address, denomID, tokenID, err
+}
+
+// SplitKeyDenom return the denom,id from a `denom/id` key suffix
+func SplitKeyDenom(key []byte) (denomID, tokenID string, err error) {
+	if bytes.HasPrefix(key, []byte(types.IBCPrefix)) {
+		// IBC denom: ibc/hash/tokenID (take remainder after second delimiter as tokenID)
+		rest := key[len(types.IBCPrefix):]
+		idx := bytes.Index(rest, delimiter)
+		if idx < 0 || idx != types.IBCDenomLen-len(types.IBCPrefix) {
+			return denomID, tokenID, errors.New("wrong KeyDenom")
+		}
+		denomID = types.IBCPrefix + string(rest[:idx])
+		tokenID = string(rest[idx+len(delimiter):])
+	} else {
+		// Standard denom: denom/tokenID (take remainder after first delimiter as tokenID)
+		idx := bytes.Index(key, delimiter)
+		if idx < 0 {
+			return denomID, tokenID, errors.New("wrong KeyDenom")
+		}
+		denomID = string(key[:idx])
+		tokenID = string(key[idx+len(delimiter):])
+	}
+
+	return denomID, tokenID, nil
+}
+
+// KeyOwner gets the key of a collection owned by an account address
+func KeyOwner(address sdk.AccAddress, denomID, tokenID string) []byte {
+	key := append(PrefixOwners, delimiter...)
+	if address != nil {
+		key = append(key, []byte(address.String())...)
+		key = append(key, delimiter...)
+	}
+
+	if address != nil && len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if address != nil && len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// KeyNFT gets the key of nft stored by an denom and id
+func KeyNFT(denomID, tokenID string) []byte {
+	key := append(PrefixNFT, delimiter...)
+	if len(denomID) > 0 {
+		key = append(key, []byte(denomID)...)
+		key = append(key, delimiter...)
+	}
+
+	if len(denomID) > 0 && len(tokenID) > 0 {
+		key = append(key, []byte(tokenID)...)
+	}
+	return key
+}
+
+// KeyCollection gets the storeKey by the collection
+func KeyCollection(denomID string) []byte {
+	key := append(PrefixCollection, delimiter...)
+	return append(key, []byte(denomID)...)
+}
+
+// KeyDenomID gets the storeKey by the denom id
+func KeyDenomID(id string) []byte {
+	key := append(PrefixDenom, delimiter...)
+	return append(key, []byte(id)...)
+}
+
+// KeyDenomName gets the storeKey by the denom name
+func KeyDenomName(name string) []byte {
+	key := append(PrefixDenomName, delimiter...)
+	return append(key, []byte(name)...)
+}
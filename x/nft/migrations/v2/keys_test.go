@@ -1,9 +1,11 @@
-package types_test
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package v2_test
 
 import (
 	"testing"
 
-	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	v2 "github.com/crypto-org-chain/chain-main/v8/x/nft/migrations/v2"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,7 +14,7 @@ import (
 func TestSplitKeyDenomWithoutIBC(t *testing.T) {
 	keyDenom := []byte("testdenomid/testtokenid")
 
-	denomID, tokenID, err := types.SplitKeyDenom(keyDenom)
+	denomID, tokenID, err := v2.SplitKeyDenom(keyDenom)
 
 	require.NoError(t, err)
 	require.Equal(t, "testdenomid", denomID)
@@ -24,7 +26,7 @@ func TestSplitKeyDenomWithIBC(t *testing.T) {
 	hash := "27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 	keyDenom := []byte("ibc/" + hash + "/testtokenid")
 
-	denomID, tokenID, err := types.SplitKeyDenom(keyDenom)
+	denomID, tokenID, err := v2.SplitKeyDenom(keyDenom)
 
 	require.NoError(t, err)
 	require.Equal(t, "ibc/"+hash, denomID)
@@ -35,7 +37,7 @@ func TestSplitKeyDenomWithIBCInvalidHashLength(t *testing.T) {
 	// Hash too short — should be rejected
 	keyDenom := []byte("ibc/shorthash/testtokenid")
 
-	_, _, err := types.SplitKeyDenom(keyDenom)
+	_, _, err := v2.SplitKeyDenom(keyDenom)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "wrong KeyDenom")
@@ -45,7 +47,7 @@ func TestSplitKeyDenomWithIBCNoTokenID(t *testing.T) {
 	// No delimiter after hash — missing tokenID
 	keyDenom := []byte("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
 
-	_, _, err := types.SplitKeyDenom(keyDenom)
+	_, _, err := v2.SplitKeyDenom(keyDenom)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "wrong KeyDenom")
@@ -55,7 +57,7 @@ func TestSplitKeyDenomNonIBCWithSlash(t *testing.T) {
 	// Non-IBC key with slashes: first segment is denomID, rest is tokenID
 	keyDenom := []byte("port/channel/classid/testtokenid")
 
-	denomID, tokenID, err := types.SplitKeyDenom(keyDenom)
+	denomID, tokenID, err := v2.SplitKeyDenom(keyDenom)
 
 	require.NoError(t, err)
 	require.Equal(t, "port", denomID)
@@ -65,7 +67,7 @@ func TestSplitKeyDenomNonIBCWithSlash(t *testing.T) {
 func TestSplitKeyDenomNoDelimiter(t *testing.T) {
 	keyDenom := []byte("nodeliminatall")
 
-	_, _, err := types.SplitKeyDenom(keyDenom)
+	_, _, err := v2.SplitKeyDenom(keyDenom)
 
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "wrong KeyDenom")
@@ -77,9 +79,9 @@ func TestSplitKeyOwnerWithNonIBC(t *testing.T) {
 
 	t.Run("simple token ID", func(t *testing.T) {
 		tokenID := "testtokenid"
-		key := types.KeyOwner(addr, denomID, tokenID)
+		key := v2.KeyOwner(addr, denomID, tokenID)
 
-		gotAddr, gotDenom, gotToken, err := types.SplitKeyOwner(key)
+		gotAddr, gotDenom, gotToken, err := v2.SplitKeyOwner(key)
 		require.NoError(t, err)
 		require.Equal(t, addr, gotAddr)
 		require.Equal(t, denomID, gotDenom)
@@ -88,9 +90,9 @@ func TestSplitKeyOwnerWithNonIBC(t *testing.T) {
 
 	t.Run("token ID with slashes", func(t *testing.T) {
 		tokenID := "collection/series/42"
-		key := types.KeyOwner(addr, denomID, tokenID)
+		key := v2.KeyOwner(addr, denomID, tokenID)
 
-		gotAddr, gotDenom, gotToken, err := types.SplitKeyOwner(key)
+		gotAddr, gotDenom, gotToken, err := v2.SplitKeyOwner(key)
 		require.NoError(t, err)
 		require.Equal(t, addr, gotAddr)
 		require.Equal(t, denomID, gotDenom)
@@ -104,9 +106,9 @@ func TestSplitKeyOwnerWithIBC(t *testing.T) {
 
 	t.Run("simple token ID", func(t *testing.T) {
 		tokenID := "testtokenid"
-		key := types.KeyOwner(addr, ibcDenom, tokenID)
+		key := v2.KeyOwner(addr, ibcDenom, tokenID)
 
-		gotAddr, gotDenom, gotToken, err := types.SplitKeyOwner(key)
+		gotAddr, gotDenom, gotToken, err := v2.SplitKeyOwner(key)
 		require.NoError(t, err)
 		require.Equal(t, addr, gotAddr)
 		require.Equal(t, ibcDenom, gotDenom)
@@ -115,9 +117,9 @@ func TestSplitKeyOwnerWithIBC(t *testing.T) {
 
 	t.Run("token ID with slashes", func(t *testing.T) {
 		tokenID := "collection/series/42"
-		key := types.KeyOwner(addr, ibcDenom, tokenID)
+		key := v2.KeyOwner(addr, ibcDenom, tokenID)
 
-		gotAddr, gotDenom, gotToken, err := types.SplitKeyOwner(key)
+		gotAddr, gotDenom, gotToken, err := v2.SplitKeyOwner(key)
 		require.NoError(t, err)
 		require.Equal(t, addr, gotAddr)
 		require.Equal(t, ibcDenom, gotDenom)
@@ -0,0 +1,120 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package v2
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTKeeper defines the keeper methods used to write the migrated state.
+type NFTKeeper interface {
+	SetDenom(ctx sdk.Context, denom types.Denom) error
+	MintNFTUnverified(ctx sdk.Context, denomID, tokenID, tokenNm, tokenURI, tokenData string, owner sdk.AccAddress) error
+}
+
+// migrationBatchSize bounds the number of legacy entries held in memory at once.
+const migrationBatchSize = 1000
+
+// MigrateStore moves denoms and NFTs from the legacy `/`-delimited keys to the collections
+// layout. The denom name, owner and supply entries are rebuilt from them by the keeper. The
+// legacy entries are read, written and deleted in batches, so the whole store is never held
+// in memory.
+func MigrateStore(ctx sdk.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec, k NFTKeeper) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	// denoms go first, as minting an NFT requires its denom
+	denoms, err := drainPrefix(store, KeyDenomID(""), func(_, value []byte) error {
+		var denom types.Denom
+		if err := cdc.Unmarshal(value, &denom); err != nil {
+			return err
+		}
+		if err := k.SetDenom(ctx, denom); err != nil {
+			return fmt.Errorf("migrate denom %s: %w", denom.Id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("migrate legacy denoms: %w", err)
+	}
+
+	nfts, err := drainPrefix(store, KeyNFT("", ""), func(key, value []byte) error {
+		denomID, tokenID, err := SplitKeyDenom(key)
+		if err != nil {
+			return err
+		}
+		var nft types.BaseNFT
+		if err := cdc.Unmarshal(value, &nft); err != nil {
+			return err
+		}
+		if nft.Id != tokenID {
+			return fmt.Errorf("token ID %s does not match key %s/%s", nft.Id, denomID, tokenID)
+		}
+		owner, err := sdk.AccAddressFromBech32(nft.Owner)
+		if err != nil {
+			return fmt.Errorf("migrate nft %s/%s: %w", denomID, nft.Id, err)
+		}
+		if err := k.MintNFTUnverified(ctx, denomID, nft.Id, nft.Name, nft.URI, nft.Data, owner); err != nil {
+			return fmt.Errorf("migrate nft %s/%s: %w", denomID, nft.Id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("migrate legacy nfts: %w", err)
+	}
+
+	// the remaining legacy entries are derived from the ones migrated above
+	for _, prefix := range [][]byte{PrefixNFT, PrefixOwners, PrefixCollection, PrefixDenom, PrefixDenomName} {
+		if _, err := drainPrefix(store, append(prefix, delimiter...), nil); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("nft v2 migration: moved store to collections", "denoms", denoms, "nfts", nfts)
+	return nil
+}
+
+// drainPrefix calls fn, if set, with the key, stripped of prefix, and value of every entry under
+// prefix and deletes the entries, at most migrationBatchSize at a time. It returns the number of
+// entries drained.
+func drainPrefix(store storetypes.KVStore, prefix []byte, fn func(key, value []byte) error) (int, error) {
+	total := 0
+	for {
+		keys, values := readBatch(store, prefix)
+		for i, key := range keys {
+			if fn != nil {
+				if err := fn(key[len(prefix):], values[i]); err != nil {
+					return total, err
+				}
+			}
+			store.Delete(key)
+		}
+		total += len(keys)
+
+		if len(keys) < migrationBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// readBatch returns copies of the first migrationBatchSize keys and values under prefix. The
+// iterator is closed before returning, so the caller is free to write to the store.
+func readBatch(store storetypes.KVStore, prefix []byte) (keys, values [][]byte) {
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(keys) < migrationBatchSize; iterator.Next() {
+		keys = append(keys, bytes.Clone(iterator.Key()))
+		values = append(values, bytes.Clone(iterator.Value()))
+	}
+	return keys, values
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/keeper"
+	v2 "github.com/crypto-org-chain/chain-main/v8/x/nft/migrations/v2"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(nft.AppModuleBasic{}).Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	creator := sdk.AccAddress("creator_____________")
+	owner := sdk.AccAddress("owner_______________")
+	ibcDenomID := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	denoms := []types.Denom{
+		types.NewDenom("denomid", "denomnm", "", "", creator),
+		types.NewDenom(ibcDenomID, ibcDenomID, "", "", creator),
+	}
+	nfts := []struct {
+		denomID string
+		nft     types.BaseNFT
+	}{
+		{"denomid", types.NewBaseNFT("tokenid", "tokennm", owner, "uri", "")},
+		{"denomid", types.NewBaseNFT("tokenid2", "tokennm2", creator, "uri2", "")},
+		{ibcDenomID, types.NewBaseNFT("tokenid", "", owner, "ibc-uri", "")},
+		// IBC class IDs may carry token IDs that contain the legacy delimiter
+		{ibcDenomID, types.NewBaseNFT("token/with/slash", "", owner, "", "")},
+	}
+
+	// write the legacy layout
+	supply := map[string]uint64{}
+	for _, denom := range denoms {
+		store.Set(v2.KeyDenomID(denom.Id), cdc.MustMarshal(&denom))
+		store.Set(v2.KeyDenomName(denom.Name), []byte(denom.Id))
+	}
+	for _, n := range nfts {
+		store.Set(v2.KeyNFT(n.denomID, n.nft.Id), cdc.MustMarshal(&n.nft))
+		store.Set(v2.KeyOwner(n.nft.GetOwner(), n.denomID, n.nft.Id), cdc.MustMarshal(&gogotypes.StringValue{Value: n.nft.Id}))
+		supply[n.denomID]++
+	}
+	for denomID, amount := range supply {
+		store.Set(v2.KeyCollection(denomID), cdc.MustMarshal(&gogotypes.UInt64Value{Value: amount}))
+	}
+
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key))
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// every legacy key is gone
+	for _, prefix := range [][]byte{v2.PrefixNFT, v2.PrefixOwners, v2.PrefixCollection, v2.PrefixDenom, v2.PrefixDenomName} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		require.False(t, iterator.Valid(), "legacy prefix %X not cleared", prefix)
+		require.NoError(t, iterator.Close())
+	}
+
+	for _, denom := range denoms {
+		got, err := k.GetDenom(ctx, denom.Id)
+		require.NoError(t, err)
+		require.Equal(t, denom, got)
+
+		got, err = k.GetDenomByName(ctx, denom.Name)
+		require.NoError(t, err)
+		require.Equal(t, denom, got)
+
+		require.Equal(t, supply[denom.Id], k.GetTotalSupply(ctx, denom.Id))
+	}
+
+	for _, n := range nfts {
+		got, err := k.GetNFT(ctx, n.denomID, n.nft.Id)
+		require.NoError(t, err)
+		require.Equal(t, n.nft, got)
+	}
+
+	require.Equal(t, uint64(1), k.GetTotalSupplyOfOwner(ctx, "denomid", owner))
+	require.Equal(t, uint64(2), k.GetTotalSupplyOfOwner(ctx, ibcDenomID, owner))
+
+	ownerCollections, err := k.GetOwner(ctx, owner, "")
+	require.NoError(t, err)
+	require.Equal(t, []types.IDCollection{
+		{DenomId: "denomid", TokenIds: []string{"tokenid"}},
+		{DenomId: ibcDenomID, TokenIds: []string{"token/with/slash", "tokenid"}},
+	}, ownerCollections.IDCollections)
+
+	ibcCollection, err := k.GetOwner(ctx, owner, ibcDenomID)
+	require.NoError(t, err)
+	require.Len(t, ibcCollection.IDCollections, 1)
+	require.Equal(t, ibcDenomID, ibcCollection.IDCollections[0].DenomId)
+}
+
+func TestMigrateStoreInvalidIBCKey(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(nft.AppModuleBasic{}).Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	owner := sdk.AccAddress("owner_______________")
+	baseNFT := types.NewBaseNFT("tokenid", "", owner, "", "")
+	ctx.KVStore(key).Set(v2.KeyNFT("ibc/shorthash", baseNFT.Id), cdc.MustMarshal(&baseNFT))
+
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key))
+	require.ErrorContains(t, keeper.NewMigrator(k).Migrate1to2(ctx), "wrong KeyDenom")
+}
+
+func TestMigrateStoreManyNFTs(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(nft.AppModuleBasic{}).Codec
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(key)
+
+	creator := sdk.AccAddress("creator_____________")
+	owner := sdk.AccAddress("owner_______________")
+	denom := types.NewDenom("denomid", "denomnm", "", "", creator)
+	store.Set(v2.KeyDenomID(denom.Id), cdc.MustMarshal(&denom))
+	store.Set(v2.KeyDenomName(denom.Name), []byte(denom.Id))
+
+	// spans several migration batches
+	const count = 2500
+	for i := range count {
+		baseNFT := types.NewBaseNFT(fmt.Sprintf("token%d", i), "", owner, "", "")
+		store.Set(v2.KeyNFT(denom.Id, baseNFT.Id), cdc.MustMarshal(&baseNFT))
+		store.Set(v2.KeyOwner(owner, denom.Id, baseNFT.Id), cdc.MustMarshal(&gogotypes.StringValue{Value: baseNFT.Id}))
+	}
+	store.Set(v2.KeyCollection(denom.Id), cdc.MustMarshal(&gogotypes.UInt64Value{Value: count}))
+
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key))
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	for _, prefix := range [][]byte{v2.PrefixNFT, v2.PrefixOwners, v2.PrefixCollection, v2.PrefixDenom, v2.PrefixDenomName} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		require.False(t, iterator.Valid(), "legacy prefix %X not cleared", prefix)
+		require.NoError(t, iterator.Close())
+	}
+
+	require.Equal(t, uint64(count), k.GetTotalSupply(ctx, denom.Id))
+	require.Equal(t, uint64(count), k.GetTotalSupplyOfOwner(ctx, denom.Id, owner))
+	require.True(t, k.HasNFT(ctx, denom.Id, "token0"))
+	require.True(t, k.HasNFT(ctx, denom.Id, fmt.Sprintf("token%d", count-1)))
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package types
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// IBCTransferMsgTypeURL is the type URL of the nft-transfer MsgTransfer, which can't
+// be imported by this module.
+const IBCTransferMsgTypeURL = "/chainmain.nft_transfer.v1.MsgTransfer"
+
+// gasCostPerIteration is the gas consumed for each allowed denom, NFT, recipient or
+// channel checked, as for the bank send authorization
+const gasCostPerIteration = uint64(10)
+
+var _ authz.Authorization = &NFTTransferAuthorization{}
+
+// IBCTransferMsg is implemented by the nft-transfer MsgTransfer, to be accepted by an
+// NFTTransferAuthorization with allowed channels.
+type IBCTransferMsg interface {
+	sdk.Msg
+
+	GetSourcePort() string
+	GetSourceChannel() string
+	GetClassId() string
+	GetTokenIds() []string
+	GetReceiver() string
+}
+
+// NewNFTTransferAuthorization creates a new NFTTransferAuthorization object for
+// transfers within the chain.
+func NewNFTTransferAuthorization(
+	denomIDs []string, tokens []NFTRef, spendLimit uint64, allowList []sdk.AccAddress,
+) *NFTTransferAuthorization {
+	allowed := make([]string, len(allowList))
+	for i, addr := range allowList {
+		allowed[i] = addr.String()
+	}
+
+	return &NFTTransferAuthorization{
+		DenomIds:   denomIDs,
+		Tokens:     tokens,
+		SpendLimit: spendLimit,
+		AllowList:  allowed,
+	}
+}
+
+// NewIBCNFTTransferAuthorization creates a new NFTTransferAuthorization object for
+// IBC transfers on the allowed channels to the allowed counterparty receivers.
+func NewIBCNFTTransferAuthorization(
+	denomIDs []string, tokens []NFTRef, spendLimit uint64, allowedChannels []AllowedChannel, allowedReceivers []string,
+) *NFTTransferAuthorization {
+	return &NFTTransferAuthorization{
+		DenomIds:         denomIDs,
+		Tokens:           tokens,
+		SpendLimit:       spendLimit,
+		AllowedChannels:  allowedChannels,
+		AllowedReceivers: allowedReceivers,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL. The authorization grants the
+// nft-transfer MsgTransfer when allowed channels are set, and MsgTransferNFT
+// otherwise, so that two grants are needed to allow both. MsgBatchTransferNFT is
+// never granted.
+func (a NFTTransferAuthorization) MsgTypeURL() string {
+	if len(a.AllowedChannels) > 0 {
+		return IBCTransferMsgTypeURL
+	}
+	return sdk.MsgTypeURL(&MsgTransferNFT{})
+}
+
+// Accept implements Authorization.Accept.
+func (a NFTTransferAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var (
+		denomID   string
+		tokenIDs  []string
+		recipient string
+	)
+	switch msg := msg.(type) {
+	case *MsgTransferNFT:
+		if len(a.AllowedChannels) > 0 {
+			return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+		}
+		denomID, tokenIDs, recipient = msg.DenomId, []string{msg.Id}, msg.Recipient
+
+	case IBCTransferMsg:
+		if sdk.MsgTypeURL(msg) != IBCTransferMsgTypeURL || len(a.AllowedChannels) == 0 {
+			return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+		}
+		if !a.isChannelAllowed(sdkCtx, msg.GetSourcePort(), msg.GetSourceChannel()) {
+			return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf(
+				"cannot transfer on channel %s/%s", msg.GetSourcePort(), msg.GetSourceChannel(),
+			)
+		}
+		denomID, tokenIDs, recipient = msg.GetClassId(), msg.GetTokenIds(), msg.GetReceiver()
+
+	default:
+		return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	for _, tokenID := range tokenIDs {
+		if !a.isNFTAllowed(sdkCtx, denomID, tokenID) {
+			return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot transfer %s/%s", denomID, tokenID)
+		}
+	}
+
+	if !a.isRecipientAllowed(sdkCtx, recipient) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot transfer to %s address", recipient)
+	}
+
+	if a.SpendLimit == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	count := uint64(len(tokenIDs))
+	if count > a.SpendLimit {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf(
+			"requested %d NFTs is more than the spend limit of %d", count, a.SpendLimit,
+		)
+	}
+	if count == a.SpendLimit {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	updated := a
+	updated.SpendLimit -= count
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// isNFTAllowed checks whether the NFT belongs to an allowed denom or is an allowed NFT
+func (a NFTTransferAuthorization) isNFTAllowed(ctx sdk.Context, denomID, tokenID string) bool {
+	for _, allowed := range a.DenomIds {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		if allowed == denomID {
+			return true
+		}
+	}
+	for _, token := range a.Tokens {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		if token.DenomId == denomID && token.TokenId == tokenID {
+			return true
+		}
+	}
+	return false
+}
+
+// isRecipientAllowed checks whether the recipient is in the allow list, or in the
+// allowed receivers for IBC transfers
+func (a NFTTransferAuthorization) isRecipientAllowed(ctx sdk.Context, recipient string) bool {
+	allowed := a.AllowList
+	if len(a.AllowedChannels) > 0 {
+		allowed = a.AllowedReceivers
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, addr := range allowed {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		if addr == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+// isChannelAllowed checks whether the source port and channel are allowed
+func (a NFTTransferAuthorization) isChannelAllowed(ctx sdk.Context, sourcePort, sourceChannel string) bool {
+	for _, channel := range a.AllowedChannels {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "nft transfer authorization")
+		if channel.SourcePort == sourcePort && channel.SourceChannel == sourceChannel {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a NFTTransferAuthorization) ValidateBasic() error {
+	if len(a.DenomIds) == 0 && len(a.Tokens) == 0 {
+		return sdkerrors.Wrap(errortypes.ErrInvalidRequest, "at least one denom ID or NFT must be allowed")
+	}
+
+	for i, denomID := range a.DenomIds {
+		if err := ValidateDenomIDWithIBC(denomID); err != nil {
+			return err
+		}
+		if slices.Contains(a.DenomIds[:i], denomID) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidRequest, "duplicate denom ID %s", denomID)
+		}
+	}
+
+	for i, token := range a.Tokens {
+		if err := ValidateDenomIDWithIBC(token.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateTokenID(token.TokenId); err != nil {
+			return err
+		}
+		if slices.Contains(a.Tokens[:i], token) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidRequest, "duplicate NFT %s/%s", token.DenomId, token.TokenId)
+		}
+	}
+
+	for i, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "invalid allowed address %s: %s", addr, err)
+		}
+		if slices.Contains(a.AllowList[:i], addr) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "duplicate allowed address %s", addr)
+		}
+	}
+
+	if len(a.AllowedChannels) > 0 && len(a.AllowList) > 0 {
+		return sdkerrors.Wrap(errortypes.ErrInvalidRequest, "allow list can't be set with allowed channels, use allowed receivers")
+	}
+	if len(a.AllowedChannels) == 0 && len(a.AllowedReceivers) > 0 {
+		return sdkerrors.Wrap(errortypes.ErrInvalidRequest, "allowed receivers require allowed channels")
+	}
+
+	for i, receiver := range a.AllowedReceivers {
+		if strings.TrimSpace(receiver) == "" {
+			return sdkerrors.Wrap(errortypes.ErrInvalidAddress, "allowed receiver cannot be blank")
+		}
+		if slices.Contains(a.AllowedReceivers[:i], receiver) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidAddress, "duplicate allowed receiver %s", receiver)
+		}
+	}
+
+	for i, channel := range a.AllowedChannels {
+		if err := host.PortIdentifierValidator(channel.SourcePort); err != nil {
+			return sdkerrors.Wrap(err, "invalid allowed source port ID")
+		}
+		if err := host.ChannelIdentifierValidator(channel.SourceChannel); err != nil {
+			return sdkerrors.Wrap(err, "invalid allowed source channel ID")
+		}
+		if slices.Contains(a.AllowedChannels[:i], channel) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidRequest, "duplicate allowed channel %s/%s", channel.SourcePort, channel.SourceChannel)
+		}
+	}
+
+	return nil
+}
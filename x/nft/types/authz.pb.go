@@ -0,0 +1,1075 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/nft/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// NFTTransferAuthorization allows the grantee to transfer NFTs of the granter, either
+// within the chain with MsgTransferNFT, or through IBC with the nft-transfer
+// MsgTransfer when allowed channels are set. As a grant is keyed by a single message
+// type, a granter needs two grants to allow both. MsgBatchTransferNFT is not covered,
+// a grantee executes one MsgTransferNFT per NFT instead so that every transfer is
+// checked and counted against the spend limit.
+type NFTTransferAuthorization struct {
+	// denom_ids are the denoms of which any NFT can be transferred.
+	DenomIds []string `protobuf:"bytes,1,rep,name=denom_ids,json=denomIds,proto3" json:"denom_ids,omitempty"`
+	// tokens are the specific NFTs that can be transferred.
+	Tokens []NFTRef `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	// spend_limit is the remaining number of NFTs that can be transferred, the
+	// authorization is deleted when it is exhausted. Zero means no limit.
+	SpendLimit uint64 `protobuf:"varint,3,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// allow_list specifies an optional list of addresses to whom the grantee can
+	// transfer NFTs within the chain. Any recipient is allowed when it is empty. It
+	// can't be set together with allowed_channels.
+	AllowList []string `protobuf:"bytes,4,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// allowed_channels are the source ports and channels on which the grantee can
+	// transfer NFTs through IBC. IBC v2 transfers with MsgTransferV2 are not
+	// covered.
+	AllowedChannels []AllowedChannel `protobuf:"bytes,5,rep,name=allowed_channels,json=allowedChannels,proto3" json:"allowed_channels"`
+	// allowed_receivers specifies an optional list of counterparty addresses to whom
+	// the grantee can transfer NFTs through IBC. They are not validated as they are
+	// encoded by the receiving chain. Any receiver is allowed when it is empty. It
+	// can only be set together with allowed_channels.
+	AllowedReceivers []string `protobuf:"bytes,6,rep,name=allowed_receivers,json=allowedReceivers,proto3" json:"allowed_receivers,omitempty"`
+}
+
+func (m *NFTTransferAuthorization) Reset()         { *m = NFTTransferAuthorization{} }
+func (m *NFTTransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*NFTTransferAuthorization) ProtoMessage()    {}
+func (*NFTTransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edf91dd708c63a56, []int{0}
+}
+func (m *NFTTransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTTransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTTransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTTransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTTransferAuthorization.Merge(m, src)
+}
+func (m *NFTTransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTTransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTTransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTTransferAuthorization proto.InternalMessageInfo
+
+func (m *NFTTransferAuthorization) GetDenomIds() []string {
+	if m != nil {
+		return m.DenomIds
+	}
+	return nil
+}
+
+func (m *NFTTransferAuthorization) GetTokens() []NFTRef {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *NFTTransferAuthorization) GetSpendLimit() uint64 {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return 0
+}
+
+func (m *NFTTransferAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *NFTTransferAuthorization) GetAllowedChannels() []AllowedChannel {
+	if m != nil {
+		return m.AllowedChannels
+	}
+	return nil
+}
+
+func (m *NFTTransferAuthorization) GetAllowedReceivers() []string {
+	if m != nil {
+		return m.AllowedReceivers
+	}
+	return nil
+}
+
+// NFTRef identifies an NFT by its denom ID and token ID.
+type NFTRef struct {
+	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty"`
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *NFTRef) Reset()         { *m = NFTRef{} }
+func (m *NFTRef) String() string { return proto.CompactTextString(m) }
+func (*NFTRef) ProtoMessage()    {}
+func (*NFTRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edf91dd708c63a56, []int{1}
+}
+func (m *NFTRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTRef.Merge(m, src)
+}
+func (m *NFTRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTRef proto.InternalMessageInfo
+
+func (m *NFTRef) GetDenomId() string {
+	if m != nil {
+		return m.DenomId
+	}
+	return ""
+}
+
+func (m *NFTRef) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+// AllowedChannel identifies a source port and channel of IBC NFT transfers.
+type AllowedChannel struct {
+	SourcePort    string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+}
+
+func (m *AllowedChannel) Reset()         { *m = AllowedChannel{} }
+func (m *AllowedChannel) String() string { return proto.CompactTextString(m) }
+func (*AllowedChannel) ProtoMessage()    {}
+func (*AllowedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edf91dd708c63a56, []int{2}
+}
+func (m *AllowedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedChannel.Merge(m, src)
+}
+func (m *AllowedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedChannel proto.InternalMessageInfo
+
+func (m *AllowedChannel) GetSourcePort() string {
+	if m != nil {
+		return m.SourcePort
+	}
+	return ""
+}
+
+func (m *AllowedChannel) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NFTTransferAuthorization)(nil), "chainmain.nft.v1.NFTTransferAuthorization")
+	proto.RegisterType((*NFTRef)(nil), "chainmain.nft.v1.NFTRef")
+	proto.RegisterType((*AllowedChannel)(nil), "chainmain.nft.v1.AllowedChannel")
+}
+
+func init() { proto.RegisterFile("chainmain/nft/v1/authz.proto", fileDescriptor_edf91dd708c63a56) }
+
+var fileDescriptor_edf91dd708c63a56 = []byte{
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0x6a, 0xd4, 0x40,
+	0x1c, 0xde, 0x74, 0xd7, 0xb5, 0x3b, 0xc5, 0xda, 0x0e, 0x3d, 0x4c, 0xab, 0xa4, 0x61, 0x41, 0x59,
+	0x94, 0x24, 0x6c, 0x05, 0x05, 0x0f, 0xc2, 0x56, 0x28, 0x14, 0x96, 0x62, 0xe3, 0x1e, 0xc4, 0x4b,
+	0x98, 0x4d, 0x66, 0x93, 0xc1, 0x64, 0x26, 0xcc, 0xcc, 0xae, 0xb6, 0x8f, 0xe0, 0xc9, 0x07, 0xf0,
+	0x21, 0x3c, 0xf4, 0x21, 0x8a, 0xa7, 0xe2, 0xc9, 0x93, 0xc8, 0xee, 0xc1, 0xd7, 0x90, 0xcc, 0x4c,
+	0x84, 0x55, 0xbc, 0x0c, 0xf9, 0x7d, 0xbf, 0x8f, 0x99, 0xef, 0x4f, 0xc0, 0xfd, 0x24, 0xc7, 0x94,
+	0x95, 0x98, 0xb2, 0x90, 0xcd, 0x54, 0xb8, 0x18, 0x86, 0x78, 0xae, 0xf2, 0xcb, 0xa0, 0x12, 0x5c,
+	0x71, 0xb8, 0xf3, 0x67, 0x1b, 0xb0, 0x99, 0x0a, 0x16, 0xc3, 0x83, 0x5d, 0x5c, 0x52, 0xc6, 0x43,
+	0x7d, 0x1a, 0xd2, 0xc1, 0x7e, 0xc2, 0x65, 0xc9, 0x65, 0xac, 0xa7, 0xd0, 0x0c, 0x76, 0xb5, 0x97,
+	0xf1, 0x8c, 0x1b, 0xbc, 0xfe, 0x32, 0x68, 0xff, 0x73, 0x1b, 0xa0, 0xb3, 0x93, 0xc9, 0x44, 0x60,
+	0x26, 0x67, 0x44, 0x8c, 0xe6, 0x2a, 0xe7, 0x82, 0x5e, 0x62, 0x45, 0x39, 0x83, 0xf7, 0x40, 0x2f,
+	0x25, 0x8c, 0x97, 0x31, 0x4d, 0x25, 0x72, 0xbc, 0xf6, 0xa0, 0x17, 0x6d, 0x6a, 0xe0, 0x34, 0x95,
+	0xf0, 0x29, 0xe8, 0x2a, 0xfe, 0x8e, 0x30, 0x89, 0x36, 0xbc, 0xf6, 0x60, 0xeb, 0x08, 0x05, 0x7f,
+	0x0b, 0x0c, 0xce, 0x4e, 0x26, 0x11, 0x99, 0x1d, 0x77, 0xae, 0x7f, 0x1c, 0xb6, 0x22, 0xcb, 0x86,
+	0x87, 0x60, 0x4b, 0x56, 0x84, 0xa5, 0x71, 0x41, 0x4b, 0xaa, 0x50, 0xdb, 0x73, 0x06, 0x9d, 0x08,
+	0x68, 0x68, 0x5c, 0x23, 0xf0, 0x19, 0x00, 0xb8, 0x28, 0xf8, 0xfb, 0xb8, 0xa0, 0x52, 0xa1, 0x4e,
+	0xfd, 0xec, 0x31, 0xfa, 0x76, 0xe5, 0xef, 0x59, 0x3b, 0xa3, 0x34, 0x15, 0x44, 0xca, 0xd7, 0x4a,
+	0x50, 0x96, 0x45, 0x3d, 0xcd, 0x1d, 0x53, 0xa9, 0xe0, 0x39, 0xd8, 0xd1, 0x03, 0x49, 0xe3, 0x24,
+	0xc7, 0x8c, 0x91, 0x42, 0xa2, 0x5b, 0x5a, 0x9b, 0xf7, 0xaf, 0xb6, 0x91, 0x61, 0xbe, 0x34, 0x44,
+	0xab, 0xf1, 0x2e, 0x5e, 0x43, 0x25, 0x7c, 0x0c, 0x76, 0x9b, 0x2b, 0x05, 0x49, 0x08, 0x5d, 0x10,
+	0x21, 0x51, 0x57, 0x27, 0xd1, 0xbc, 0x15, 0x35, 0xf8, 0xf3, 0xf3, 0xaf, 0x57, 0x7e, 0xdf, 0x8a,
+	0x34, 0xcd, 0x2d, 0x86, 0x53, 0xa2, 0xf0, 0x30, 0x58, 0x8b, 0xf5, 0xe3, 0xaf, 0x2f, 0x8f, 0x1e,
+	0xae, 0x57, 0xfd, 0xbf, 0x06, 0xfa, 0x2f, 0x40, 0xd7, 0x84, 0x08, 0xf7, 0xc1, 0x66, 0xd3, 0x05,
+	0x72, 0x3c, 0x67, 0xd0, 0x8b, 0x6e, 0xdb, 0x2a, 0xea, 0x95, 0xce, 0xb6, 0x5e, 0x6d, 0x98, 0x95,
+	0x9e, 0x4f, 0xd3, 0xfe, 0x1b, 0xb0, 0xbd, 0x6e, 0x54, 0xc7, 0xcf, 0xe7, 0x22, 0x21, 0x71, 0xc5,
+	0x85, 0xb2, 0x57, 0x01, 0x03, 0xbd, 0xe2, 0x42, 0xc1, 0x07, 0x60, 0xdb, 0x12, 0x6c, 0x88, 0xf6,
+	0xce, 0x3b, 0x06, 0x6d, 0x02, 0x1b, 0x5f, 0x2f, 0x5d, 0xe7, 0x66, 0xe9, 0x3a, 0x3f, 0x97, 0xae,
+	0xf3, 0x69, 0xe5, 0xb6, 0x6e, 0x56, 0x6e, 0xeb, 0xfb, 0xca, 0x6d, 0xbd, 0x3d, 0xca, 0xa8, 0xca,
+	0xe7, 0xd3, 0x20, 0xe1, 0x65, 0x98, 0x88, 0x8b, 0x4a, 0x71, 0x9f, 0x8b, 0xcc, 0xd7, 0x8e, 0x43,
+	0x7d, 0xfa, 0xda, 0xf8, 0x07, 0x6d, 0x5d, 0x5d, 0x54, 0x44, 0x4e, 0xbb, 0xfa, 0x6f, 0x7c, 0xf2,
+	0x7b, 0x00, 0x95, 0xaf, 0x61, 0x93, 0x03, 0x03, 0x00, 0x00,
+}
+
+func (m *NFTTransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTTransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedReceivers) > 0 {
+		for iNdEx := len(m.AllowedReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedReceivers[iNdEx])
+			copy(dAtA[i:], m.AllowedReceivers[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for iNdEx := len(m.AllowedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.SpendLimit != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.SpendLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DenomIds) > 0 {
+		for iNdEx := len(m.DenomIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomIds[iNdEx])
+			copy(dAtA[i:], m.DenomIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NFTTransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomIds) > 0 {
+		for _, s := range m.DenomIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.SpendLimit != 0 {
+		n += 1 + sovAuthz(uint64(m.SpendLimit))
+	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedChannels) > 0 {
+		for _, e := range m.AllowedChannels {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedReceivers) > 0 {
+		for _, s := range m.AllowedReceivers {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NFTRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NFTTransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTTransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTTransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomIds = append(m.DenomIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, NFTRef{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			m.SpendLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedChannels = append(m.AllowedChannels, AllowedChannel{})
+			if err := m.AllowedChannels[len(m.AllowedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedReceivers = append(m.AllowedReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,144 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package types_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	nfttransfertypes "github.com/crypto-org-chain/chain-main/v8/x/nft-transfer/types"
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// counterpartyReceiver is an address of another chain, which isn't a valid local
+// bech32 address
+const counterpartyReceiver = "0x2CB3E1DDc8A3aBaE7e5C1B1C3Bdb8a1e2e30C2F5"
+
+func TestNFTTransferAuthorizationValidateBasic(t *testing.T) {
+	token := types.NFTRef{DenomId: denomID, TokenId: id}
+	channel := types.AllowedChannel{SourcePort: "nft", SourceChannel: "channel-0"}
+
+	testCases := []struct {
+		name          string
+		authorization *types.NFTTransferAuthorization
+		expPass       bool
+	}{
+		{"denom", types.NewNFTTransferAuthorization([]string{denomID}, nil, 0, nil), true},
+		{"nft with allow list", types.NewNFTTransferAuthorization(nil, []types.NFTRef{token}, 1, []sdk.AccAddress{address}), true},
+		{"nft with channel", types.NewIBCNFTTransferAuthorization(nil, []types.NFTRef{token}, 1, []types.AllowedChannel{channel}, nil), true},
+		{"channel with counterparty receiver", types.NewIBCNFTTransferAuthorization([]string{denomID}, nil, 0, []types.AllowedChannel{channel}, []string{counterpartyReceiver}), true},
+		{"ibc denom", types.NewNFTTransferAuthorization([]string{"ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2"}, nil, 0, nil), true},
+		{"nothing allowed", types.NewNFTTransferAuthorization(nil, nil, 0, nil), false},
+		{"invalid denom", types.NewNFTTransferAuthorization([]string{"1denom"}, nil, 0, nil), false},
+		{"duplicate denom", types.NewNFTTransferAuthorization([]string{denomID, denomID}, nil, 0, nil), false},
+		{"invalid token", types.NewNFTTransferAuthorization(nil, []types.NFTRef{{DenomId: denomID, TokenId: "1"}}, 0, nil), false},
+		{"duplicate nft", types.NewNFTTransferAuthorization(nil, []types.NFTRef{token, token}, 0, nil), false},
+		{"invalid allowed address", &types.NFTTransferAuthorization{DenomIds: []string{denomID}, AllowList: []string{"invalid"}}, false},
+		{"duplicate allowed address", types.NewNFTTransferAuthorization([]string{denomID}, nil, 0, []sdk.AccAddress{address, address}), false},
+		{"allow list with channel", &types.NFTTransferAuthorization{DenomIds: []string{denomID}, AllowList: []string{address.String()}, AllowedChannels: []types.AllowedChannel{channel}}, false},
+		{"receiver without channel", &types.NFTTransferAuthorization{DenomIds: []string{denomID}, AllowedReceivers: []string{counterpartyReceiver}}, false},
+		{"blank receiver", types.NewIBCNFTTransferAuthorization([]string{denomID}, nil, 0, []types.AllowedChannel{channel}, []string{" "}), false},
+		{"duplicate receiver", types.NewIBCNFTTransferAuthorization([]string{denomID}, nil, 0, []types.AllowedChannel{channel}, []string{counterpartyReceiver, counterpartyReceiver}), false},
+		{"invalid channel", types.NewIBCNFTTransferAuthorization([]string{denomID}, nil, 0, []types.AllowedChannel{{SourcePort: "nft"}}, nil), false},
+		{"duplicate channel", types.NewIBCNFTTransferAuthorization([]string{denomID}, nil, 0, []types.AllowedChannel{channel, channel}, nil), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authorization.ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNFTTransferAuthorizationAccept(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+	address3 := CreateTestAddrs(3)[2]
+
+	authorization := types.NewNFTTransferAuthorization(
+		[]string{denomID}, []types.NFTRef{{DenomId: "other", TokenId: id}}, 2, []sdk.AccAddress{address2},
+	)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgTransferNFT{}), authorization.MsgTypeURL())
+
+	// NFTs of other denoms and other recipients are rejected
+	_, err := authorization.Accept(ctx, types.NewMsgTransferNFT("id2", "other", address.String(), address2.String()))
+	require.Error(t, err)
+	_, err = authorization.Accept(ctx, types.NewMsgTransferNFT(id, denomID, address.String(), address3.String()))
+	require.Error(t, err)
+
+	// the spend limit is decremented, and the authorization deleted when exhausted
+	res, err := authorization.Accept(ctx, types.NewMsgTransferNFT(id, "other", address.String(), address2.String()))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, uint64(1), res.Updated.(*types.NFTTransferAuthorization).SpendLimit)
+
+	res, err = res.Updated.Accept(ctx, types.NewMsgTransferNFT("id2", denomID, address.String(), address2.String()))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	// batch transfers are not covered by the grant
+	_, err = authorization.Accept(ctx, types.NewMsgBatchTransferNFT(address.String(), []types.TransferNFTItem{
+		{Id: id, DenomId: denomID, Recipient: address2.String()},
+	}))
+	require.ErrorIs(t, err, errortypes.ErrInvalidType)
+
+	// IBC transfers are only accepted when allowed channels are set
+	transfer := nfttransfertypes.NewMsgTransfer(
+		nfttransfertypes.PortID, "channel-0", denomID, []string{id, "id2"}, address.String(), counterpartyReceiver, clienttypes.ZeroHeight(), 1, "",
+	)
+	_, err = authorization.Accept(ctx, transfer)
+	require.Error(t, err)
+
+	authorization = types.NewIBCNFTTransferAuthorization(
+		[]string{denomID}, nil, 2,
+		[]types.AllowedChannel{{SourcePort: nfttransfertypes.PortID, SourceChannel: "channel-0"}},
+		[]string{counterpartyReceiver},
+	)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(transfer), authorization.MsgTypeURL())
+
+	_, err = authorization.Accept(ctx, types.NewMsgTransferNFT(id, denomID, address.String(), address2.String()))
+	require.Error(t, err)
+
+	// only the allowed counterparty receivers are accepted
+	transfer.Receiver = address2.String()
+	_, err = authorization.Accept(ctx, transfer)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+
+	transfer.Receiver = counterpartyReceiver
+	res, err = authorization.Accept(ctx, transfer)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	transfer.SourceChannel = "channel-1"
+	_, err = authorization.Accept(ctx, transfer)
+	require.Error(t, err)
+
+	// more NFTs than the spend limit are rejected
+	transfer.SourceChannel = "channel-0"
+	authorization.SpendLimit = 1
+	_, err = authorization.Accept(ctx, transfer)
+	require.Error(t, err)
+
+	// a zero spend limit is unlimited
+	authorization.SpendLimit = 0
+	res, err = authorization.Accept(ctx, transfer)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+}
@@ -5,7 +5,7 @@ package types
 // DONTCOVER
 
 import (
-	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/crypto-org-chain/chain-main/v8/x/nft/exported"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +13,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 var ModuleCdc = codec.NewLegacyAmino()
@@ -31,6 +32,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditNFT{}, "chainmain/nft/MsgEditNFT", nil)
 	cdc.RegisterConcrete(&MsgMintNFT{}, "chainmain/nft/MsgMintNFT", nil)
 	cdc.RegisterConcrete(&MsgBurnNFT{}, "chainmain/nft/MsgBurnNFT", nil)
+	cdc.RegisterConcrete(&MsgBatchMintNFT{}, "chainmain/nft/MsgBatchMintNFT", nil)
+	cdc.RegisterConcrete(&MsgBatchTransferNFT{}, "chainmain/nft/MsgBatchTransferNFT", nil)
+	cdc.RegisterConcrete(&MsgBatchBurnNFT{}, "chainmain/nft/MsgBatchBurnNFT", nil)
+
+	cdc.RegisterConcrete(&NFTTransferAuthorization{}, "chainmain/nft/NFTTransferAuthorization", nil)
 
 	cdc.RegisterInterface((*exported.NFT)(nil), nil)
 	cdc.RegisterConcrete(&BaseNFT{}, "chainmain/nft/BaseNFT", nil)
@@ -43,37 +49,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditNFT{},
 		&MsgMintNFT{},
 		&MsgBurnNFT{},
+		&MsgBatchMintNFT{},
+		&MsgBatchTransferNFT{},
+		&MsgBatchBurnNFT{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&NFTTransferAuthorization{},
 	)
 
 	registry.RegisterImplementations((*exported.NFT)(nil),
 		&BaseNFT{},
 	)
 
-	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
-}
-
-// MustMarshalSupply return supply protobuf code
-func MustMarshalSupply(cdc codec.Codec, supply uint64) []byte {
-	supplyWrap := gogotypes.UInt64Value{Value: supply}
-	return cdc.MustMarshal(&supplyWrap)
-}
-
-// MustUnMarshalSupply return the supply
-func MustUnMarshalSupply(cdc codec.Codec, value []byte) uint64 {
-	var supplyWrap gogotypes.UInt64Value
-	cdc.MustUnmarshal(value, &supplyWrap)
-	return supplyWrap.Value
-}
-
-// MustMarshalTokenID return the tokenID protobuf code
-func MustMarshalTokenID(cdc codec.Codec, tokenID string) []byte {
-	tokenIDWrap := gogotypes.StringValue{Value: tokenID}
-	return cdc.MustMarshal(&tokenIDWrap)
-}
+	// denoms are packed into the class data served by the SDK x/nft adapter
+	registry.RegisterImplementations((*proto.Message)(nil),
+		&Denom{},
+	)
 
-// MustUnMarshalTokenID return the tokenID
-func MustUnMarshalTokenID(cdc codec.Codec, value []byte) string {
-	var tokenIDWrap gogotypes.StringValue
-	cdc.MustUnmarshal(value, &tokenIDWrap)
-	return tokenIDWrap.Value
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -18,4 +18,7 @@ var (
 	ErrInvalidTokenID    = sdkerrors.Register(ModuleNameAlias, 10, "invalid nft id")
 	ErrInvalidTokenURI   = sdkerrors.Register(ModuleNameAlias, 11, "invalid nft uri")
 	ErrInvalidDenomName  = sdkerrors.Register(ModuleNameAlias, 12, "invalid denom name")
+	ErrInvalidBatch      = sdkerrors.Register(ModuleNameAlias, 13, "invalid nft batch")
+	ErrInvalidSchema     = sdkerrors.Register(ModuleNameAlias, 14, "invalid denom schema")
+	ErrInvalidTokenData  = sdkerrors.Register(ModuleNameAlias, 15, "invalid nft data")
 )
@@ -2,7 +2,15 @@
 // Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
 package types
 
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
 // NFT module event types
+//
+// Deprecated: the legacy events are emitted alongside the typed events of event.proto
+// during a deprecation window, indexers should rely on the typed events.
 var (
 	EventTypeIssueDenom = "issue_denom"
 	EventTypeTransfer   = "transfer_nft"
@@ -21,3 +29,13 @@ var (
 	AttributeKeyDenomID   = "denom_id"
 	AttributeKeyDenomName = "denom_name"
 )
+
+// URIHash returns the hex encoded SHA-256 hash of a denom or token URI carried by the
+// typed events, or an empty string if the URI is empty.
+func URIHash(uri string) string {
+	if len(uri) == 0 {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(uri))
+	return hex.EncodeToString(hash[:])
+}
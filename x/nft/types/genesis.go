@@ -4,6 +4,7 @@ package types
 
 import (
 	newsdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -23,6 +24,14 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 
+		var schema *Schema
+		if c.Denom.EnforceSchema {
+			var err error
+			if schema, err = ParseSchema(c.Denom.Schema); err != nil {
+				return err
+			}
+		}
+
 		for _, nft := range c.NFTs {
 			if nft.GetOwner().Empty() {
 				return newsdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner")
@@ -35,6 +44,12 @@ func ValidateGenesis(data GenesisState) error {
 			if err := ValidateTokenURI(nft.GetURI()); err != nil {
 				return err
 			}
+
+			if schema != nil {
+				if err := schema.ValidateData(storetypes.NewInfiniteGasMeter(), nft.GetData()); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
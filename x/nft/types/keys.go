@@ -2,12 +2,7 @@
 // Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
 package types
 
-import (
-	"bytes"
-	"errors"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
-)
+import "cosmossdk.io/collections"
 
 const (
 	// ModuleName is the name of the module
@@ -26,104 +21,11 @@ const (
 	RouterKey = ModuleName
 )
 
+// Prefixes 0x01-0x05 were used by the legacy `/`-delimited layout, see migrations/v2.
 var (
-	PrefixNFT        = []byte{0x01}
-	PrefixOwners     = []byte{0x02} // key for a owner
-	PrefixCollection = []byte{0x03} // key for balance of NFTs held by the denom
-	PrefixDenom      = []byte{0x04} // key for denom of the nft
-	PrefixDenomName  = []byte{0x05} // key for denom name of the nft
-
-	delimiter = []byte("/")
+	DenomsKey       = collections.NewPrefix(6)  // denom ID -> Denom
+	DenomsByNameKey = collections.NewPrefix(7)  // denom name -> denom ID
+	NFTsKey         = collections.NewPrefix(8)  // (denom ID, token ID) -> BaseNFT
+	NFTsByOwnerKey  = collections.NewPrefix(9)  // (owner, denom ID, token ID)
+	SupplyKey       = collections.NewPrefix(10) // denom ID -> number of NFTs
 )
-
-// SplitKeyOwner return the address,denom,id from the key of stored owner
-func SplitKeyOwner(key []byte) (address sdk.AccAddress, denomID, tokenID string, err error) {
-	key = key[len(PrefixOwners)+len(delimiter):]
-	keys := bytes.SplitN(key, delimiter, 2)
-	if len(keys) < 2 {
-		return address, denomID, tokenID, errors.New("wrong KeyOwner")
-	}
-
-	address, err = sdk.AccAddressFromBech32(string(keys[0]))
-	if err != nil {
-		return address, denomID, tokenID, err
-	}
-
-	denomID, tokenID, err = SplitKeyDenom(keys[1])
-
-	return address, denomID, tokenID, err
-}
-
-func SplitKeyDenom(key []byte) (denomID, tokenID string, err error) {
-	if bytes.HasPrefix(key, []byte(IBCPrefix)) {
-		// IBC denom: ibc/hash/tokenID (take remainder after second delimiter as tokenID)
-		rest := key[len(IBCPrefix):]
-		idx := bytes.Index(rest, delimiter)
-		if idx < 0 || idx != IBCDenomLen-len(IBCPrefix) {
-			return denomID, tokenID, errors.New("wrong KeyDenom")
-		}
-		denomID = IBCPrefix + string(rest[:idx])
-		tokenID = string(rest[idx+len(delimiter):])
-	} else {
-		// Standard denom: denom/tokenID (take remainder after first delimiter as tokenID)
-		idx := bytes.Index(key, delimiter)
-		if idx < 0 {
-			return denomID, tokenID, errors.New("wrong KeyDenom")
-		}
-		denomID = string(key[:idx])
-		tokenID = string(key[idx+len(delimiter):])
-	}
-
-	return denomID, tokenID, nil
-}
-
-// KeyOwner gets the key of a collection owned by an account address
-func KeyOwner(address sdk.AccAddress, denomID, tokenID string) []byte {
-	key := append(PrefixOwners, delimiter...)
-	if address != nil {
-		key = append(key, []byte(address.String())...)
-		key = append(key, delimiter...)
-	}
-
-	if address != nil && len(denomID) > 0 {
-		key = append(key, []byte(denomID)...)
-		key = append(key, delimiter...)
-	}
-
-	if address != nil && len(denomID) > 0 && len(tokenID) > 0 {
-		key = append(key, []byte(tokenID)...)
-	}
-	return key
-}
-
-// KeyNFT gets the key of nft stored by an denom and id
-func KeyNFT(denomID, tokenID string) []byte {
-	key := append(PrefixNFT, delimiter...)
-	if len(denomID) > 0 {
-		key = append(key, []byte(denomID)...)
-		key = append(key, delimiter...)
-	}
-
-	if len(denomID) > 0 && len(tokenID) > 0 {
-		key = append(key, []byte(tokenID)...)
-	}
-	return key
-}
-
-// KeyCollection gets the storeKey by the collection
-func KeyCollection(denomID string) []byte {
-	key := append(PrefixCollection, delimiter...)
-	return append(key, []byte(denomID)...)
-}
-
-// KeyDenomID gets the storeKey by the denom id
-func KeyDenomID(id string) []byte {
-	key := append(PrefixDenom, delimiter...)
-	return append(key, []byte(id)...)
-}
-
-// KeyDenomName gets the storeKey by the denom name
-func KeyDenomName(name string) []byte {
-	key := append(PrefixDenomName, delimiter...)
-	return append(key, []byte(name)...)
-}
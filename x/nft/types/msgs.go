@@ -16,6 +16,10 @@ const (
 	TypeMsgEditNFT     = "edit_nft"
 	TypeMsgMintNFT     = "mint_nft"
 	TypeMsgBurnNFT     = "burn_nft"
+
+	TypeMsgBatchMintNFT     = "batch_mint_nft"
+	TypeMsgBatchTransferNFT = "batch_transfer_nft"
+	TypeMsgBatchBurnNFT     = "batch_burn_nft"
 )
 
 var (
@@ -24,6 +28,10 @@ var (
 	_ sdk.Msg = &MsgEditNFT{}
 	_ sdk.Msg = &MsgMintNFT{}
 	_ sdk.Msg = &MsgBurnNFT{}
+
+	_ sdk.Msg = &MsgBatchMintNFT{}
+	_ sdk.Msg = &MsgBatchTransferNFT{}
+	_ sdk.Msg = &MsgBatchBurnNFT{}
 )
 
 // NewMsgIssueDenom is a constructor function for MsgSetName
@@ -52,6 +60,12 @@ func (msg MsgIssueDenom) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
 		return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
 	}
+
+	if msg.EnforceSchema {
+		if _, err := ParseSchema(msg.Schema); err != nil {
+			return err
+		}
+	}
 	return ValidateDenomName(msg.Name)
 }
 
@@ -233,3 +247,163 @@ func (msg MsgBurnNFT) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgBatchMintNFT is a constructor function for MsgBatchMintNFT
+func NewMsgBatchMintNFT(sender string, items []MintNFTItem) *MsgBatchMintNFT {
+	return &MsgBatchMintNFT{
+		Sender: sender,
+		Items:  items,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBatchMintNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBatchMintNFT) Type() string { return TypeMsgBatchMintNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBatchMintNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateBatchSize(len(msg.Items)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(msg.Items))
+	for _, item := range msg.Items {
+		if _, err := sdk.AccAddressFromBech32(item.Recipient); err != nil {
+			return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receipt address (%s)", err)
+		}
+		if err := ValidateDenomID(item.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateTokenURI(item.URI); err != nil {
+			return err
+		}
+		if err := ValidateTokenID(item.Id); err != nil {
+			return err
+		}
+		if err := checkBatchDuplicate(seen, item.DenomId, item.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBatchMintNFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgBatchTransferNFT is a constructor function for MsgBatchTransferNFT
+func NewMsgBatchTransferNFT(sender string, items []TransferNFTItem) *MsgBatchTransferNFT {
+	return &MsgBatchTransferNFT{
+		Sender: sender,
+		Items:  items,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBatchTransferNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBatchTransferNFT) Type() string { return TypeMsgBatchTransferNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBatchTransferNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateBatchSize(len(msg.Items)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(msg.Items))
+	for _, item := range msg.Items {
+		if _, err := sdk.AccAddressFromBech32(item.Recipient); err != nil {
+			return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+		if err := ValidateDenomID(item.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateTokenID(item.Id); err != nil {
+			return err
+		}
+		if err := checkBatchDuplicate(seen, item.DenomId, item.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBatchTransferNFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgBatchBurnNFT is a constructor function for MsgBatchBurnNFT
+func NewMsgBatchBurnNFT(sender string, items []BurnNFTItem) *MsgBatchBurnNFT {
+	return &MsgBatchBurnNFT{
+		Sender: sender,
+		Items:  items,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgBatchBurnNFT) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBatchBurnNFT) Type() string { return TypeMsgBatchBurnNFT }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBatchBurnNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return newsdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := ValidateBatchSize(len(msg.Items)); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(msg.Items))
+	for _, item := range msg.Items {
+		if err := ValidateDenomID(item.DenomId); err != nil {
+			return err
+		}
+		if err := ValidateTokenID(item.Id); err != nil {
+			return err
+		}
+		if err := checkBatchDuplicate(seen, item.DenomId, item.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg MsgBatchBurnNFT) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// checkBatchDuplicate rejects a batch that references the same NFT more than once
+func checkBatchDuplicate(seen map[string]struct{}, denomID, tokenID string) error {
+	key := denomID + "/" + tokenID
+	if _, ok := seen[key]; ok {
+		return newsdkerrors.Wrapf(ErrInvalidBatch, "duplicate nft %s in batch", key)
+	}
+	seen[key] = struct{}{}
+	return nil
+}
@@ -104,3 +104,95 @@ func TestMsgBurnNFTGetSignersMethod(t *testing.T) {
 	require.Equal(t, 1, len(signers))
 	require.Equal(t, address.String(), signers[0].String())
 }
+
+func TestMsgBatchMintNFTValidateBasicMethod(t *testing.T) {
+	item := types.MintNFTItem{Id: id, DenomId: denom, Name: nftName, URI: tokenURI, Data: tokenData, Recipient: address2.String()}
+
+	newMsgBatchMintNFT := types.NewMsgBatchMintNFT("", []types.MintNFTItem{item})
+	err := newMsgBatchMintNFT.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgBatchMintNFT = types.NewMsgBatchMintNFT(address.String(), nil)
+	err = newMsgBatchMintNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	newMsgBatchMintNFT = types.NewMsgBatchMintNFT(address.String(), make([]types.MintNFTItem, types.MaxBatchSize+1))
+	err = newMsgBatchMintNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	newMsgBatchMintNFT = types.NewMsgBatchMintNFT(address.String(), []types.MintNFTItem{item, item})
+	err = newMsgBatchMintNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	invalid := item
+	invalid.Recipient = ""
+	newMsgBatchMintNFT = types.NewMsgBatchMintNFT(address.String(), []types.MintNFTItem{item, invalid})
+	err = newMsgBatchMintNFT.ValidateBasic()
+	require.Error(t, err)
+
+	other := item
+	other.DenomId = "denom2"
+	newMsgBatchMintNFT = types.NewMsgBatchMintNFT(address.String(), []types.MintNFTItem{item, other})
+	err = newMsgBatchMintNFT.ValidateBasic()
+	require.NoError(t, err)
+}
+
+func TestMsgBatchTransferNFTValidateBasicMethod(t *testing.T) {
+	item := types.TransferNFTItem{Id: id, DenomId: denom, Recipient: address2.String()}
+
+	newMsgBatchTransferNFT := types.NewMsgBatchTransferNFT("", []types.TransferNFTItem{item})
+	err := newMsgBatchTransferNFT.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgBatchTransferNFT = types.NewMsgBatchTransferNFT(address.String(), nil)
+	err = newMsgBatchTransferNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	newMsgBatchTransferNFT = types.NewMsgBatchTransferNFT(address.String(), []types.TransferNFTItem{item, item})
+	err = newMsgBatchTransferNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	invalid := item
+	invalid.Id = ""
+	newMsgBatchTransferNFT = types.NewMsgBatchTransferNFT(address.String(), []types.TransferNFTItem{invalid})
+	err = newMsgBatchTransferNFT.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgBatchTransferNFT = types.NewMsgBatchTransferNFT(address.String(), []types.TransferNFTItem{item})
+	err = newMsgBatchTransferNFT.ValidateBasic()
+	require.NoError(t, err)
+}
+
+func TestMsgBatchBurnNFTValidateBasicMethod(t *testing.T) {
+	item := types.BurnNFTItem{Id: id, DenomId: denom}
+
+	newMsgBatchBurnNFT := types.NewMsgBatchBurnNFT("", []types.BurnNFTItem{item})
+	err := newMsgBatchBurnNFT.ValidateBasic()
+	require.Error(t, err)
+
+	newMsgBatchBurnNFT = types.NewMsgBatchBurnNFT(address.String(), nil)
+	err = newMsgBatchBurnNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	newMsgBatchBurnNFT = types.NewMsgBatchBurnNFT(address.String(), []types.BurnNFTItem{item, item})
+	err = newMsgBatchBurnNFT.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	newMsgBatchBurnNFT = types.NewMsgBatchBurnNFT(address.String(), []types.BurnNFTItem{item})
+	err = newMsgBatchBurnNFT.ValidateBasic()
+	require.NoError(t, err)
+}
+
+func TestMsgIssueDenomValidateBasicMethod(t *testing.T) {
+	newMsgIssueDenom := types.NewMsgIssueDenom(denomID, denom, "{a:a,b:b}", "", address.String())
+	err := newMsgIssueDenom.ValidateBasic()
+	require.NoError(t, err)
+
+	newMsgIssueDenom.EnforceSchema = true
+	err = newMsgIssueDenom.ValidateBasic()
+	require.ErrorIs(t, err, types.ErrInvalidSchema)
+
+	newMsgIssueDenom.Schema = `{"type": "object", "required": ["a"]}`
+	err = newMsgIssueDenom.ValidateBasic()
+	require.NoError(t, err)
+}
@@ -72,6 +72,9 @@ type Denom struct {
 	Schema  string `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
 	Creator string `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
 	Uri     string `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`
+	// needed for nft transfers
+	// enforce_schema makes mints and edits validate the NFT data as JSON against schema
+	EnforceSchema bool `protobuf:"varint,6,opt,name=enforce_schema,json=enforceSchema,proto3" json:"enforce_schema,omitempty"`
 }
 
 func (m *Denom) Reset()         { *m = Denom{} }
@@ -235,38 +238,40 @@ func init() {
 func init() { proto.RegisterFile("chainmain/nft/v1/nft.proto", fileDescriptor_966228980714306f) }
 
 var fileDescriptor_966228980714306f = []byte{
-	// 493 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xb1, 0x6e, 0xdb, 0x30,
-	0x10, 0x86, 0x25, 0x5b, 0x8a, 0x63, 0x3a, 0x49, 0x0d, 0xd6, 0x68, 0x95, 0x0c, 0x52, 0x20, 0x74,
-	0xc8, 0x62, 0x09, 0x76, 0xb6, 0x74, 0x53, 0x83, 0x00, 0x5e, 0xd2, 0x42, 0x48, 0x97, 0x2e, 0x06,
-	0x23, 0x32, 0x36, 0x11, 0x4b, 0x34, 0x44, 0x3a, 0x89, 0xf7, 0x3e, 0x40, 0x87, 0x3e, 0x40, 0x1f,
-	0xc7, 0x63, 0xc6, 0x4e, 0x42, 0x2b, 0x2f, 0x9d, 0xfd, 0x04, 0x05, 0x49, 0xc9, 0x30, 0x9a, 0xa5,
-	0x8b, 0x7d, 0xf7, 0xdf, 0x1d, 0xfe, 0xef, 0x44, 0x12, 0x9c, 0x24, 0x53, 0x44, 0xb3, 0x14, 0xd1,
-	0x2c, 0xcc, 0xee, 0x44, 0xf8, 0x30, 0x90, 0x7f, 0xc1, 0x3c, 0x67, 0x82, 0xc1, 0xee, 0xb6, 0x16,
-	0x48, 0xf1, 0x61, 0x70, 0xd2, 0x9b, 0xb0, 0x09, 0x53, 0xc5, 0x50, 0x46, 0xba, 0xcf, 0x7f, 0x02,
-	0xad, 0x08, 0x71, 0x72, 0x7d, 0x75, 0x03, 0x8f, 0x40, 0x83, 0x62, 0xc7, 0x3c, 0x35, 0xcf, 0xda,
-	0x71, 0x83, 0x62, 0x08, 0x81, 0x95, 0xa1, 0x94, 0x38, 0x0d, 0xa5, 0xa8, 0x18, 0x1e, 0x83, 0xe6,
-	0x22, 0xa7, 0x4e, 0x53, 0x4a, 0x51, 0xab, 0x2c, 0xbc, 0xe6, 0xe7, 0x78, 0x14, 0x4b, 0x4d, 0xb6,
-	0x63, 0x24, 0x90, 0x63, 0xe9, 0x76, 0x19, 0xc3, 0x1e, 0xb0, 0xd9, 0x63, 0x46, 0x72, 0xc7, 0x56,
-	0xa2, 0x4e, 0x2e, 0xac, 0x3f, 0x3f, 0x3c, 0xd3, 0x5f, 0x00, 0xfb, 0x92, 0x64, 0x2c, 0xfd, 0x2f,
-	0xdf, 0x37, 0x60, 0x8f, 0x27, 0x53, 0x92, 0x22, 0x6d, 0x1d, 0x57, 0x19, 0x74, 0x40, 0x2b, 0xc9,
-	0x09, 0x12, 0x2c, 0xaf, 0x7c, 0xeb, 0x14, 0x76, 0x35, 0xa9, 0x36, 0x96, 0x61, 0x65, 0xfb, 0x08,
-	0x0e, 0x46, 0x97, 0x1f, 0xd8, 0x6c, 0x46, 0x12, 0x41, 0x59, 0x06, 0x03, 0xb0, 0x8f, 0x25, 0xc6,
-	0xb8, 0x66, 0x88, 0x5e, 0x6f, 0x0a, 0xef, 0xd5, 0x12, 0xa5, 0xb3, 0x0b, 0xbf, 0xae, 0xf8, 0x71,
-	0x4b, 0x85, 0x23, 0x0c, 0x07, 0xa0, 0x2d, 0xd8, 0x3d, 0xc9, 0xc6, 0x14, 0x73, 0xa7, 0x71, 0xda,
-	0x3c, 0x6b, 0x47, 0xbd, 0x4d, 0xe1, 0x75, 0xf5, 0xc0, 0xb6, 0xe4, 0xc7, 0xfb, 0x2a, 0x1e, 0x61,
-	0x5e, 0x19, 0x7f, 0x37, 0x81, 0xfd, 0x51, 0xee, 0x2f, 0xa1, 0x11, 0xc6, 0x39, 0xe1, 0xbc, 0xda,
-	0xba, 0x4e, 0xe1, 0x3d, 0x38, 0xa2, 0x78, 0x9c, 0x6c, 0xe9, 0xb4, 0x43, 0x67, 0xe8, 0x06, 0xff,
-	0x1e, 0x67, 0xb0, 0xbb, 0x44, 0xf4, 0x6e, 0x55, 0x78, 0x46, 0x59, 0x78, 0x87, 0xbb, 0x2a, 0xdf,
-	0x14, 0x5e, 0x47, 0x63, 0x51, 0x9c, 0x70, 0x3f, 0x3e, 0xa4, 0x78, 0xa7, 0x5a, 0x61, 0x7d, 0x35,
-	0x01, 0xd8, 0xf9, 0x1c, 0xe7, 0xc0, 0x56, 0x9b, 0x2a, 0xb2, 0xce, 0xf0, 0xed, 0x4b, 0x63, 0x75,
-	0x68, 0x91, 0x25, 0x1d, 0x63, 0xdd, 0x0b, 0xdf, 0x03, 0x2b, 0xbb, 0x13, 0x35, 0xec, 0xf1, 0xcb,
-	0x99, 0xea, 0x8a, 0x45, 0x07, 0x15, 0xa7, 0x75, 0x7d, 0x75, 0xc3, 0x63, 0x35, 0xa4, 0x31, 0xa2,
-	0x4f, 0xab, 0xdf, 0xae, 0xb1, 0x2a, 0x5d, 0xf3, 0xb9, 0x74, 0xcd, 0x5f, 0xa5, 0x6b, 0x7e, 0x5b,
-	0xbb, 0xc6, 0xf3, 0xda, 0x35, 0x7e, 0xae, 0x5d, 0xe3, 0xcb, 0x70, 0x42, 0xc5, 0x74, 0x71, 0x1b,
-	0x24, 0x2c, 0x0d, 0x93, 0x7c, 0x39, 0x17, 0xac, 0xcf, 0xf2, 0x49, 0x5f, 0xf9, 0x84, 0xea, 0xb7,
-	0xaf, 0x9e, 0xc1, 0x93, 0x7a, 0x08, 0x62, 0x39, 0x27, 0xfc, 0x76, 0x4f, 0x5d, 0xf0, 0xf3, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x19, 0xf0, 0x08, 0xb1, 0x26, 0x03, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3d, 0x6f, 0xda, 0x40,
+	0x18, 0xc7, 0x31, 0x98, 0xb7, 0x23, 0x50, 0x74, 0x45, 0xad, 0x93, 0xc1, 0x46, 0x56, 0x2b, 0xb1,
+	0x60, 0x04, 0xd9, 0xd2, 0xcd, 0x8d, 0x22, 0xb1, 0xa4, 0x95, 0x9b, 0x2e, 0x5d, 0x90, 0xe3, 0x3b,
+	0xe0, 0x14, 0xec, 0x43, 0xbe, 0xcb, 0x0b, 0x7b, 0x3f, 0x40, 0x87, 0x8e, 0x1d, 0xfa, 0x71, 0x18,
+	0x33, 0x76, 0xb2, 0x5a, 0x58, 0x3a, 0xf3, 0x09, 0xaa, 0x7b, 0x31, 0x45, 0xcd, 0xd2, 0xc5, 0x7e,
+	0x9e, 0xff, 0xf3, 0x9c, 0xff, 0xbf, 0xbb, 0xf3, 0x03, 0x4e, 0xa2, 0x79, 0x48, 0x92, 0x38, 0x24,
+	0xc9, 0x20, 0x99, 0xf2, 0xc1, 0xdd, 0x50, 0xbc, 0xbc, 0x65, 0x4a, 0x39, 0x85, 0xed, 0x7d, 0xcd,
+	0x13, 0xe2, 0xdd, 0xf0, 0xa4, 0x33, 0xa3, 0x33, 0x2a, 0x8b, 0x03, 0x11, 0xa9, 0x3e, 0xf7, 0x01,
+	0x54, 0xfd, 0x90, 0xe1, 0xcb, 0x8b, 0x2b, 0xd8, 0x02, 0x45, 0x82, 0x2c, 0xa3, 0x6b, 0xf4, 0xea,
+	0x41, 0x91, 0x20, 0x08, 0x81, 0x99, 0x84, 0x31, 0xb6, 0x8a, 0x52, 0x91, 0x31, 0x3c, 0x06, 0xa5,
+	0xdb, 0x94, 0x58, 0x25, 0x21, 0xf9, 0xd5, 0x4d, 0xe6, 0x94, 0x3e, 0x06, 0xe3, 0x40, 0x68, 0xa2,
+	0x1d, 0x85, 0x3c, 0xb4, 0x4c, 0xd5, 0x2e, 0x62, 0xd8, 0x01, 0x65, 0x7a, 0x9f, 0xe0, 0xd4, 0x2a,
+	0x4b, 0x51, 0x25, 0x67, 0xe6, 0xef, 0xef, 0x8e, 0xe1, 0x7e, 0x33, 0x40, 0xf9, 0x1c, 0x27, 0x34,
+	0xfe, 0x2f, 0xe3, 0x17, 0xa0, 0xc2, 0xa2, 0x39, 0x8e, 0x43, 0xe5, 0x1d, 0xe8, 0x0c, 0x5a, 0xa0,
+	0x1a, 0xa5, 0x38, 0xe4, 0x34, 0xd5, 0xc6, 0x79, 0x0a, 0xdb, 0x0a, 0x55, 0x39, 0x4b, 0xc2, 0xd7,
+	0xa0, 0x85, 0x93, 0x29, 0x4d, 0x23, 0x3c, 0xd1, 0xdf, 0xaa, 0x74, 0x8d, 0x5e, 0x2d, 0x68, 0x6a,
+	0xf5, 0x83, 0x14, 0x35, 0xde, 0x3d, 0x38, 0x1a, 0x9f, 0xbf, 0xa5, 0x8b, 0x05, 0x8e, 0x38, 0xa1,
+	0x09, 0xf4, 0x40, 0x0d, 0x09, 0xda, 0x49, 0x8e, 0xea, 0x3f, 0xdf, 0x65, 0xce, 0xb3, 0x55, 0x18,
+	0x2f, 0xce, 0xdc, 0xbc, 0xe2, 0x06, 0x55, 0x19, 0x8e, 0x11, 0x1c, 0x82, 0x3a, 0xa7, 0x37, 0x38,
+	0x99, 0x10, 0xc4, 0xac, 0x62, 0xb7, 0xd4, 0xab, 0xfb, 0x9d, 0x5d, 0xe6, 0xb4, 0xd5, 0x82, 0x7d,
+	0xc9, 0x0d, 0x6a, 0x32, 0x1e, 0x23, 0xa6, 0x8d, 0xbf, 0x1a, 0xa0, 0xfc, 0x4e, 0x9c, 0x93, 0xd8,
+	0x5b, 0x88, 0x50, 0x8a, 0x19, 0xd3, 0x87, 0x93, 0xa7, 0xf0, 0x06, 0xb4, 0x08, 0x9a, 0x44, 0x7b,
+	0x3a, 0xe5, 0xd0, 0x18, 0xd9, 0xde, 0xbf, 0xd7, 0xee, 0x1d, 0x6e, 0xc2, 0x7f, 0xb5, 0xce, 0x9c,
+	0xc2, 0x26, 0x73, 0x9a, 0x87, 0x2a, 0xdb, 0x65, 0x4e, 0x43, 0x61, 0x11, 0x14, 0x31, 0x37, 0x68,
+	0x12, 0x74, 0x50, 0xd5, 0x58, 0x9f, 0x0d, 0x00, 0xfe, 0xaa, 0xf0, 0x14, 0x94, 0xe5, 0x4e, 0x25,
+	0x59, 0x63, 0xf4, 0xf2, 0xa9, 0xb1, 0xbc, 0x5b, 0xdf, 0x14, 0x8e, 0x81, 0xea, 0x85, 0x6f, 0x80,
+	0x99, 0x4c, 0x79, 0x0e, 0x7b, 0xfc, 0x74, 0x8d, 0xfe, 0x15, 0xfd, 0x23, 0xcd, 0x69, 0x5e, 0x5e,
+	0x5c, 0xb1, 0x40, 0x2e, 0x52, 0x18, 0xfe, 0xfb, 0xf5, 0x2f, 0xbb, 0xb0, 0xde, 0xd8, 0xc6, 0xe3,
+	0xc6, 0x36, 0x7e, 0x6e, 0x6c, 0xe3, 0xcb, 0xd6, 0x2e, 0x3c, 0x6e, 0xed, 0xc2, 0x8f, 0xad, 0x5d,
+	0xf8, 0x34, 0x9a, 0x11, 0x3e, 0xbf, 0xbd, 0xf6, 0x22, 0x1a, 0x0f, 0xa2, 0x74, 0xb5, 0xe4, 0xb4,
+	0x4f, 0xd3, 0x59, 0x5f, 0xfa, 0x0c, 0xe4, 0xb3, 0x2f, 0xc7, 0xe5, 0x41, 0x0e, 0x0c, 0x5f, 0x2d,
+	0x31, 0xbb, 0xae, 0xc8, 0x41, 0x38, 0xfd, 0x33, 0x00, 0x86, 0x3c, 0x21, 0xfb, 0x4e, 0x03, 0x00,
+	0x00,
 }
 
 func (this *BaseNFT) Equal(that interface{}) bool {
@@ -339,6 +344,9 @@ func (this *Denom) Equal(that interface{}) bool {
 	if this.Uri != that1.Uri {
 		return false
 	}
+	if this.EnforceSchema != that1.EnforceSchema {
+		return false
+	}
 	return true
 }
 func (this *IDCollection) Equal(that interface{}) bool {
@@ -515,6 +523,16 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnforceSchema {
+		i--
+		if m.EnforceSchema {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Uri) > 0 {
 		i -= len(m.Uri)
 		copy(dAtA[i:], m.Uri)
@@ -749,6 +767,9 @@ func (m *Denom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovNft(uint64(l))
 	}
+	if m.EnforceSchema {
+		n += 2
+	}
 	return n
 }
 
@@ -1212,6 +1233,26 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 			}
 			m.Uri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceSchema", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceSchema = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
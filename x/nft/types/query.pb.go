@@ -243,6 +243,182 @@ func (m *QueryOwnerResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOwnerNFTsRequest is the request type for the Query/OwnerNFTs RPC method
+type QueryOwnerNFTsRequest struct {
+	Owner         string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	DenomId       string `protobuf:"bytes,2,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	TokenIdPrefix string `protobuf:"bytes,3,opt,name=token_id_prefix,json=tokenIdPrefix,proto3" json:"token_id_prefix,omitempty" yaml:"token_id_prefix"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnerNFTsRequest) Reset()         { *m = QueryOwnerNFTsRequest{} }
+func (m *QueryOwnerNFTsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsRequest) ProtoMessage()    {}
+func (*QueryOwnerNFTsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b19ecfbbaf95fad, []int{4}
+}
+func (m *QueryOwnerNFTsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerNFTsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerNFTsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerNFTsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsRequest.Merge(m, src)
+}
+func (m *QueryOwnerNFTsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerNFTsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerNFTsRequest proto.InternalMessageInfo
+
+func (m *QueryOwnerNFTsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryOwnerNFTsRequest) GetDenomId() string {
+	if m != nil {
+		return m.DenomId
+	}
+	return ""
+}
+
+func (m *QueryOwnerNFTsRequest) GetTokenIdPrefix() string {
+	if m != nil {
+		return m.TokenIdPrefix
+	}
+	return ""
+}
+
+func (m *QueryOwnerNFTsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOwnerNFTsResponse is the response type for the Query/OwnerNFTs RPC method
+type QueryOwnerNFTsResponse struct {
+	NFTs       []OwnedNFT          `protobuf:"bytes,1,rep,name=nfts,proto3" json:"nfts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOwnerNFTsResponse) Reset()         { *m = QueryOwnerNFTsResponse{} }
+func (m *QueryOwnerNFTsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnerNFTsResponse) ProtoMessage()    {}
+func (*QueryOwnerNFTsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b19ecfbbaf95fad, []int{5}
+}
+func (m *QueryOwnerNFTsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnerNFTsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnerNFTsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOwnerNFTsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnerNFTsResponse.Merge(m, src)
+}
+func (m *QueryOwnerNFTsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnerNFTsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnerNFTsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOwnerNFTsResponse proto.InternalMessageInfo
+
+func (m *QueryOwnerNFTsResponse) GetNFTs() []OwnedNFT {
+	if m != nil {
+		return m.NFTs
+	}
+	return nil
+}
+
+func (m *QueryOwnerNFTsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// OwnedNFT defines an NFT together with the denom it belongs to
+type OwnedNFT struct {
+	DenomId string  `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
+	NFT     BaseNFT `protobuf:"bytes,2,opt,name=nft,proto3" json:"nft"`
+}
+
+func (m *OwnedNFT) Reset()         { *m = OwnedNFT{} }
+func (m *OwnedNFT) String() string { return proto.CompactTextString(m) }
+func (*OwnedNFT) ProtoMessage()    {}
+func (*OwnedNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b19ecfbbaf95fad, []int{6}
+}
+func (m *OwnedNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnedNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnedNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnedNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnedNFT.Merge(m, src)
+}
+func (m *OwnedNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnedNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnedNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnedNFT proto.InternalMessageInfo
+
+func (m *OwnedNFT) GetDenomId() string {
+	if m != nil {
+		return m.DenomId
+	}
+	return ""
+}
+
+func (m *OwnedNFT) GetNFT() BaseNFT {
+	if m != nil {
+		return m.NFT
+	}
+	return BaseNFT{}
+}
+
 // QueryCollectionRequest is the request type for the Query/Collection RPC method
 type QueryCollectionRequest struct {
 	DenomId string `protobuf:"bytes,1,opt,name=denom_id,json=denomId,proto3" json:"denom_id,omitempty" yaml:"denom_id"`
@@ -254,7 +430,7 @@ func (m *QueryCollectionRequest) Reset()         { *m = QueryCollectionRequest{}
 func (m *QueryCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCollectionRequest) ProtoMessage()    {}
 func (*QueryCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{4}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{7}
 }
 func (m *QueryCollectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +483,7 @@ func (m *QueryCollectionResponse) Reset()         { *m = QueryCollectionResponse
 func (m *QueryCollectionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCollectionResponse) ProtoMessage()    {}
 func (*QueryCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{5}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{8}
 }
 func (m *QueryCollectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +535,7 @@ func (m *QueryDenomRequest) Reset()         { *m = QueryDenomRequest{} }
 func (m *QueryDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomRequest) ProtoMessage()    {}
 func (*QueryDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{6}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{9}
 }
 func (m *QueryDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +580,7 @@ func (m *QueryDenomResponse) Reset()         { *m = QueryDenomResponse{} }
 func (m *QueryDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomResponse) ProtoMessage()    {}
 func (*QueryDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{7}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{10}
 }
 func (m *QueryDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,7 +625,7 @@ func (m *QueryDenomByNameRequest) Reset()         { *m = QueryDenomByNameRequest
 func (m *QueryDenomByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomByNameRequest) ProtoMessage()    {}
 func (*QueryDenomByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{8}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{11}
 }
 func (m *QueryDenomByNameRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +670,7 @@ func (m *QueryDenomByNameResponse) Reset()         { *m = QueryDenomByNameRespon
 func (m *QueryDenomByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomByNameResponse) ProtoMessage()    {}
 func (*QueryDenomByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{9}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{12}
 }
 func (m *QueryDenomByNameResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +716,7 @@ func (m *QueryDenomsRequest) Reset()         { *m = QueryDenomsRequest{} }
 func (m *QueryDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsRequest) ProtoMessage()    {}
 func (*QueryDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{10}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{13}
 }
 func (m *QueryDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,7 +762,7 @@ func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
 func (m *QueryDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsResponse) ProtoMessage()    {}
 func (*QueryDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{11}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{14}
 }
 func (m *QueryDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +815,7 @@ func (m *QueryNFTRequest) Reset()         { *m = QueryNFTRequest{} }
 func (m *QueryNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTRequest) ProtoMessage()    {}
 func (*QueryNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{12}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{15}
 }
 func (m *QueryNFTRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +867,7 @@ func (m *QueryNFTResponse) Reset()         { *m = QueryNFTResponse{} }
 func (m *QueryNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNFTResponse) ProtoMessage()    {}
 func (*QueryNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b19ecfbbaf95fad, []int{13}
+	return fileDescriptor_1b19ecfbbaf95fad, []int{16}
 }
 func (m *QueryNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +908,9 @@ func init() {
 	proto.RegisterType((*QuerySupplyResponse)(nil), "chainmain.nft.v1.QuerySupplyResponse")
 	proto.RegisterType((*QueryOwnerRequest)(nil), "chainmain.nft.v1.QueryOwnerRequest")
 	proto.RegisterType((*QueryOwnerResponse)(nil), "chainmain.nft.v1.QueryOwnerResponse")
+	proto.RegisterType((*QueryOwnerNFTsRequest)(nil), "chainmain.nft.v1.QueryOwnerNFTsRequest")
+	proto.RegisterType((*QueryOwnerNFTsResponse)(nil), "chainmain.nft.v1.QueryOwnerNFTsResponse")
+	proto.RegisterType((*OwnedNFT)(nil), "chainmain.nft.v1.OwnedNFT")
 	proto.RegisterType((*QueryCollectionRequest)(nil), "chainmain.nft.v1.QueryCollectionRequest")
 	proto.RegisterType((*QueryCollectionResponse)(nil), "chainmain.nft.v1.QueryCollectionResponse")
 	proto.RegisterType((*QueryDenomRequest)(nil), "chainmain.nft.v1.QueryDenomRequest")
@@ -747,62 +926,71 @@ func init() {
 func init() { proto.RegisterFile("chainmain/nft/v1/query.proto", fileDescriptor_1b19ecfbbaf95fad) }
 
 var fileDescriptor_1b19ecfbbaf95fad = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x6f, 0x3a, 0x45,
-	0x18, 0xc7, 0x19, 0xf8, 0x01, 0xed, 0xd4, 0xa4, 0xed, 0xf4, 0x1f, 0xae, 0x15, 0x70, 0xec, 0x1f,
-	0xa8, 0xb2, 0x1b, 0x6a, 0xbd, 0x18, 0x4f, 0xd4, 0xa0, 0x4d, 0x4c, 0xab, 0x6b, 0x4f, 0x8d, 0x89,
-	0x59, 0x60, 0xd9, 0x12, 0xd9, 0x99, 0x2d, 0xbb, 0x54, 0x49, 0x6d, 0x4c, 0xbc, 0xea, 0xa1, 0x89,
-	0xe9, 0xdd, 0x93, 0x6f, 0xc0, 0x37, 0xd1, 0x63, 0x13, 0x2f, 0x9e, 0x88, 0xa1, 0xbe, 0x82, 0xbe,
-	0x02, 0x33, 0x7f, 0xb6, 0xec, 0xb2, 0x40, 0x1b, 0xd2, 0xdb, 0xec, 0xce, 0x77, 0x9e, 0xe7, 0x33,
-	0x5f, 0x9e, 0xe7, 0x59, 0xe0, 0x66, 0xfd, 0xdc, 0x68, 0x11, 0xdb, 0x68, 0x11, 0x8d, 0x34, 0x3d,
-	0xed, 0xb2, 0xac, 0x5d, 0x74, 0xcd, 0x4e, 0x4f, 0x75, 0x3a, 0xd4, 0xa3, 0x68, 0xe9, 0x69, 0x57,
-	0x25, 0x4d, 0x4f, 0xbd, 0x2c, 0x2b, 0xab, 0x16, 0xb5, 0x28, 0xdf, 0xd4, 0xd8, 0x4a, 0xe8, 0x94,
-	0x4d, 0x8b, 0x52, 0xab, 0x6d, 0x6a, 0x86, 0xd3, 0xd2, 0x0c, 0x42, 0xa8, 0x67, 0x78, 0x2d, 0x4a,
-	0x5c, 0xb9, 0xab, 0x44, 0x72, 0xb0, 0x60, 0x62, 0x6f, 0xaf, 0x4e, 0x5d, 0x9b, 0xba, 0x5a, 0xcd,
-	0x70, 0x4d, 0x91, 0x5a, 0xbb, 0x2c, 0xd7, 0x4c, 0xcf, 0x28, 0x6b, 0x8e, 0x61, 0xb5, 0x08, 0x0f,
-	0x24, 0xb4, 0xf8, 0x0c, 0xa2, 0xaf, 0x99, 0xe2, 0x9b, 0xae, 0xe3, 0xb4, 0x7b, 0xba, 0x79, 0xd1,
-	0x35, 0x5d, 0x0f, 0xa9, 0x70, 0xae, 0x61, 0x12, 0x6a, 0x7f, 0xd7, 0x6a, 0x64, 0x40, 0x1e, 0x14,
-	0xe6, 0x2b, 0x2b, 0x8f, 0xfd, 0xdc, 0x62, 0xcf, 0xb0, 0xdb, 0x9f, 0x60, 0x7f, 0x07, 0xeb, 0x69,
-	0xbe, 0x3c, 0x6a, 0xa0, 0x55, 0x98, 0xa4, 0x3f, 0x10, 0xb3, 0x93, 0x89, 0x33, 0xb1, 0x2e, 0x1e,
-	0x70, 0x09, 0xae, 0x84, 0x62, 0xbb, 0x0e, 0x25, 0xae, 0x89, 0xd6, 0x61, 0xca, 0xb0, 0x69, 0x97,
-	0x78, 0x3c, 0xf4, 0x1b, 0x5d, 0x3e, 0xe1, 0xbf, 0x00, 0x5c, 0xe6, 0xfa, 0x13, 0x76, 0x7a, 0x56,
-	0x94, 0x9d, 0x10, 0x4a, 0x65, 0xe9, 0xb1, 0x9f, 0x7b, 0x4b, 0x88, 0x05, 0x94, 0x84, 0x43, 0x55,
-	0x08, 0x87, 0x66, 0x64, 0x12, 0x79, 0x50, 0x58, 0xd8, 0xdf, 0x51, 0x85, 0x73, 0x2a, 0x73, 0x4e,
-	0x15, 0x3f, 0x9a, 0x74, 0x4e, 0xfd, 0xca, 0xb0, 0x4c, 0xc9, 0xa4, 0x07, 0x4e, 0xe2, 0xdf, 0x80,
-	0x74, 0x50, 0x52, 0xcb, 0x4b, 0x96, 0x7c, 0x0c, 0xc0, 0x23, 0x6f, 0xa8, 0xa3, 0xbf, 0xba, 0x2a,
-	0xf4, 0x92, 0xe6, 0xf3, 0x10, 0x4d, 0x9c, 0x9f, 0xd9, 0x7d, 0x96, 0x46, 0xe4, 0x0a, 0xe1, 0xdc,
-	0x00, 0xb8, 0xce, 0x71, 0x0e, 0x69, 0xbb, 0x6d, 0xd6, 0xd9, 0xbb, 0x59, 0x9d, 0xac, 0x8e, 0x61,
-	0x9a, 0xc5, 0xa1, 0x3f, 0x00, 0xdc, 0x88, 0x20, 0x49, 0x9b, 0x3e, 0x85, 0xb0, 0xfe, 0xf4, 0x56,
-	0x7a, 0xb5, 0x19, 0xf5, 0x2a, 0x70, 0x32, 0xa0, 0x7f, 0x3d, 0xd7, 0x0e, 0x65, 0xe5, 0x7d, 0xc6,
-	0xae, 0x3e, 0xa3, 0x5f, 0xf8, 0x50, 0x16, 0x82, 0x0c, 0x32, 0x2c, 0x04, 0x2e, 0x98, 0x5c, 0x08,
-	0x42, 0x2f, 0x54, 0xf8, 0x44, 0x7a, 0xc5, 0x5f, 0x56, 0x7a, 0xc7, 0x86, 0xed, 0x7b, 0x8a, 0x0e,
-	0x20, 0x14, 0x59, 0x89, 0x61, 0x9b, 0x92, 0x68, 0xed, 0xb1, 0x9f, 0x5b, 0x0e, 0x12, 0xb1, 0x3d,
-	0xac, 0xcf, 0xf3, 0x07, 0x76, 0x18, 0x1f, 0xc1, 0x4c, 0x34, 0xe0, 0x6c, 0x6c, 0xdf, 0x06, 0x2f,
-	0xe8, 0xfa, 0x58, 0xe1, 0x32, 0x01, 0x33, 0x97, 0xc9, 0x2d, 0x90, 0xe3, 0xc2, 0x0f, 0x2f, 0x21,
-	0x3f, 0x86, 0x29, 0x9e, 0xde, 0xcd, 0x80, 0x7c, 0x62, 0x0a, 0x65, 0xe5, 0xcd, 0x5d, 0x3f, 0x17,
-	0xd3, 0xa5, 0xf8, 0xf5, 0x6a, 0xe3, 0x02, 0x2e, 0x72, 0xac, 0xe3, 0xea, 0xe9, 0xac, 0x9d, 0xa4,
-	0xc2, 0x39, 0x8f, 0x7e, 0x6f, 0x12, 0xa6, 0x8f, 0x8f, 0xea, 0xfd, 0x1d, 0xac, 0xa7, 0xf9, 0xf2,
-	0xa8, 0x81, 0xbf, 0x80, 0x4b, 0xc3, 0x94, 0xd2, 0x86, 0x03, 0x98, 0x20, 0x4d, 0x4f, 0xfa, 0xfb,
-	0x76, 0xd4, 0x83, 0x8a, 0xe1, 0x9a, 0xc7, 0xd5, 0xd3, 0x4a, 0x7a, 0xd0, 0xcf, 0x25, 0xd8, 0x41,
-	0x26, 0xdf, 0xff, 0x33, 0x0d, 0x93, 0x3c, 0x14, 0xfa, 0x15, 0xc0, 0x94, 0x18, 0xc4, 0x68, 0x2b,
-	0x7a, 0x3a, 0xfa, 0x0d, 0x50, 0xb6, 0x9f, 0x51, 0x09, 0x2e, 0x7c, 0xf0, 0xcb, 0xdf, 0xff, 0xfd,
-	0x1e, 0x57, 0xd1, 0x87, 0x5a, 0xf8, 0x8b, 0x34, 0x6c, 0x53, 0x57, 0xbb, 0xf2, 0x3d, 0xb9, 0xd6,
-	0x5c, 0x81, 0x40, 0x61, 0x92, 0xcf, 0x3f, 0xf4, 0xfe, 0x84, 0x2c, 0xc1, 0x6f, 0x80, 0xb2, 0x35,
-	0x5d, 0x24, 0x49, 0xde, 0xe1, 0x24, 0x6b, 0x68, 0x65, 0x84, 0x84, 0x34, 0x3d, 0x17, 0xdd, 0x00,
-	0x08, 0x87, 0x53, 0x04, 0x15, 0x26, 0x44, 0x8c, 0x4c, 0x4d, 0xa5, 0xf8, 0x02, 0xa5, 0x04, 0x28,
-	0x71, 0x80, 0x5d, 0xb4, 0xfd, 0x22, 0x2b, 0xd0, 0x4f, 0x30, 0xc9, 0x0b, 0x77, 0xa2, 0x07, 0xc1,
-	0x69, 0x34, 0xd1, 0x83, 0xd0, 0xb4, 0xc1, 0x05, 0x8e, 0x80, 0x51, 0x7e, 0x04, 0x41, 0x34, 0x45,
-	0x30, 0xfb, 0x2d, 0x80, 0x0b, 0x81, 0x99, 0x80, 0x8a, 0xd3, 0xe2, 0x87, 0x06, 0x91, 0xb2, 0xf7,
-	0x12, 0xa9, 0x04, 0xd2, 0x38, 0x50, 0x11, 0xed, 0x8e, 0x07, 0x62, 0xe3, 0xca, 0xa7, 0x62, 0xeb,
-	0x6b, 0xe4, 0xc1, 0x94, 0x18, 0x00, 0x68, 0xea, 0x8d, 0xdd, 0xe7, 0xca, 0x34, 0x3c, 0x45, 0xf0,
-	0xbb, 0x9c, 0x63, 0x03, 0xad, 0x8d, 0xe5, 0x40, 0x3f, 0x43, 0xd6, 0x33, 0xe8, 0xbd, 0x09, 0xc1,
-	0x86, 0xbd, 0xaf, 0xe0, 0x69, 0x12, 0x99, 0xac, 0xcc, 0x93, 0x7d, 0x80, 0x8a, 0x63, 0x2a, 0x31,
-	0xd8, 0x0c, 0x57, 0x7e, 0xef, 0x5f, 0x57, 0xbe, 0xbc, 0x1b, 0x64, 0xc1, 0xfd, 0x20, 0x0b, 0xfe,
-	0x1d, 0x64, 0xc1, 0xcd, 0x43, 0x36, 0x76, 0xff, 0x90, 0x8d, 0xfd, 0xf3, 0x90, 0x8d, 0x9d, 0xed,
-	0x5b, 0x2d, 0xef, 0xbc, 0x5b, 0x53, 0xeb, 0xd4, 0xd6, 0xea, 0x9d, 0x9e, 0xe3, 0xd1, 0x12, 0xed,
-	0x58, 0x25, 0x1e, 0x59, 0xc4, 0x2f, 0xf1, 0x04, 0x3f, 0xf2, 0x14, 0x5e, 0xcf, 0x31, 0xdd, 0x5a,
-	0x8a, 0xff, 0xb9, 0xfb, 0xe8, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xd5, 0x5e, 0xa9, 0x8a,
-	0x0a, 0x00, 0x00,
+	// 1015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x19, 0x7e, 0x04, 0x78, 0x6c, 0x05, 0x3b, 0x2c, 0x90, 0xba, 0x34, 0xa1, 0x53, 0x16,
+	0x02, 0x6d, 0x6c, 0x85, 0x52, 0xa9, 0xaa, 0x7a, 0x32, 0x55, 0x5a, 0xa4, 0x8a, 0xdd, 0xba, 0x9c,
+	0x56, 0x95, 0x90, 0x49, 0x9c, 0xac, 0xd5, 0xc4, 0x63, 0x62, 0x87, 0x6e, 0x44, 0x51, 0xa5, 0x1e,
+	0xdb, 0x1e, 0x90, 0xaa, 0x3d, 0xb7, 0xff, 0xc3, 0xfe, 0x13, 0x7b, 0x5c, 0xa9, 0x97, 0x9e, 0xa2,
+	0x0a, 0xfa, 0x17, 0x70, 0xed, 0xa5, 0xf2, 0x9b, 0x71, 0x62, 0xc7, 0xf9, 0x41, 0x23, 0x4e, 0xc4,
+	0x9e, 0xef, 0xbc, 0xf7, 0x99, 0xf7, 0xfc, 0xbe, 0x36, 0xb0, 0x5e, 0x7a, 0x6e, 0xda, 0x4e, 0xdd,
+	0xb4, 0x1d, 0xcd, 0xa9, 0xf8, 0xda, 0x79, 0x41, 0x3b, 0x6b, 0x5a, 0x8d, 0x96, 0xea, 0x36, 0xb8,
+	0xcf, 0xe9, 0x52, 0x67, 0x55, 0x75, 0x2a, 0xbe, 0x7a, 0x5e, 0x50, 0x1e, 0x55, 0x79, 0x95, 0xe3,
+	0xa2, 0x16, 0xfc, 0x12, 0x3a, 0x65, 0xbd, 0xca, 0x79, 0xb5, 0x66, 0x69, 0xa6, 0x6b, 0x6b, 0xa6,
+	0xe3, 0x70, 0xdf, 0xf4, 0x6d, 0xee, 0x78, 0x72, 0x55, 0x49, 0xe4, 0x08, 0x82, 0x89, 0xb5, 0xdd,
+	0x12, 0xf7, 0xea, 0xdc, 0xd3, 0x4e, 0x4d, 0xcf, 0x12, 0xa9, 0xb5, 0xf3, 0xc2, 0xa9, 0xe5, 0x9b,
+	0x05, 0xcd, 0x35, 0xab, 0xb6, 0x83, 0x81, 0x84, 0x96, 0x3d, 0x03, 0xfa, 0x75, 0xa0, 0xf8, 0xa6,
+	0xe9, 0xba, 0xb5, 0x96, 0x61, 0x9d, 0x35, 0x2d, 0xcf, 0xa7, 0x2a, 0xcc, 0x95, 0x2d, 0x87, 0xd7,
+	0x4f, 0xec, 0x72, 0x9a, 0x6c, 0x90, 0xdc, 0xbc, 0xbe, 0x7c, 0xdb, 0xce, 0x2e, 0xb6, 0xcc, 0x7a,
+	0xed, 0x53, 0x16, 0xae, 0x30, 0x63, 0x16, 0x7f, 0x1e, 0x96, 0xe9, 0x23, 0x98, 0xe1, 0xdf, 0x3b,
+	0x56, 0x23, 0x3d, 0x19, 0x88, 0x0d, 0x71, 0xc1, 0xf2, 0xb0, 0x1c, 0x8b, 0xed, 0xb9, 0xdc, 0xf1,
+	0x2c, 0xba, 0x0a, 0x29, 0xb3, 0xce, 0x9b, 0x8e, 0x8f, 0xa1, 0xa7, 0x0d, 0x79, 0xc5, 0x5e, 0x11,
+	0x78, 0x88, 0xfa, 0x27, 0xc1, 0xee, 0x71, 0x51, 0xb6, 0x62, 0x28, 0xfa, 0xd2, 0x6d, 0x3b, 0xfb,
+	0x40, 0x88, 0x05, 0x94, 0x84, 0xa3, 0x45, 0x80, 0x6e, 0x31, 0xd2, 0x53, 0x1b, 0x24, 0xb7, 0xb0,
+	0xb7, 0xa5, 0x8a, 0xca, 0xa9, 0x41, 0xe5, 0x54, 0xd1, 0x34, 0x59, 0x39, 0xf5, 0xa9, 0x59, 0xb5,
+	0x24, 0x93, 0x11, 0xd9, 0xc9, 0x7e, 0x25, 0x40, 0xa3, 0xd4, 0xf2, 0x90, 0xf9, 0x10, 0x83, 0x60,
+	0xe4, 0x35, 0xb5, 0xb7, 0xeb, 0xaa, 0xd0, 0x4b, 0x9a, 0x2f, 0x62, 0x34, 0x93, 0xb8, 0x67, 0x7b,
+	0x24, 0x8d, 0xc8, 0x15, 0xc3, 0xf9, 0x97, 0xc0, 0x4a, 0x17, 0xe7, 0xa8, 0x78, 0xec, 0x85, 0x85,
+	0xdc, 0x8a, 0x12, 0x0d, 0x29, 0x4c, 0xb4, 0xe0, 0x93, 0x77, 0x28, 0xb8, 0x0e, 0x8b, 0x3e, 0xff,
+	0xce, 0x72, 0x4e, 0xec, 0xf2, 0x89, 0xdb, 0xb0, 0x2a, 0xf6, 0x0b, 0xac, 0xe6, 0xbc, 0xae, 0xdc,
+	0xb6, 0xb3, 0xab, 0x62, 0x5b, 0x8f, 0x80, 0x19, 0x6f, 0xe1, 0x9d, 0xc3, 0xf2, 0x53, 0xbc, 0xee,
+	0x69, 0xc6, 0xf4, 0xd8, 0xcd, 0xf8, 0x9d, 0xc0, 0x6a, 0xef, 0xe9, 0x65, 0x43, 0x3e, 0x83, 0x69,
+	0xa7, 0xe2, 0x7b, 0x69, 0xb2, 0x31, 0x95, 0x5b, 0xd8, 0x53, 0xfa, 0xf7, 0xa3, 0x7c, 0x54, 0x3c,
+	0xd6, 0x1f, 0xbc, 0x6e, 0x67, 0x27, 0xae, 0xdb, 0xd9, 0x69, 0xdc, 0x8f, 0xbb, 0xee, 0xaf, 0x3f,
+	0x3e, 0xcc, 0x85, 0x89, 0xfe, 0xf7, 0xa3, 0xfd, 0x09, 0x4c, 0x39, 0x15, 0x5f, 0x66, 0x7f, 0x3b,
+	0x79, 0x02, 0xdd, 0xf4, 0xac, 0xe0, 0x00, 0x0b, 0xf2, 0x00, 0x53, 0x47, 0xc5, 0x63, 0x23, 0xd8,
+	0xc2, 0xae, 0xc2, 0xba, 0x1c, 0xf0, 0x5a, 0xcd, 0x2a, 0x05, 0x24, 0xe3, 0xce, 0x57, 0xb1, 0x4f,
+	0x25, 0xc6, 0x69, 0xd5, 0x1f, 0x04, 0xd6, 0x12, 0x48, 0x9d, 0x5e, 0x41, 0xa9, 0x73, 0x57, 0x4e,
+	0xd0, 0x7a, 0xf2, 0xbc, 0x91, 0x9d, 0x11, 0xfd, 0xfd, 0xf5, 0xea, 0x40, 0xfa, 0xd1, 0xe7, 0xc1,
+	0xd1, 0xc7, 0xac, 0x17, 0x3b, 0x00, 0x1a, 0x0d, 0xd2, 0xb5, 0x07, 0x14, 0x0c, 0xb6, 0x07, 0xa1,
+	0x17, 0x2a, 0xf6, 0x44, 0xd6, 0x0a, 0x6f, 0xea, 0xad, 0x23, 0xb3, 0x1e, 0xd6, 0x94, 0xee, 0x03,
+	0x88, 0xac, 0x8e, 0x59, 0xb7, 0x24, 0xd1, 0xca, 0x6d, 0x3b, 0xfb, 0x30, 0x4a, 0x14, 0xac, 0x31,
+	0x63, 0x1e, 0x2f, 0x82, 0xcd, 0xec, 0x10, 0xd2, 0xc9, 0x80, 0xe3, 0xb1, 0x7d, 0x1b, 0x3d, 0x60,
+	0xc7, 0x6d, 0xe2, 0x8f, 0x09, 0x19, 0xfb, 0x31, 0x79, 0x49, 0x60, 0x39, 0x16, 0x5e, 0x42, 0x7e,
+	0x0c, 0x29, 0x4c, 0x1f, 0x0e, 0xf4, 0x20, 0x4a, 0x7d, 0x3a, 0x18, 0x06, 0x43, 0x8a, 0xef, 0xef,
+	0xd9, 0x38, 0x83, 0x45, 0xc4, 0x0a, 0x46, 0x6c, 0xcc, 0x49, 0x52, 0x61, 0x2e, 0xf4, 0xc5, 0xa4,
+	0xd1, 0x86, 0x2b, 0xcc, 0x98, 0x95, 0x56, 0xc9, 0xbe, 0x84, 0xa5, 0x6e, 0x4a, 0x59, 0x86, 0x7d,
+	0x61, 0x09, 0x64, 0x94, 0x25, 0xcc, 0x46, 0xed, 0x60, 0xef, 0xd5, 0x1c, 0xcc, 0x60, 0x28, 0xfa,
+	0x0b, 0x81, 0x94, 0x78, 0x3d, 0xd3, 0xcd, 0xe4, 0xee, 0xe4, 0x97, 0x81, 0xf2, 0x78, 0x84, 0x4a,
+	0x70, 0xb1, 0xfd, 0x9f, 0xfe, 0xfc, 0xe7, 0xb7, 0x49, 0x95, 0x7e, 0xa8, 0xc5, 0xbf, 0x53, 0xba,
+	0x63, 0xea, 0x69, 0x17, 0x61, 0x4d, 0x2e, 0x35, 0x4f, 0x20, 0x70, 0x98, 0x41, 0xe3, 0xa6, 0xef,
+	0x0f, 0xc8, 0x12, 0xfd, 0x32, 0x50, 0x36, 0x87, 0x8b, 0x24, 0xc9, 0x3b, 0x48, 0xb2, 0x42, 0x97,
+	0x7b, 0x48, 0xd0, 0xd6, 0x7f, 0x26, 0x30, 0xdf, 0x79, 0x55, 0xd0, 0xed, 0x61, 0x01, 0x23, 0xaf,
+	0x52, 0x25, 0x37, 0x5a, 0x28, 0xb3, 0xef, 0x62, 0xf6, 0x4d, 0xca, 0x7a, 0xb2, 0xe3, 0xab, 0xd6,
+	0xd3, 0x2e, 0xf0, 0xef, 0xa5, 0x80, 0xb9, 0x22, 0x00, 0x5d, 0x4b, 0xa3, 0x83, 0x92, 0x24, 0x2c,
+	0x5c, 0xd9, 0xb9, 0x83, 0x52, 0xf2, 0xe4, 0x91, 0x67, 0x9b, 0x3e, 0xbe, 0x53, 0x5f, 0xe8, 0x0f,
+	0x30, 0x83, 0x53, 0x34, 0xb0, 0x21, 0x51, 0x6b, 0x54, 0x36, 0x87, 0x8b, 0x24, 0x42, 0x0e, 0x11,
+	0x18, 0xdd, 0xe8, 0x41, 0x10, 0x13, 0x1a, 0xcd, 0xfe, 0x92, 0xc0, 0x42, 0xc4, 0xa0, 0xe8, 0xce,
+	0xb0, 0xf8, 0x31, 0x57, 0x54, 0x76, 0xef, 0x22, 0x95, 0x40, 0x1a, 0x02, 0xed, 0xd0, 0xed, 0xfe,
+	0x40, 0x81, 0x77, 0x86, 0x54, 0xc1, 0xef, 0x4b, 0xea, 0x43, 0x4a, 0xb8, 0x11, 0x1d, 0x7a, 0x62,
+	0x6f, 0xd4, 0xcc, 0xc4, 0x2d, 0x8d, 0xbd, 0x8b, 0x1c, 0x6b, 0x74, 0xa5, 0x2f, 0x07, 0xfd, 0x11,
+	0x82, 0x01, 0xa6, 0xef, 0x0d, 0x08, 0xd6, 0x35, 0x22, 0x85, 0x0d, 0x93, 0xc8, 0x64, 0x05, 0x4c,
+	0xf6, 0x01, 0xdd, 0xe9, 0x33, 0x16, 0xd1, 0xc9, 0xbc, 0x08, 0x8d, 0xe8, 0x52, 0xff, 0xea, 0xf5,
+	0x75, 0x86, 0xbc, 0xb9, 0xce, 0x90, 0xbf, 0xaf, 0x33, 0xe4, 0xea, 0x26, 0x33, 0xf1, 0xe6, 0x26,
+	0x33, 0xf1, 0xd7, 0x4d, 0x66, 0xe2, 0xd9, 0x5e, 0xd5, 0xf6, 0x9f, 0x37, 0x4f, 0xd5, 0x12, 0xaf,
+	0x6b, 0xa5, 0x46, 0xcb, 0xf5, 0x79, 0x9e, 0x37, 0xaa, 0x79, 0x8c, 0x2c, 0xe2, 0xe7, 0x31, 0xc1,
+	0x0b, 0x4c, 0xe1, 0xb7, 0x5c, 0xcb, 0x3b, 0x4d, 0xe1, 0xff, 0x1f, 0x1f, 0xfd, 0x37, 0x00, 0x15,
+	0x71, 0xda, 0xb7, 0x2d, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -821,6 +1009,9 @@ type QueryClient interface {
 	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
 	// Owner queries the NFTs of the specified owner
 	Owner(ctx context.Context, in *QueryOwnerRequest, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
+	// OwnerNFTs queries the NFTs of the specified owner across all denoms,
+	// optionally filtered by denom and token ID prefix
+	OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error)
 	// Collection queries the NFTs of the specified denom
 	Collection(ctx context.Context, in *QueryCollectionRequest, opts ...grpc.CallOption) (*QueryCollectionResponse, error)
 	// Denom queries the definition of a given denom
@@ -859,6 +1050,15 @@ func (c *queryClient) Owner(ctx context.Context, in *QueryOwnerRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) OwnerNFTs(ctx context.Context, in *QueryOwnerNFTsRequest, opts ...grpc.CallOption) (*QueryOwnerNFTsResponse, error) {
+	out := new(QueryOwnerNFTsResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.nft.v1.Query/OwnerNFTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Collection(ctx context.Context, in *QueryCollectionRequest, opts ...grpc.CallOption) (*QueryCollectionResponse, error) {
 	out := new(QueryCollectionResponse)
 	err := c.cc.Invoke(ctx, "/chainmain.nft.v1.Query/Collection", in, out, opts...)
@@ -910,6 +1110,9 @@ type QueryServer interface {
 	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
 	// Owner queries the NFTs of the specified owner
 	Owner(context.Context, *QueryOwnerRequest) (*QueryOwnerResponse, error)
+	// OwnerNFTs queries the NFTs of the specified owner across all denoms,
+	// optionally filtered by denom and token ID prefix
+	OwnerNFTs(context.Context, *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error)
 	// Collection queries the NFTs of the specified denom
 	Collection(context.Context, *QueryCollectionRequest) (*QueryCollectionResponse, error)
 	// Denom queries the definition of a given denom
@@ -932,6 +1135,9 @@ func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyReq
 func (*UnimplementedQueryServer) Owner(ctx context.Context, req *QueryOwnerRequest) (*QueryOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Owner not implemented")
 }
+func (*UnimplementedQueryServer) OwnerNFTs(ctx context.Context, req *QueryOwnerNFTsRequest) (*QueryOwnerNFTsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerNFTs not implemented")
+}
 func (*UnimplementedQueryServer) Collection(ctx context.Context, req *QueryCollectionRequest) (*QueryCollectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Collection not implemented")
 }
@@ -988,6 +1194,24 @@ func _Query_Owner_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OwnerNFTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerNFTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerNFTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.nft.v1.Query/OwnerNFTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerNFTs(ctx, req.(*QueryOwnerNFTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Collection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCollectionRequest)
 	if err := dec(in); err != nil {
@@ -1078,6 +1302,7 @@ func _Query_NFT_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chainmain.nft.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1090,6 +1315,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Owner",
 			Handler:    _Query_Owner_Handler,
 		},
+		{
+			MethodName: "OwnerNFTs",
+			Handler:    _Query_OwnerNFTs_Handler,
+		},
 		{
 			MethodName: "Collection",
 			Handler:    _Query_Collection_Handler,
@@ -1276,7 +1505,7 @@ func (m *QueryOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCollectionRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryOwnerNFTsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1286,12 +1515,12 @@ func (m *QueryCollectionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCollectionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOwnerNFTsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOwnerNFTsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1306,19 +1535,33 @@ func (m *QueryCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIdPrefix) > 0 {
+		i -= len(m.TokenIdPrefix)
+		copy(dAtA[i:], m.TokenIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIdPrefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.DenomId) > 0 {
 		i -= len(m.DenomId)
 		copy(dAtA[i:], m.DenomId)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomId)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCollectionResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOwnerNFTsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1328,12 +1571,12 @@ func (m *QueryCollectionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOwnerNFTsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOwnerNFTsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1350,22 +1593,24 @@ func (m *QueryCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Collection != nil {
-		{
-			size, err := m.Collection.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.NFTs) > 0 {
+		for iNdEx := len(m.NFTs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *OwnedNFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1375,16 +1620,26 @@ func (m *QueryDenomRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OwnedNFT) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OwnedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.NFT.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DenomId) > 0 {
 		i -= len(m.DenomId)
 		copy(dAtA[i:], m.DenomId)
@@ -1395,7 +1650,7 @@ func (m *QueryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCollectionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1405,19 +1660,19 @@ func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCollectionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Denom != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1425,12 +1680,19 @@ func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomByNameRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCollectionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1440,27 +1702,44 @@ func (m *QueryDenomByNameRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DenomName) > 0 {
-		i -= len(m.DenomName)
-		copy(dAtA[i:], m.DenomName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomName)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Collection != nil {
+		{
+			size, err := m.Collection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomByNameResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1470,26 +1749,121 @@ func (m *QueryDenomByNameResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Denom != nil {
-		{
-			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
+	if len(m.DenomId) > 0 {
+		i -= len(m.DenomId)
+		copy(dAtA[i:], m.DenomId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denom != nil {
+		{
+			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomName) > 0 {
+		i -= len(m.DenomName)
+		copy(dAtA[i:], m.DenomName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denom != nil {
+		{
+			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1729,6 +2103,65 @@ func (m *QueryOwnerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOwnerNFTsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerNFTsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NFTs) > 0 {
+		for _, e := range m.NFTs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnedNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.NFT.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryCollectionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2338,6 +2771,423 @@ func (m *QueryOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOwnerNFTsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerNFTsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnerNFTsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnerNFTsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTs = append(m.NFTs, OwnedNFT{})
+			if err := m.NFTs[len(m.NFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnedNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnedNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NFT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCollectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,305 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	sdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+)
+
+const (
+	// MaxSchemaLen is the maximum length of a schema enforced on NFT data
+	MaxSchemaLen = 8192
+	// MaxSchemaDepth is the maximum nesting of properties and items in an enforced schema
+	MaxSchemaDepth = 16
+	// MaxNumberExponent bounds the exponent of numbers compared during validation
+	MaxNumberExponent = 64
+
+	// GasPerSchemaByte is charged for every byte of schema and data decoded
+	GasPerSchemaByte = 3
+	// GasPerSchemaNode is charged for every data value checked against a schema node
+	GasPerSchemaNode = 20
+)
+
+var schemaTypes = map[string]bool{
+	"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+}
+
+// Schema is the JSON-Schema subset NFT data is validated against when a denom enforces its schema.
+//
+// The supported keywords are type, properties, required, additionalProperties (boolean only), items,
+// enum, minLength, maxLength, minimum, maximum, minItems and maxItems. $schema, title and description
+// are accepted as annotations. Any other keyword is rejected, so that a schema never silently checks
+// less than its author intended.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	MinLength            *uint64            `json:"minLength,omitempty"`
+	MaxLength            *uint64            `json:"maxLength,omitempty"`
+	Minimum              *json.Number       `json:"minimum,omitempty"`
+	Maximum              *json.Number       `json:"maximum,omitempty"`
+	MinItems             *uint64            `json:"minItems,omitempty"`
+	MaxItems             *uint64            `json:"maxItems,omitempty"`
+
+	minimum, maximum *big.Rat
+}
+
+// ParseSchema decodes and checks a schema that is to be enforced on NFT data
+func ParseSchema(schema string) (*Schema, error) {
+	if len(schema) > MaxSchemaLen {
+		return nil, sdkerrors.Wrapf(ErrInvalidSchema, "the length of schema only accepts value [0, %d]", MaxSchemaLen)
+	}
+
+	var s Schema
+	dec := json.NewDecoder(strings.NewReader(schema))
+	dec.DisallowUnknownFields()
+	dec.UseNumber()
+	if err := decodeJSON(dec, &s); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSchema, err.Error())
+	}
+	if err := s.check("schema", 0); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// check validates the schema node at path and prepares its number bounds
+func (s *Schema) check(path string, depth int) error {
+	if depth > MaxSchemaDepth {
+		return sdkerrors.Wrapf(ErrInvalidSchema, "%s: nesting exceeds %d levels", path, MaxSchemaDepth)
+	}
+	if s.Type != "" && !schemaTypes[s.Type] {
+		return sdkerrors.Wrapf(ErrInvalidSchema, "%s: unsupported type %q", path, s.Type)
+	}
+	for _, v := range s.Enum {
+		switch v := v.(type) {
+		case nil, bool, string:
+		case json.Number:
+			if _, err := parseNumber(v); err != nil {
+				return sdkerrors.Wrapf(ErrInvalidSchema, "%s.enum: %s", path, err)
+			}
+		default:
+			return sdkerrors.Wrapf(ErrInvalidSchema, "%s.enum: only scalar values are supported", path)
+		}
+	}
+	if s.MinLength != nil && s.MaxLength != nil && *s.MinLength > *s.MaxLength {
+		return sdkerrors.Wrapf(ErrInvalidSchema, "%s: minLength is greater than maxLength", path)
+	}
+	if s.MinItems != nil && s.MaxItems != nil && *s.MinItems > *s.MaxItems {
+		return sdkerrors.Wrapf(ErrInvalidSchema, "%s: minItems is greater than maxItems", path)
+	}
+
+	var err error
+	if s.Minimum != nil {
+		if s.minimum, err = parseNumber(*s.Minimum); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidSchema, "%s.minimum: %s", path, err)
+		}
+	}
+	if s.Maximum != nil {
+		if s.maximum, err = parseNumber(*s.Maximum); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidSchema, "%s.maximum: %s", path, err)
+		}
+	}
+	if s.minimum != nil && s.maximum != nil && s.minimum.Cmp(s.maximum) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidSchema, "%s: minimum is greater than maximum", path)
+	}
+
+	for _, name := range sortedKeys(s.Properties) {
+		if s.Properties[name] == nil {
+			return sdkerrors.Wrapf(ErrInvalidSchema, "%s.properties.%s: must be an object", path, name)
+		}
+		if err := s.Properties[name].check(path+".properties."+name, depth+1); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.check(path+".items", depth+1)
+	}
+	return nil
+}
+
+// ValidateData checks that data is a JSON document matching the schema. Gas is consumed for every
+// byte decoded and every value checked, and object members are visited in sorted order, so both the
+// result and the gas used are deterministic.
+func (s *Schema) ValidateData(gasMeter storetypes.GasMeter, data string) error {
+	gasMeter.ConsumeGas(GasPerSchemaByte*uint64(len(data)), "nft data schema validation")
+
+	var v interface{}
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	if err := decodeJSON(dec, &v); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidTokenData, "nft data is not valid JSON: %s", err)
+	}
+	return s.validate(gasMeter, "data", v)
+}
+
+func (s *Schema) validate(gasMeter storetypes.GasMeter, path string, v interface{}) error {
+	gasMeter.ConsumeGas(GasPerSchemaNode, "nft data schema validation")
+
+	if s.Type != "" && !hasType(v, s.Type) {
+		return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: expected %s", path, s.Type)
+	}
+	if len(s.Enum) > 0 && !inEnum(v, s.Enum) {
+		return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: value is not one of the enumerated values", path)
+	}
+
+	switch v := v.(type) {
+	case string:
+		length := uint64(utf8.RuneCountInString(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: shorter than %d characters", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: longer than %d characters", path, *s.MaxLength)
+		}
+	case json.Number:
+		if s.minimum == nil && s.maximum == nil {
+			return nil
+		}
+		n, err := parseNumber(v)
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: %s", path, err)
+		}
+		if s.minimum != nil && n.Cmp(s.minimum) < 0 {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: less than %s", path, *s.Minimum)
+		}
+		if s.maximum != nil && n.Cmp(s.maximum) > 0 {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: greater than %s", path, *s.Maximum)
+		}
+	case []interface{}:
+		if s.MinItems != nil && uint64(len(v)) < *s.MinItems {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: fewer than %d items", path, *s.MinItems)
+		}
+		if s.MaxItems != nil && uint64(len(v)) > *s.MaxItems {
+			return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: more than %d items", path, *s.MaxItems)
+		}
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range v {
+			if err := s.Items.validate(gasMeter, fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: missing required property %q", path, name)
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			prop, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return sdkerrors.Wrapf(ErrInvalidTokenData, "%s: unexpected property %q", path, name)
+				}
+				continue
+			}
+			if err := prop.validate(gasMeter, path+"."+name, v[name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// decodeJSON decodes a single JSON value from dec, rejecting trailing content
+func decodeJSON(dec *json.Decoder, v interface{}) error {
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected content after the JSON value")
+	}
+	return nil
+}
+
+func hasType(v interface{}, typ string) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return typ == "object"
+	case []interface{}:
+		return typ == "array"
+	case string:
+		return typ == "string"
+	case bool:
+		return typ == "boolean"
+	case nil:
+		return typ == "null"
+	case json.Number:
+		if typ == "number" {
+			return true
+		}
+		if typ != "integer" {
+			return false
+		}
+		n, err := parseNumber(v)
+		return err == nil && n.IsInt()
+	}
+	return false
+}
+
+func inEnum(v interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		switch e := e.(type) {
+		case json.Number:
+			n, ok := v.(json.Number)
+			if !ok {
+				continue
+			}
+			a, errA := parseNumber(n)
+			b, errB := parseNumber(e)
+			if errA == nil && errB == nil && a.Cmp(b) == 0 {
+				return true
+			}
+		default:
+			if e == v {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseNumber converts a JSON number to an exact rational, bounding the exponent so that
+// the conversion cost stays proportional to the length of the number
+func parseNumber(n json.Number) (*big.Rat, error) {
+	s := string(n)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > MaxNumberExponent || exp < -MaxNumberExponent {
+			return nil, fmt.Errorf("number %s is out of range", s)
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", s)
+	}
+	return r, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
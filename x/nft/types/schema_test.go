@@ -0,0 +1,107 @@
+// Copyright (c) 2016-2021 Shanghai Bianjie AI Technology Inc. (licensed under the Apache License, Version 2.0)
+// Modifications Copyright (c) 2021-present Cronos.org (licensed under the Apache License, Version 2.0)
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/nft/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+const testSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "card",
+	"type": "object",
+	"required": ["name", "level"],
+	"additionalProperties": false,
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "maxLength": 8},
+		"level": {"type": "integer", "minimum": 1, "maximum": 1e2},
+		"rarity": {"enum": ["common", "rare", 3]},
+		"tags": {"type": "array", "maxItems": 2, "items": {"type": "string"}},
+		"extra": {"type": "object"}
+	}
+}`
+
+func TestParseSchema(t *testing.T) {
+	_, err := types.ParseSchema(testSchema)
+	require.NoError(t, err)
+
+	for name, schema := range map[string]string{
+		"not json":          "{a:a,b:b}",
+		"trailing content":  `{"type": "object"} {}`,
+		"unknown keyword":   `{"type": "string", "pattern": "^a"}`,
+		"unknown type":      `{"type": "date"}`,
+		"type list":         `{"type": ["string", "null"]}`,
+		"object enum":       `{"enum": [{"a": 1}]}`,
+		"huge exponent":     `{"maximum": 1e100000}`,
+		"inverted bounds":   `{"minimum": 2, "maximum": 1}`,
+		"inverted lengths":  `{"minLength": 2, "maxLength": 1}`,
+		"null property":     `{"properties": {"a": null}}`,
+		"too long":          `{"description": "` + strings.Repeat("a", types.MaxSchemaLen) + `"}`,
+		"too deeply nested": strings.Repeat(`{"items": `, types.MaxSchemaDepth+1) + "{}" + strings.Repeat("}", types.MaxSchemaDepth+1),
+	} {
+		_, err := types.ParseSchema(schema)
+		require.ErrorIs(t, err, types.ErrInvalidSchema, name)
+	}
+}
+
+func TestSchemaValidateData(t *testing.T) {
+	schema, err := types.ParseSchema(testSchema)
+	require.NoError(t, err)
+
+	for _, data := range []string{
+		`{"name": "alice", "level": 1}`,
+		`{"name": "ålîçé", "level": 100.0, "rarity": 3.0, "tags": ["a", "b"], "extra": {"any": [1]}}`,
+		`{"name": "bob", "level": 5e1, "rarity": "rare"}`,
+	} {
+		require.NoError(t, schema.ValidateData(storetypes.NewInfiniteGasMeter(), data), data)
+	}
+
+	for _, data := range []string{
+		``,
+		`{a:a,b:b}`,
+		`{"name": "alice", "level": 1} {}`,
+		`[]`,
+		`{"name": "alice"}`,
+		`{"name": "", "level": 1}`,
+		`{"name": "aliceliddell", "level": 1}`,
+		`{"name": "alice", "level": 1.5}`,
+		`{"name": "alice", "level": 0}`,
+		`{"name": "alice", "level": 101}`,
+		`{"name": "alice", "level": 1e100000}`,
+		`{"name": "alice", "level": 1, "rarity": "epic"}`,
+		`{"name": "alice", "level": 1, "tags": ["a", "b", "c"]}`,
+		`{"name": "alice", "level": 1, "tags": [1]}`,
+		`{"name": "alice", "level": 1, "color": "red"}`,
+	} {
+		require.ErrorIs(t, schema.ValidateData(storetypes.NewInfiniteGasMeter(), data), types.ErrInvalidTokenData, data)
+	}
+}
+
+func TestSchemaValidateDataGas(t *testing.T) {
+	schema, err := types.ParseSchema(testSchema)
+	require.NoError(t, err)
+
+	// two invalid members: the error and the gas used must not depend on map iteration order
+	data := `{"name": 1, "level": 1.5, "tags": [1, 2]}`
+	meter := storetypes.NewInfiniteGasMeter()
+	expErr := schema.ValidateData(meter, data)
+	require.ErrorIs(t, expErr, types.ErrInvalidTokenData)
+	expGas := meter.GasConsumed()
+	require.Equal(t, uint64(types.GasPerSchemaByte*len(data)+2*types.GasPerSchemaNode), expGas)
+
+	for i := 0; i < 20; i++ {
+		meter := storetypes.NewInfiniteGasMeter()
+		require.EqualError(t, schema.ValidateData(meter, data), expErr.Error())
+		require.Equal(t, expGas, meter.GasConsumed())
+	}
+
+	require.Panics(t, func() {
+		_ = schema.ValidateData(storetypes.NewGasMeter(10), `{"name": "alice", "level": 1}`)
+	})
+}
@@ -17,6 +17,9 @@ const (
 	IBCPrefix   = "ibc/"
 
 	MaxTokenURILen = 256
+
+	// MaxBatchSize is the maximum number of NFTs a single batch message can act on
+	MaxBatchSize = 256
 )
 
 var (
@@ -78,6 +81,14 @@ func ValidateTokenURI(tokenURI string) error {
 	return nil
 }
 
+// ValidateBatchSize verify that the number of NFTs in a batch message is legal
+func ValidateBatchSize(size int) error {
+	if size == 0 || size > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidBatch, "the number of nfts in a batch only accepts value [1, %d]", MaxBatchSize)
+	}
+	return nil
+}
+
 // Modified returns whether the field is modified
 func Modified(target string) bool {
 	return target != DoNotModify
@@ -0,0 +1,32 @@
+package supply
+
+import (
+	"context"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker indexes the vesting accounts created in the block, and removes the fully
+// vested accounts from the vesting accounts index. It then updates the cached unvested
+// supply and stores the supply snapshot of the block, if any. Snapshot errors are
+// logged and skipped.
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.IndexNewAccounts(sdkCtx); err != nil {
+		return err
+	}
+	k.PruneVestedAccounts(sdkCtx)
+	k.UpdateUnvestedSupply(sdkCtx)
+
+	// the snapshots are informational, a failure must not halt the chain
+	if err := k.SnapshotSupply(sdkCtx); err != nil {
+		k.Logger(sdkCtx).Error("failed to store the supply snapshot", "height", sdkCtx.BlockHeight(), "err", err)
+	}
+	return nil
+}
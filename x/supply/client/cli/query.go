@@ -12,6 +12,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const (
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+	FlagDenom       = "denom"
+)
+
 // GetQueryCmd returns the parent command for all x/supply CLI query commands. The
 // provided clientCtx should have, at a minimum, a verifier, Tendermint RPC client,
 // and marshaler set.
@@ -27,6 +33,10 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryTotalSupply(),
 		GetCmdQueryLiquidSupply(),
+		GetCmdQueryExcludedSupply(),
+		GetCmdQuerySupplyBreakdown(),
+		GetCmdQuerySupplySnapshots(),
+		GetCmdQueryParams(),
 	)
 
 	return cmd
@@ -41,8 +51,9 @@ func GetCmdQueryTotalSupply() *cobra.Command {
 			fmt.Sprintf(`Query total supply of coins that are held by accounts in the chain. [Deprecated: do not use]
 Example:
   $ %s query %s total
+  $ %s query %s total --denom=basecro
 `,
-				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -53,7 +64,11 @@ Example:
 
 			//nolint: staticcheck
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.TotalSupply(cmd.Context(), types.NewSupplyRequest())
+			req, err := newSupplyRequest(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.TotalSupply(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -62,7 +77,9 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "The denom to query the total supply of")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "total")
 
 	return cmd
 }
@@ -76,6 +93,118 @@ func GetCmdQueryLiquidSupply() *cobra.Command {
 			fmt.Sprintf(`Query liquid supply of coins that are held by accounts in the chain. [Deprecated: do not use]
 Example:
   $ %s query %s liquid
+  $ %s query %s liquid --denom=basecro
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint: staticcheck
+			queryClient := types.NewQueryClient(clientCtx)
+			req, err := newSupplyRequest(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.LiquidSupply(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The denom to query the liquid supply of")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "liquid")
+
+	return cmd
+}
+
+// GetCmdQueryExcludedSupply returns command for the supply excluded from liquid supply
+func GetCmdQueryExcludedSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "excluded",
+		Short: "Query the supply of coins excluded from the liquid supply",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unvested supply and the balances of the module accounts and addresses excluded from the liquid supply.
+Example:
+  $ %s query %s excluded
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint: staticcheck
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExcludedSupply(cmd.Context(), types.NewSupplyRequest())
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupplyBreakdown returns command for the breakdown of the total supply
+func GetCmdQuerySupplyBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "breakdown",
+		Short: "Query the breakdown of the total supply of coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the breakdown of the total supply into the unvested supply, the excluded accounts, the staked supply, the tier-locked positions, the burned coins and the liquid supply.
+Example:
+  $ %s query %s breakdown
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint: staticcheck
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SupplyBreakdown(cmd.Context(), types.NewSupplyRequest())
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupplySnapshots returns command for the supply snapshots in a height range
+func GetCmdQuerySupplySnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Query the supply breakdowns stored periodically in a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the supply breakdowns stored periodically, between the start and end heights inclusive.
+Example:
+  $ %s query %s snapshots --start-height=100000 --end-height=200000
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -86,9 +215,26 @@ Example:
 				return err
 			}
 
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			//nolint: staticcheck
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.LiquidSupply(cmd.Context(), types.NewSupplyRequest())
+			res, err := queryClient.SupplySnapshots(cmd.Context(), &types.QuerySupplySnapshotsRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
 			if err != nil {
 				return err
 			}
@@ -97,7 +243,62 @@ Example:
 		},
 	}
 
+	cmd.Flags().Int64(FlagStartHeight, 0, "Minimum height of the snapshots")
+	cmd.Flags().Int64(FlagEndHeight, 0, "Maximum height of the snapshots, zero for the latest")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "snapshots")
 
 	return cmd
 }
+
+// GetCmdQueryParams returns command for the supply module params
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current supply parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module accounts and addresses excluded from the liquid supply.
+Example:
+  $ %s query %s params
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			//nolint: staticcheck
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newSupplyRequest returns the supply request of the denom and pagination flags
+func newSupplyRequest(cmd *cobra.Command) (*types.SupplyRequest, error) {
+	denom, err := cmd.Flags().GetString(FlagDenom)
+	if err != nil {
+		return nil, err
+	}
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.NewSupplyRequest()
+	req.Denom = denom
+	req.Pagination = pageReq
+	return req, nil
+}
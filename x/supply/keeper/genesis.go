@@ -8,10 +8,20 @@ import (
 
 // InitGenesis initializes the supply module's state from a given genesis state.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
-	k.SetVestingAccounts(ctx, k.FetchVestingAccounts(ctx))
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+	if err := k.ValidateExcludedAccounts(genState.Params); err != nil {
+		panic(err)
+	}
+	k.SetParams(ctx, genState.Params)
+	k.RebuildVestingAccounts(ctx)
+	k.RebuildUnvestedSupply(ctx)
 }
 
 // ExportGenesis returns the supplu module's genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return types.DefaultGenesis()
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+	}
 }
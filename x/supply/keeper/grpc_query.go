@@ -4,22 +4,141 @@ import (
 	"context"
 
 	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (k Keeper) TotalSupply(ctx context.Context, _ *types.SupplyRequest) (*types.SupplyResponse, error) {
+func (k Keeper) TotalSupply(ctx context.Context, req *types.SupplyRequest) (*types.SupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	totalSupply := k.GetTotalSupply(sdkCtx)
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		supply := sdk.NewCoins(k.bankKeeper.GetSupply(sdkCtx, req.Denom))
+		burned, err := k.getTotalBurnedOf(sdkCtx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SupplyResponse{Supply: supply, Burned: burned}, nil
+	}
 
-	return &types.SupplyResponse{Supply: totalSupply}, nil
+	totalSupply, pageRes, err := k.bankKeeper.GetPaginatedTotalSupply(sdkCtx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := k.GetTotalBurned(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SupplyResponse{Supply: totalSupply, Burned: burned, Pagination: pageRes}, nil
 }
 
 // LiquidSupply implements the Query/LiquidSupply gRPC method
-func (k Keeper) LiquidSupply(ctx context.Context, _ *types.SupplyRequest) (*types.SupplyResponse, error) {
+func (k Keeper) LiquidSupply(ctx context.Context, req *types.SupplyRequest) (*types.SupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	liquidSupply := k.GetLiquidSupply(sdkCtx)
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		liquidSupply := sdk.NewCoins(k.GetLiquidSupplyOf(sdkCtx, req.Denom))
+		burned, err := k.getTotalBurnedOf(sdkCtx, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.SupplyResponse{Supply: liquidSupply, Burned: burned}, nil
+	}
+
+	totalSupply, pageRes, err := k.bankKeeper.GetPaginatedTotalSupply(sdkCtx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := k.GetTotalBurned(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := k.getExcludedAddresses(sdkCtx)
+	liquidSupply := sdk.NewCoins()
+	for _, coin := range totalSupply {
+		liquidSupply = liquidSupply.Add(k.getLiquidSupplyOf(sdkCtx, coin.Denom, excluded))
+	}
+
+	return &types.SupplyResponse{Supply: liquidSupply, Burned: burned, Pagination: pageRes}, nil
+}
+
+// ExcludedSupply implements the Query/ExcludedSupply gRPC method
+func (k Keeper) ExcludedSupply(ctx context.Context, _ *types.SupplyRequest) (*types.ExcludedSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	unvestedSupply, excludedBalances, err := k.GetExcludedSupply(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ExcludedSupplyResponse{Unvested: unvestedSupply, Accounts: excludedBalances}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(sdkCtx)}, nil
+}
+
+// SupplyBreakdown implements the Query/SupplyBreakdown gRPC method
+func (k Keeper) SupplyBreakdown(ctx context.Context, _ *types.SupplyRequest) (*types.SupplyBreakdownResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	breakdown, err := k.GetSupplyBreakdown(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SupplyBreakdownResponse{Breakdown: breakdown}, nil
+}
+
+// SupplySnapshots implements the Query/SupplySnapshots gRPC method
+func (k Keeper) SupplySnapshots(ctx context.Context, req *types.QuerySupplySnapshotsRequest) (*types.QuerySupplySnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.EndHeight != 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Error(codes.InvalidArgument, "end height is lower than start height")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var snapshots []types.SupplySnapshot
+	pageRes, err := query.FilteredPaginate(k.supplySnapshotStore(sdkCtx), req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		height := heightFromSupplySnapshotKey(key)
+		if height < req.StartHeight || (req.EndHeight != 0 && height > req.EndHeight) {
+			return false, nil
+		}
+
+		if accumulate {
+			var snapshot types.SupplySnapshot
+			if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+				return false, err
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &types.SupplyResponse{Supply: liquidSupply}, nil
+	return &types.QuerySupplySnapshotsResponse{Snapshots: snapshots, Pagination: pageRes}, nil
 }
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Hooks wraps the Keeper to implement staking hooks.
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterDelegationModified queues the delegator for an update of its unvested coins, as
+// the delegated vesting coins of vesting accounts are not locked.
+func (h Hooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.k.scheduleDelegatorRefresh(sdk.UnwrapSDKContext(ctx), delAddr)
+}
+
+// BeforeDelegationRemoved queues the delegator for an update of its unvested coins, as
+// for AfterDelegationModified.
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, _ sdk.ValAddress) error {
+	return h.k.scheduleDelegatorRefresh(sdk.UnwrapSDKContext(ctx), delAddr)
+}
+
+// scheduleDelegatorRefresh queues a vesting account delegator at the end of the block,
+// and when its unbonding delegations would complete, which returns delegated vesting
+// coins to the account
+func (k Keeper) scheduleDelegatorRefresh(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	vacc, ok := k.accountKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount)
+	if !ok || vestingEndTime(vacc) <= ctx.BlockTime().Unix() {
+		return nil
+	}
+
+	unbondingTime, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.ScheduleUnvestedRefresh(ctx, ctx.BlockTime(), delAddr)
+	k.ScheduleUnvestedRefresh(ctx, ctx.BlockTime().Add(unbondingTime), delAddr)
+	return nil
+}
+
+// No-op hooks.
+
+func (h Hooks) AfterValidatorCreated(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (h Hooks) BeforeRedelegationSlashed(_ context.Context, _ uint64, _ sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (h Hooks) AfterRedelegationCompleted(_ context.Context, _ sdk.AccAddress, _, _ sdk.ValAddress, _ []uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
+	return nil
+}
@@ -1,38 +1,34 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
 
 	newsdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// ModuleAccounts defines the module accounts which will be queried to get liquid supply
-var ModuleAccounts = []string{
-	authtypes.FeeCollectorName,
-	distrtypes.ModuleName,
-	stakingtypes.BondedPoolName,
-	stakingtypes.NotBondedPoolName,
-	minttypes.ModuleName,
-	govtypes.ModuleName,
-}
-
 // Keeper for supply module
 type Keeper struct {
-	cdc           codec.BinaryCodec
-	storeKey      storetypes.StoreKey
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	cdc             codec.BinaryCodec
+	storeKey        storetypes.StoreKey
+	bankKeeper      types.BankKeeper
+	accountKeeper   types.AccountKeeper
+	inflationKeeper types.InflationKeeper
+	stakingKeeper   types.StakingKeeper
+	tieredRewards   types.TieredRewardsKeeper
+	accountNumbers  types.AccountNumberIndex
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a new keeper
@@ -41,53 +37,76 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	inflationKeeper types.InflationKeeper,
+	stakingKeeper types.StakingKeeper,
+	tieredRewards types.TieredRewardsKeeper,
+	accountNumbers types.AccountNumberIndex,
+	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		bankKeeper:    bankKeeper,
-		accountKeeper: accountKeeper,
+		cdc:             cdc,
+		storeKey:        storeKey,
+		bankKeeper:      bankKeeper,
+		accountKeeper:   accountKeeper,
+		inflationKeeper: inflationKeeper,
+		stakingKeeper:   stakingKeeper,
+		tieredRewards:   tieredRewards,
+		accountNumbers:  accountNumbers,
+		authority:       authority,
 	}
 }
 
-// FetchVestingAccounts iterates over all the accounts and returns addresses of all the vesting accounts
-func (k Keeper) FetchVestingAccounts(ctx sdk.Context) types.VestingAccounts {
-	var addresses []string
-
-	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
-		vacc, ok := account.(vestexported.VestingAccount)
-		if ok {
-			addresses = append(addresses, vacc.GetAddress().String())
-		}
-		return false
-	})
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
 
-	return types.VestingAccounts{
-		Addresses: addresses,
-	}
+// GetAuthority returns the x/supply module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
 }
 
-// SetVestingAccounts persists given vesting accounts
-func (k Keeper) SetVestingAccounts(ctx sdk.Context, vestingAccounts types.VestingAccounts) {
+// SetParams persists the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshal(&vestingAccounts)
-	store.Set(types.VestingAccountsKey, b)
+	b := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, b)
 }
 
-// GetVestingAccounts returns stored vesting accounts
-func (k Keeper) GetVestingAccounts(ctx sdk.Context) types.VestingAccounts {
+// GetParams returns the stored module parameters
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.VestingAccountsKey)
+	b := store.Get(types.ParamsKey)
 
 	if b == nil {
-		return types.VestingAccounts{
-			Addresses: []string{},
+		return types.NewParams([]string{}, []string{}, 0, 0)
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(b, &params)
+	return params
+}
+
+// ValidateExcludedAccounts checks that the excluded module accounts of the params are
+// registered, and that the excluded addresses are not module account addresses, which
+// would be excluded twice.
+func (k Keeper) ValidateExcludedAccounts(params types.Params) error {
+	moduleAddresses := make(map[string]string)
+	for _, moduleName := range params.ExcludedModuleAccounts {
+		addr := k.accountKeeper.GetModuleAddress(moduleName)
+		if addr == nil {
+			return fmt.Errorf("excluded module account %s does not exist", moduleName)
+		}
+		moduleAddresses[addr.String()] = moduleName
+	}
+
+	for _, addr := range params.ExcludedAddresses {
+		if moduleName, ok := moduleAddresses[addr]; ok {
+			return fmt.Errorf("excluded address %s is the address of excluded module account %s", addr, moduleName)
 		}
 	}
 
-	var vestingAccounts types.VestingAccounts
-	k.cdc.MustUnmarshal(b, &vestingAccounts)
-	return vestingAccounts
+	return nil
 }
 
 // GetTotalSupply returns the current total supply in the system
@@ -100,51 +119,201 @@ func (k Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
 	return totalSupply
 }
 
-// GetUnvestedSupply returns total unvested supply
+// GetUnvestedSupply returns total unvested supply of the vesting accounts, including
+// the excluded ones, computed by walking the vesting accounts
 func (k Keeper) GetUnvestedSupply(ctx sdk.Context) sdk.Coins {
-	vestingAccounts := k.GetVestingAccounts(ctx)
-
 	var lockedCoins sdk.Coins
 
-	for _, vestingAccountAddress := range vestingAccounts.GetAddresses() {
-		addr, err := sdk.AccAddressFromBech32(vestingAccountAddress)
-		if err != nil {
-			panic(err)
-		}
-
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
 		lockedCoins = lockedCoins.Add(k.bankKeeper.LockedCoins(ctx, addr)...)
-	}
+		return false
+	})
 
 	return lockedCoins
 }
 
 // GetModuleAccountBalance returns the balance of a module account
-func (k Keeper) GetModuleAccountBalance(ctx sdk.Context, moduleName string) sdk.Coins {
+func (k Keeper) GetModuleAccountBalance(ctx sdk.Context, moduleName string) (sdk.Coins, error) {
 	addr := k.accountKeeper.GetModuleAddress(moduleName)
 
 	if addr == nil {
-		panic(newsdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName))
+		return nil, newsdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", moduleName)
 	}
 
-	return k.bankKeeper.GetAllBalances(ctx, addr)
+	return k.bankKeeper.GetAllBalances(ctx, addr), nil
 }
 
 // GetTotalModuleAccountBalance returns total balance of given module accounts
-func (k Keeper) GetTotalModuleAccountBalance(ctx sdk.Context, moduleNames ...string) sdk.Coins {
+func (k Keeper) GetTotalModuleAccountBalance(ctx sdk.Context, moduleNames ...string) (sdk.Coins, error) {
 	var balance sdk.Coins
 
 	for _, moduleName := range moduleNames {
-		balance = balance.Add(k.GetModuleAccountBalance(ctx, moduleName)...)
+		moduleBalance, err := k.GetModuleAccountBalance(ctx, moduleName)
+		if err != nil {
+			return nil, err
+		}
+		balance = balance.Add(moduleBalance...)
+	}
+
+	return balance, nil
+}
+
+// GetExcludedBalances returns the balances of the module accounts and addresses
+// excluded from the liquid supply by the params
+func (k Keeper) GetExcludedBalances(ctx sdk.Context) ([]types.ExcludedBalance, error) {
+	params := k.GetParams(ctx)
+	balances := make([]types.ExcludedBalance, 0, len(params.ExcludedModuleAccounts)+len(params.ExcludedAddresses))
+
+	for _, moduleName := range params.ExcludedModuleAccounts {
+		balance, err := k.GetModuleAccountBalance(ctx, moduleName)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, types.ExcludedBalance{
+			ModuleName: moduleName,
+			Address:    k.accountKeeper.GetModuleAddress(moduleName).String(),
+			Balance:    balance,
+		})
+	}
+
+	for _, address := range params.ExcludedAddresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, types.ExcludedBalance{
+			Address: address,
+			Balance: k.bankKeeper.GetAllBalances(ctx, addr),
+		})
 	}
 
-	return balance
+	return balances, nil
 }
 
-// GetLiquidSupply returns the total liquid supply in the system
-func (k Keeper) GetLiquidSupply(ctx sdk.Context) sdk.Coins {
+// getExcludedAddresses returns the addresses of the module accounts and the addresses
+// excluded from the liquid supply by the params
+func (k Keeper) getExcludedAddresses(ctx sdk.Context) []sdk.AccAddress {
+	params := k.GetParams(ctx)
+	addresses := make([]sdk.AccAddress, 0, len(params.ExcludedModuleAccounts)+len(params.ExcludedAddresses))
+
+	for _, moduleName := range params.ExcludedModuleAccounts {
+		addresses = append(addresses, k.accountKeeper.GetModuleAddress(moduleName))
+	}
+
+	for _, address := range params.ExcludedAddresses {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			panic(err)
+		}
+		addresses = append(addresses, addr)
+	}
+
+	return addresses
+}
+
+// getExcludedAddressSet returns the set of the addresses excluded from the liquid
+// supply by the params
+func (k Keeper) getExcludedAddressSet(ctx sdk.Context) map[string]bool {
+	excluded := make(map[string]bool)
+	for _, addr := range k.getExcludedAddresses(ctx) {
+		excluded[addr.String()] = true
+	}
+	return excluded
+}
+
+// GetExcludedSupply returns the unvested supply cached at the end of the last block and
+// the balances of the excluded accounts, which are not part of the liquid supply. The
+// locked coins of excluded vesting accounts are only counted in their balances.
+func (k Keeper) GetExcludedSupply(ctx sdk.Context) (sdk.Coins, []types.ExcludedBalance, error) {
+	excludedBalances, err := k.GetExcludedBalances(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.GetCachedUnvestedSupply(ctx), excludedBalances, nil
+}
+
+// GetLiquidSupply returns the total liquid supply in the system, with the unvested
+// supply cached at the end of the last block
+func (k Keeper) GetLiquidSupply(ctx sdk.Context) (sdk.Coins, error) {
+	totalSupply := k.GetTotalSupply(ctx)
+	unvestedSupply, excludedBalances, err := k.GetExcludedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return liquidSupply(totalSupply, unvestedSupply, excludedBalances), nil
+}
+
+// liquidSupply returns the total supply less the unvested supply and the balances of
+// the excluded accounts
+func liquidSupply(totalSupply, unvestedSupply sdk.Coins, excludedBalances []types.ExcludedBalance) sdk.Coins {
+	liquid := totalSupply.Sub(unvestedSupply...)
+	for _, balance := range excludedBalances {
+		liquid = liquid.Sub(balance.Balance...)
+	}
+	return liquid
+}
+
+// GetSupplyBreakdown returns the breakdown of the total supply in the system, with the
+// unvested supply cached at the end of the last block
+func (k Keeper) GetSupplyBreakdown(ctx sdk.Context) (types.SupplyBreakdown, error) {
 	totalSupply := k.GetTotalSupply(ctx)
-	unvestedSupply := k.GetUnvestedSupply(ctx)
-	moduleAccountBalance := k.GetTotalModuleAccountBalance(ctx, ModuleAccounts...)
+	unvestedSupply, excludedBalances, err := k.GetExcludedSupply(ctx)
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+	bondedTokens, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+
+	tierLocked := []types.TierLockedSupply{}
+	err = k.tieredRewards.IterateLockedAmountsByTier(ctx, func(tierId uint32, amount math.Int) bool {
+		tierLocked = append(tierLocked, types.TierLockedSupply{
+			TierId: tierId,
+			Amount: sdk.NewCoins(sdk.NewCoin(bondDenom, amount)),
+		})
+		return false
+	})
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
+
+	burned, err := k.inflationKeeper.GetTotalBurned(ctx)
+	if err != nil {
+		return types.SupplyBreakdown{}, err
+	}
 
-	return totalSupply.Sub(unvestedSupply...).Sub(moduleAccountBalance...)
+	return types.SupplyBreakdown{
+		Total:      totalSupply,
+		Unvested:   unvestedSupply,
+		Accounts:   excludedBalances,
+		Staked:     sdk.NewCoins(sdk.NewCoin(bondDenom, bondedTokens)),
+		TierLocked: tierLocked,
+		Burned:     burned,
+		Liquid:     liquidSupply(totalSupply, unvestedSupply, excludedBalances),
+	}, nil
+}
+
+// GetTotalBurned returns the cumulative total of the coins burned through x/inflation
+func (k Keeper) GetTotalBurned(ctx sdk.Context) (sdk.Coins, error) {
+	return k.inflationKeeper.GetTotalBurned(ctx)
+}
+
+// getTotalBurnedOf returns the cumulative total of the coins of a denom burned
+// through x/inflation
+func (k Keeper) getTotalBurnedOf(ctx sdk.Context, denom string) (sdk.Coins, error) {
+	burned, err := k.GetTotalBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(sdk.NewCoin(denom, burned.AmountOf(denom))), nil
 }
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/crypto-org-chain/chain-main/v8/app"
+	"github.com/crypto-org-chain/chain-main/v8/testutil"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+type KeeperSuite struct {
+	suite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+	app    *app.ChainApp
+}
+
+func (s *KeeperSuite) SetupTest() {
+	a := testutil.Setup(false, nil)
+	s.app = a
+	s.ctx = a.BaseApp.NewContext(false).WithBlockHeader(tmproto.Header{ChainID: testutil.ChainID, Time: time.Now().UTC()})
+	s.keeper = a.SupplyKeeper
+}
+
+func TestKeeperSuite(t *testing.T) {
+	suite.Run(t, new(KeeperSuite))
+}
+
+func (s *KeeperSuite) requireDefaultParams() {
+	s.T().Helper()
+	params := s.keeper.GetParams(s.ctx)
+	s.Require().Equal(types.DefaultExcludedModuleAccounts, params.ExcludedModuleAccounts)
+	s.Require().Empty(params.ExcludedAddresses)
+	s.Require().Zero(params.SnapshotInterval)
+	s.Require().Equal(uint64(types.DefaultMaxSnapshots), params.MaxSnapshots)
+}
+
+func (s *KeeperSuite) liquidSupply() sdk.Coins {
+	liquid, err := s.keeper.GetLiquidSupply(s.ctx)
+	s.Require().NoError(err)
+	return liquid
+}
+
+func (s *KeeperSuite) TestExcludedAccounts() {
+	s.requireDefaultParams()
+
+	foundation := sdk.AccAddress([]byte("foundation_addr_____"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, foundation, funds))
+	liquidBefore := s.liquidSupply()
+
+	params := types.DefaultParams()
+	params.ExcludedAddresses = []string{foundation.String()}
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	_, err := msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: foundation.String(), Params: params})
+	s.Require().ErrorContains(err, "invalid authority")
+	_, err = msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	// the balance of the excluded address is no longer part of the liquid supply
+	s.Require().Equal(liquidBefore.Sub(funds...), s.liquidSupply())
+
+	res, err := s.keeper.ExcludedSupply(s.ctx, &types.SupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Accounts, len(types.DefaultExcludedModuleAccounts)+1)
+	for i, moduleName := range types.DefaultExcludedModuleAccounts {
+		s.Require().Equal(moduleName, res.Accounts[i].ModuleName)
+		balance, err := s.keeper.GetModuleAccountBalance(s.ctx, moduleName)
+		s.Require().NoError(err)
+		s.Require().Equal(balance, res.Accounts[i].Balance)
+	}
+	s.Require().Equal(types.ExcludedBalance{Address: foundation.String(), Balance: funds}, res.Accounts[len(res.Accounts)-1])
+
+	// the tier rewards pool is excluded by default
+	pool := s.app.AccountKeeper.GetModuleAddress(tieredrewardstypes.RewardsPoolName)
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, pool, funds))
+	s.Require().Equal(liquidBefore.Sub(funds...), s.liquidSupply())
+}
+
+func (s *KeeperSuite) TestUpdateParams_InvalidExcludedAccounts() {
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	for name, params := range map[string]types.Params{
+		"unknown module account":  types.NewParams([]string{"unknown"}, []string{}, 0, 0),
+		"empty module account":    types.NewParams([]string{""}, []string{}, 0, 0),
+		"duplicate module":        types.NewParams([]string{authtypes.FeeCollectorName, authtypes.FeeCollectorName}, []string{}, 0, 0),
+		"invalid address":         types.NewParams([]string{}, []string{"invalid"}, 0, 0),
+		"module account address":  types.NewParams([]string{authtypes.FeeCollectorName}, []string{feeCollector.String()}, 0, 0),
+		"duplicate excluded addr": types.NewParams([]string{}, []string{feeCollector.String(), feeCollector.String()}, 0, 0),
+		"no snapshots kept":       types.NewParams([]string{}, []string{}, 100, 0),
+	} {
+		_, err := keeper.NewMsgServerImpl(s.keeper).UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+		s.Require().Error(err, name)
+	}
+	s.requireDefaultParams()
+}
+
+func (s *KeeperSuite) TestMigrate1to2() {
+	s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	s.Require().Empty(s.keeper.GetParams(s.ctx).ExcludedModuleAccounts)
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+	s.requireDefaultParams()
+}
+
+func (s *KeeperSuite) TestVestingAccountsIndex() {
+	from := sdk.AccAddress([]byte("vesting_funder______"))
+	continuous := sdk.AccAddress([]byte("continuous_vesting__"))
+	permanent := sdk.AccAddress([]byte("permanent_locked____"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, from, funds.Add(funds...)))
+	unvestedBefore := s.keeper.GetUnvestedSupply(s.ctx)
+
+	endTime := s.ctx.BlockTime().Add(time.Hour)
+	vestingServer := vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper)
+	_, err := vestingServer.CreateVestingAccount(s.ctx, vestingtypes.NewMsgCreateVestingAccount(from, continuous, funds, endTime.Unix(), false))
+	s.Require().NoError(err)
+	_, err = vestingServer.CreatePermanentLockedAccount(s.ctx, vestingtypes.NewMsgCreatePermanentLockedAccount(from, permanent, funds))
+	s.Require().NoError(err)
+
+	// the vesting accounts created after genesis are indexed at the end of the block
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().ElementsMatch([]string{continuous.String(), permanent.String()}, s.keeper.GetVestingAccounts(s.ctx).Addresses)
+	s.Require().Equal(unvestedBefore.Add(funds...).Add(funds...), s.keeper.GetUnvestedSupply(s.ctx))
+
+	// the fully vested accounts are removed from the index, unlike permanently locked ones
+	s.ctx = s.ctx.WithBlockTime(endTime)
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal([]string{permanent.String()}, s.keeper.GetVestingAccounts(s.ctx).Addresses)
+	s.Require().Equal(unvestedBefore.Add(funds...), s.keeper.GetUnvestedSupply(s.ctx))
+}
+
+func (s *KeeperSuite) TestMigrate2to3() {
+	from := sdk.AccAddress([]byte("vesting_funder______"))
+	to := sdk.AccAddress([]byte("continuous_vesting__"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, from, funds))
+	vestingServer := vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper)
+	_, err := vestingServer.CreateVestingAccount(s.ctx, vestingtypes.NewMsgCreateVestingAccount(from, to, funds, s.ctx.BlockTime().Add(time.Hour).Unix(), false))
+	s.Require().NoError(err)
+
+	// the vesting accounts of the previous version are only those stored at genesis
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	legacy := types.VestingAccounts{Addresses: []string{}}
+	store.Set(types.VestingAccountsKey, s.app.AppCodec().MustMarshal(&legacy))
+	store.Delete(types.VestingIndexKey(s.ctx.BlockTime().Add(time.Hour).Unix(), to))
+	store.Delete(types.NextAccountNumberKey)
+	s.Require().Empty(s.keeper.GetVestingAccounts(s.ctx).Addresses)
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate2to3(s.ctx))
+	s.Require().False(store.Has(types.VestingAccountsKey))
+	s.Require().Equal([]string{to.String()}, s.keeper.GetVestingAccounts(s.ctx).Addresses)
+	s.Require().Equal(funds, s.keeper.GetUnvestedSupply(s.ctx))
+
+	// the accounts already indexed by the migration are not checked again
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal([]string{to.String()}, s.keeper.GetVestingAccounts(s.ctx).Addresses)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the default params, which exclude the module accounts that were
+// hard-coded before the params, and the tier rewards pool.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+	return nil
+}
+
+// Migrate2to3 replaces the vesting accounts stored at genesis by the vesting accounts
+// index, rebuilt from all the accounts to include the vesting accounts created since.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.KVStore(m.keeper.storeKey).Delete(types.VestingAccountsKey)
+	m.keeper.RebuildVestingAccounts(ctx)
+	return nil
+}
+
+// Migrate3to4 caches the unvested supply, read by the liquid supply queries.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.RebuildUnvestedSupply(ctx)
+	return nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the supply MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// UpdateParams implements MsgServer.UpdateParams method.
+// It defines a method to update the x/supply module parameters.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateExcludedAccounts(msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	k.SetParams(ctx, msg.Params)
+
+	// the unvested coins of the vesting accounts which are excluded are not cached
+	if !slices.Equal(params.ExcludedModuleAccounts, msg.Params.ExcludedModuleAccounts) ||
+		!slices.Equal(params.ExcludedAddresses, msg.Params.ExcludedAddresses) {
+		k.RebuildUnvestedSupply(ctx)
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
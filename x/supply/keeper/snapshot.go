@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSupplySnapshot persists a supply snapshot
+func (k Keeper) SetSupplySnapshot(ctx sdk.Context, snapshot types.SupplySnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.SupplySnapshotKey(snapshot.Height), b)
+}
+
+// GetSupplySnapshot returns the supply snapshot stored at a height, if any
+func (k Keeper) GetSupplySnapshot(ctx sdk.Context, height int64) (types.SupplySnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.SupplySnapshotKey(height))
+
+	if b == nil {
+		return types.SupplySnapshot{}, false
+	}
+
+	var snapshot types.SupplySnapshot
+	k.cdc.MustUnmarshal(b, &snapshot)
+	return snapshot, true
+}
+
+// SnapshotSupply stores the supply breakdown every snapshot interval of the params,
+// and prunes the snapshots beyond the maximum number of snapshots kept
+func (k Keeper) SnapshotSupply(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.SnapshotInterval == 0 || uint64(ctx.BlockHeight())%params.SnapshotInterval != 0 {
+		return nil
+	}
+
+	breakdown, err := k.GetSupplyBreakdown(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.SetSupplySnapshot(ctx, types.SupplySnapshot{
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		Breakdown: breakdown,
+	})
+
+	// prune the snapshots older than the maximum number of snapshots kept
+	if params.MaxSnapshots-1 >= uint64(ctx.BlockHeight())/params.SnapshotInterval {
+		return nil
+	}
+	k.pruneSupplySnapshots(ctx, ctx.BlockHeight()-int64(params.SnapshotInterval*(params.MaxSnapshots-1)))
+	return nil
+}
+
+// pruneSupplySnapshots removes the supply snapshots below a height
+func (k Keeper) pruneSupplySnapshots(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.SupplySnapshotKeyPrefix, types.SupplySnapshotKey(height))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// supplySnapshotStore returns the prefix store of the supply snapshots
+func (k Keeper) supplySnapshotStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SupplySnapshotKeyPrefix)
+}
+
+// heightFromSupplySnapshotKey returns the height of a key of the supply snapshots
+// prefix store
+func heightFromSupplySnapshotKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key))
+}
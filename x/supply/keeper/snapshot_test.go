@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+	tieredrewardskeeper "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/keeper"
+	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (s *KeeperSuite) TestSupplyBreakdown() {
+	s.ctx = s.ctx.WithBlockHeight(1)
+	bondDenom, err := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(err)
+	vals, err := s.app.StakingKeeper.GetBondedValidatorsByPower(s.ctx)
+	s.Require().NoError(err)
+
+	tier := tieredrewardstypes.Tier{
+		Id:            1,
+		ExitDuration:  time.Hour * 24 * 365,
+		BonusApy:      sdkmath.LegacyNewDecWithPrec(4, 2),
+		MinLockAmount: sdkmath.NewInt(1_000),
+	}
+	s.Require().NoError(s.app.TieredRewardsKeeper.SetTier(s.ctx, tier))
+
+	owner := sdk.AccAddress([]byte("tier_position_owner_"))
+	lockAmount := sdkmath.NewInt(10_000)
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, owner, sdk.NewCoins(sdk.NewCoin(bondDenom, lockAmount))))
+	_, err = tieredrewardskeeper.NewMsgServerImpl(s.app.TieredRewardsKeeper).LockTier(s.ctx, &tieredrewardstypes.MsgLockTier{
+		Owner:            owner.String(),
+		Id:               tier.Id,
+		Amount:           lockAmount,
+		ValidatorAddress: vals[0].GetOperator(),
+	})
+	s.Require().NoError(err)
+
+	res, err := s.keeper.SupplyBreakdown(s.ctx, &types.SupplyRequest{})
+	s.Require().NoError(err)
+	breakdown := res.Breakdown
+	s.Require().Equal(s.keeper.GetTotalSupply(s.ctx), breakdown.Total)
+	s.Require().Equal(s.liquidSupply(), breakdown.Liquid)
+	excludedBalances, err := s.keeper.GetExcludedBalances(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(excludedBalances, breakdown.Accounts)
+	s.Require().Equal([]types.TierLockedSupply{{TierId: tier.Id, Amount: sdk.NewCoins(sdk.NewCoin(bondDenom, lockAmount))}}, breakdown.TierLocked)
+
+	bonded, err := s.app.StakingKeeper.TotalBondedTokens(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, bonded)), breakdown.Staked)
+
+	// the liquid supply is the total supply less the unvested supply and the excluded accounts
+	excluded := breakdown.Unvested
+	for _, account := range breakdown.Accounts {
+		excluded = excluded.Add(account.Balance...)
+	}
+	s.Require().Equal(breakdown.Total, breakdown.Liquid.Add(excluded...))
+}
+
+func (s *KeeperSuite) TestSupplyBreakdown_UnknownModuleAccount() {
+	params := types.DefaultParams()
+	params.ExcludedModuleAccounts = append(params.ExcludedModuleAccounts, "unknown")
+	params.SnapshotInterval = 1
+	s.keeper.SetParams(s.ctx, params)
+
+	_, err := s.keeper.SupplyBreakdown(s.ctx, &types.SupplyRequest{})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+	_, err = s.keeper.ExcludedSupply(s.ctx, &types.SupplyRequest{})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	// the failed snapshot is skipped
+	s.ctx = s.ctx.WithBlockHeight(1)
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	_, found := s.keeper.GetSupplySnapshot(s.ctx, 1)
+	s.Require().False(found)
+}
+
+func (s *KeeperSuite) TestSupplySnapshots() {
+	params := types.DefaultParams()
+	params.SnapshotInterval = 10
+	params.MaxSnapshots = 3
+	s.keeper.SetParams(s.ctx, params)
+
+	for height := int64(1); height <= 60; height++ {
+		s.ctx = s.ctx.WithBlockHeight(height).WithBlockTime(s.ctx.BlockTime().Add(time.Second))
+		s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	}
+
+	// the snapshots older than the maximum number of snapshots kept are pruned
+	for height, found := range map[int64]bool{5: false, 10: false, 30: false, 40: true, 50: true, 60: true} {
+		_, ok := s.keeper.GetSupplySnapshot(s.ctx, height)
+		s.Require().Equal(found, ok, height)
+	}
+
+	snapshot, _ := s.keeper.GetSupplySnapshot(s.ctx, 60)
+	breakdown, err := s.keeper.GetSupplyBreakdown(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.app.AppCodec().MustMarshal(&breakdown), s.app.AppCodec().MustMarshal(&snapshot.Breakdown))
+	s.Require().Equal(s.ctx.BlockTime(), snapshot.Time)
+
+	res, err := s.keeper.SupplySnapshots(s.ctx, &types.QuerySupplySnapshotsRequest{StartHeight: 45})
+	s.Require().NoError(err)
+	s.Require().Len(res.Snapshots, 2)
+	s.Require().Equal(int64(50), res.Snapshots[0].Height)
+	s.Require().Equal(int64(60), res.Snapshots[1].Height)
+
+	res, err = s.keeper.SupplySnapshots(s.ctx, &types.QuerySupplySnapshotsRequest{EndHeight: 55, Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err)
+	s.Require().Len(res.Snapshots, 1)
+	s.Require().Equal(int64(40), res.Snapshots[0].Height)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	_, err = s.keeper.SupplySnapshots(s.ctx, &types.QuerySupplySnapshotsRequest{StartHeight: 50, EndHeight: 40})
+	s.Require().Error(err)
+}
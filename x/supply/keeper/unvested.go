@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// continuousRefreshInterval is the interval, in seconds, at which the cached unvested
+// coins of a continuous vesting account are updated. Such accounts vest every second,
+// so that their cached unvested coins are overstated by at most the coins vested in an
+// interval, rather than updating every account at every block.
+const continuousRefreshInterval = int64(10 * 60)
+
+// nextUnvestedChange returns the next time, in unix seconds, the unvested coins of a
+// vesting account change with its vesting schedule, if any. The changes of continuous
+// vesting accounts are sampled every continuousRefreshInterval.
+func nextUnvestedChange(vacc vestexported.VestingAccount, now int64) (int64, bool) {
+	switch vacc := vacc.(type) {
+	case *vestingtypes.PermanentLockedAccount:
+		return 0, false
+
+	case *vestingtypes.ContinuousVestingAccount:
+		if now >= vacc.EndTime {
+			return 0, false
+		}
+		return min(max(now, vacc.StartTime)+continuousRefreshInterval, vacc.EndTime), true
+
+	case *vestingtypes.PeriodicVestingAccount:
+		end := vacc.StartTime
+		for _, period := range vacc.VestingPeriods {
+			end += period.Length
+			if end > now {
+				return end, true
+			}
+		}
+		return 0, false
+	}
+
+	if now >= vacc.GetEndTime() {
+		return 0, false
+	}
+	return vacc.GetEndTime(), true
+}
+
+// RebuildUnvestedSupply caches the unvested supply of the indexed vesting accounts
+// which are not excluded, so that the liquid supply queries don't walk the vesting
+// accounts. It is called at genesis, by migrations and when the excluded accounts
+// change.
+func (k Keeper) RebuildUnvestedSupply(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.UnvestedSupplyKeyPrefix, types.UnvestedAccountKeyPrefix, types.UnvestedRefreshKeyPrefix} {
+		iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	var addresses []sdk.AccAddress
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addresses = append(addresses, addr)
+		return false
+	})
+
+	excluded := k.getExcludedAddressSet(ctx)
+	for _, addr := range addresses {
+		k.refreshUnvestedCoins(ctx, addr, excluded)
+	}
+}
+
+// UpdateUnvestedSupply updates the cached unvested supply with the unvested coins of
+// the vesting accounts queued until the block time, as their unvested coins can have
+// changed. It is called at the end of each block.
+func (k Keeper) UpdateUnvestedSupply(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := types.UnvestedRefreshTimePrefix(ctx.BlockTime().UnixNano() + 1)
+	iterator := store.Iterator(types.UnvestedRefreshKeyPrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	excluded := k.getExcludedAddressSet(ctx)
+	refreshed := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		store.Delete(key)
+
+		addr := types.AddressFromUnvestedRefreshKey(key)
+		if refreshed[string(addr)] {
+			continue
+		}
+		refreshed[string(addr)] = true
+		k.refreshUnvestedCoins(ctx, addr, excluded)
+	}
+}
+
+// ScheduleUnvestedRefresh queues a vesting account to update its unvested coins at
+// the given time
+func (k Keeper) ScheduleUnvestedRefresh(ctx sdk.Context, t time.Time, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnvestedRefreshKey(t.UnixNano(), addr), []byte{})
+}
+
+// refreshUnvestedCoins updates the cached unvested coins of an account, which are none
+// for excluded accounts, and queues the account at the next change of its vesting
+// schedule
+func (k Keeper) refreshUnvestedCoins(ctx sdk.Context, addr sdk.AccAddress, excluded map[string]bool) {
+	var unvested sdk.Coins
+	vacc, ok := k.accountKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+	if ok && !excluded[addr.String()] {
+		unvested = k.bankKeeper.LockedCoins(ctx, addr)
+		if next, ok := nextUnvestedChange(vacc, ctx.BlockTime().Unix()); ok {
+			k.ScheduleUnvestedRefresh(ctx, time.Unix(next, 0), addr)
+		}
+	}
+
+	cached := k.GetCachedUnvestedCoins(ctx, addr)
+	if cached.Equal(unvested) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range cached {
+		store.Delete(types.UnvestedAccountKey(addr, coin.Denom))
+		k.addCachedUnvestedSupply(ctx, coin.Denom, coin.Amount.Neg())
+	}
+	for _, coin := range unvested {
+		store.Set(types.UnvestedAccountKey(addr, coin.Denom), mustMarshalInt(coin.Amount))
+		k.addCachedUnvestedSupply(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// GetCachedUnvestedCoins returns the cached unvested coins of a vesting account
+func (k Keeper) GetCachedUnvestedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnvestedAccountPrefix(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var coins sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		coins = append(coins, sdk.NewCoin(string(iterator.Key()), mustUnmarshalInt(iterator.Value())))
+	}
+	return coins
+}
+
+// GetCachedUnvestedSupply returns the cached unvested supply of all the denoms
+func (k Keeper) GetCachedUnvestedSupply(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UnvestedSupplyKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var coins sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		coins = append(coins, sdk.NewCoin(string(iterator.Key()), mustUnmarshalInt(iterator.Value())))
+	}
+	return coins
+}
+
+// GetCachedUnvestedSupplyOf returns the cached unvested supply of a denom
+func (k Keeper) GetCachedUnvestedSupplyOf(ctx sdk.Context, denom string) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.UnvestedSupplyKey(denom))
+
+	if b == nil {
+		return math.ZeroInt()
+	}
+	return mustUnmarshalInt(b)
+}
+
+// addCachedUnvestedSupply adds an amount, which can be negative, to the cached
+// unvested supply of a denom
+func (k Keeper) addCachedUnvestedSupply(ctx sdk.Context, denom string, amount math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	total := k.GetCachedUnvestedSupplyOf(ctx, denom).Add(amount)
+
+	if total.IsZero() {
+		store.Delete(types.UnvestedSupplyKey(denom))
+		return
+	}
+	store.Set(types.UnvestedSupplyKey(denom), mustMarshalInt(total))
+}
+
+// GetLiquidSupplyOf returns the liquid supply of a denom, computed from the cached
+// unvested supply and the balances of the excluded accounts in the denom
+func (k Keeper) GetLiquidSupplyOf(ctx sdk.Context, denom string) sdk.Coin {
+	return k.getLiquidSupplyOf(ctx, denom, k.getExcludedAddresses(ctx))
+}
+
+// getLiquidSupplyOf returns the liquid supply of a denom, less the balances of the
+// excluded addresses
+func (k Keeper) getLiquidSupplyOf(ctx sdk.Context, denom string, excluded []sdk.AccAddress) sdk.Coin {
+	liquid := k.bankKeeper.GetSupply(ctx, denom).Amount.Sub(k.GetCachedUnvestedSupplyOf(ctx, denom))
+	for _, addr := range excluded {
+		liquid = liquid.Sub(k.bankKeeper.GetBalance(ctx, addr, denom).Amount)
+	}
+	return sdk.NewCoin(denom, liquid)
+}
+
+func mustMarshalInt(amount math.Int) []byte {
+	b, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func mustUnmarshalInt(b []byte) math.Int {
+	var amount math.Int
+	if err := amount.Unmarshal(b); err != nil {
+		panic(err)
+	}
+	return amount
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/keeper"
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// createVestingAccount creates a continuous vesting account locking the funds for an
+// hour
+func (s *KeeperSuite) createVestingAccount(to sdk.AccAddress, funds sdk.Coins) {
+	from := sdk.AccAddress([]byte("vesting_funder______"))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, from, funds))
+
+	vestingServer := vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper)
+	msg := vestingtypes.NewMsgCreateVestingAccount(from, to, funds, s.ctx.BlockTime().Add(time.Hour).Unix(), false)
+	_, err := vestingServer.CreateVestingAccount(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperSuite) TestLiquidSupplyByDenom() {
+	holder := sdk.AccAddress([]byte("other_denom_holder__"))
+	other := sdk.NewCoins(sdk.NewInt64Coin("other", 700))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, holder, other))
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	s.createVestingAccount(sdk.AccAddress([]byte("continuous_vesting__")), funds)
+
+	// the unvested supply of the queries is cached at the end of the block
+	before, err := s.keeper.LiquidSupply(s.ctx, &types.SupplyRequest{Denom: sdk.DefaultBondDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(before.Supply.AmountOf(sdk.DefaultBondDenom), s.liquidSupply().AmountOf(sdk.DefaultBondDenom))
+	excluded, err := s.keeper.ExcludedSupply(s.ctx, &types.SupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(excluded.Unvested)
+
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	liquid := s.liquidSupply()
+	expected := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, liquid.AmountOf(sdk.DefaultBondDenom)))
+	s.Require().Equal(expected.Add(funds...), before.Supply)
+
+	res, err := s.keeper.LiquidSupply(s.ctx, &types.SupplyRequest{Denom: sdk.DefaultBondDenom})
+	s.Require().NoError(err)
+	s.Require().Equal(expected, res.Supply)
+	s.Require().Equal(funds.AmountOf(sdk.DefaultBondDenom), s.keeper.GetCachedUnvestedSupplyOf(s.ctx, sdk.DefaultBondDenom))
+	excluded, err = s.keeper.ExcludedSupply(s.ctx, &types.SupplyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(funds, excluded.Unvested)
+
+	res, err = s.keeper.TotalSupply(s.ctx, &types.SupplyRequest{Denom: "other"})
+	s.Require().NoError(err)
+	s.Require().Equal(other, res.Supply)
+	s.Require().Nil(res.Pagination)
+
+	// the liquid supply of all the denoms is paginated by denom
+	var paginated sdk.Coins
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		res, err = s.keeper.LiquidSupply(s.ctx, &types.SupplyRequest{Pagination: pageReq})
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(res.Supply), 1)
+		paginated = paginated.Add(res.Supply...)
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	s.Require().Equal(liquid, paginated)
+
+	_, err = s.keeper.LiquidSupply(s.ctx, &types.SupplyRequest{Denom: "1invalid"})
+	s.Require().Error(err)
+	_, err = s.keeper.TotalSupply(s.ctx, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperSuite) TestMigrate3to4() {
+	funds := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	s.createVestingAccount(sdk.AccAddress([]byte("continuous_vesting__")), funds)
+	s.Require().NoError(s.keeper.IndexNewAccounts(s.ctx))
+	s.Require().True(s.keeper.GetCachedUnvestedSupplyOf(s.ctx, sdk.DefaultBondDenom).IsZero())
+
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate3to4(s.ctx))
+	s.Require().Equal(s.keeper.GetUnvestedSupply(s.ctx).AmountOf(sdk.DefaultBondDenom), s.keeper.GetCachedUnvestedSupplyOf(s.ctx, sdk.DefaultBondDenom))
+	s.Require().Equal(funds.AmountOf(sdk.DefaultBondDenom), s.keeper.GetCachedUnvestedSupplyOf(s.ctx, sdk.DefaultBondDenom))
+}
+
+func (s *KeeperSuite) TestUnvestedSupplyUpdates() {
+	bondDenom, err := s.app.StakingKeeper.BondDenom(s.ctx)
+	s.Require().NoError(err)
+	vals, err := s.app.StakingKeeper.GetBondedValidatorsByPower(s.ctx)
+	s.Require().NoError(err)
+
+	continuous := sdk.AccAddress([]byte("continuous_vesting__"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 3_600))
+	s.createVestingAccount(continuous, funds)
+
+	permanent := sdk.AccAddress([]byte("permanent_locked____"))
+	from := sdk.AccAddress([]byte("vesting_funder______"))
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, from, funds))
+	_, err = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper).CreatePermanentLockedAccount(s.ctx, vestingtypes.NewMsgCreatePermanentLockedAccount(from, permanent, funds))
+	s.Require().NoError(err)
+
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal(funds.Add(funds...), s.keeper.GetCachedUnvestedSupply(s.ctx))
+
+	// the cached unvested coins of continuous vesting accounts are not updated every block
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Minute))
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal(funds, s.keeper.GetCachedUnvestedCoins(s.ctx, continuous))
+	s.Require().NotEqual(s.app.BankKeeper.LockedCoins(s.ctx, continuous), s.keeper.GetCachedUnvestedCoins(s.ctx, continuous))
+
+	// the cached unvested coins follow the vesting schedule
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(10*time.Minute - time.Minute))
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal(s.app.BankKeeper.LockedCoins(s.ctx, continuous), s.keeper.GetCachedUnvestedCoins(s.ctx, continuous))
+	s.Require().Equal(s.keeper.GetUnvestedSupply(s.ctx), s.keeper.GetCachedUnvestedSupply(s.ctx))
+
+	// delegated vesting coins are not locked
+	_, err = stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper).Delegate(s.ctx, stakingtypes.NewMsgDelegate(
+		continuous.String(), vals[0].GetOperator(), sdk.NewInt64Coin(bondDenom, 1_000),
+	))
+	s.Require().NoError(err)
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Equal(s.app.BankKeeper.LockedCoins(s.ctx, continuous), s.keeper.GetCachedUnvestedCoins(s.ctx, continuous))
+	s.Require().Equal(s.keeper.GetUnvestedSupply(s.ctx), s.keeper.GetCachedUnvestedSupply(s.ctx))
+
+	// the fully vested accounts are removed from the cache
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(supply.EndBlocker(s.ctx, s.keeper))
+	s.Require().Empty(s.keeper.GetCachedUnvestedCoins(s.ctx, continuous))
+	s.Require().Equal(funds, s.keeper.GetCachedUnvestedSupply(s.ctx))
+	s.Require().Equal(s.keeper.GetUnvestedSupply(s.ctx), s.keeper.GetCachedUnvestedSupply(s.ctx))
+
+	// the excluded accounts are not cached
+	params := s.keeper.GetParams(s.ctx)
+	params.ExcludedAddresses = append(params.ExcludedAddresses, permanent.String())
+	_, err = keeper.NewMsgServerImpl(s.keeper).UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetCachedUnvestedSupply(s.ctx))
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/supply/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// vestingEndTime returns the time at which a vesting account is fully vested, which
+// is never for permanently locked accounts
+func vestingEndTime(vacc vestexported.VestingAccount) int64 {
+	if _, ok := vacc.(*vestingtypes.PermanentLockedAccount); ok {
+		return math.MaxInt64
+	}
+	return vacc.GetEndTime()
+}
+
+// IndexVestingAccount adds an account to the vesting accounts index if it is a vesting
+// account which is not fully vested, and queues it to cache its unvested coins
+func (k Keeper) IndexVestingAccount(ctx sdk.Context, account sdk.AccountI) {
+	vacc, ok := account.(vestexported.VestingAccount)
+	if !ok {
+		return
+	}
+
+	endTime := vestingEndTime(vacc)
+	if endTime <= ctx.BlockTime().Unix() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VestingIndexKey(endTime, vacc.GetAddress()), []byte{})
+	k.ScheduleUnvestedRefresh(ctx, ctx.BlockTime(), vacc.GetAddress())
+}
+
+// IterateVestingAccounts iterates over the addresses of the indexed vesting accounts
+func (k Keeper) IterateVestingAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.VestingIndexKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.AddressFromVestingIndexKey(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetVestingAccounts returns the addresses of the indexed vesting accounts
+func (k Keeper) GetVestingAccounts(ctx sdk.Context) types.VestingAccounts {
+	addresses := []string{}
+
+	k.IterateVestingAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addresses = append(addresses, addr.String())
+		return false
+	})
+
+	return types.VestingAccounts{
+		Addresses: addresses,
+	}
+}
+
+// IndexNewAccounts adds the vesting accounts created since the last call to the
+// vesting accounts index, whichever way they were created
+func (k Keeper) IndexNewAccounts(ctx sdk.Context) error {
+	next := k.getNextAccountNumber(ctx)
+
+	err := k.accountNumbers.Walk(ctx, new(collections.Range[uint64]).StartInclusive(next),
+		func(accountNumber uint64, addr sdk.AccAddress) (bool, error) {
+			if account := k.accountKeeper.GetAccount(ctx, addr); account != nil {
+				k.IndexVestingAccount(ctx, account)
+			}
+			next = accountNumber + 1
+			return false, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	k.setNextAccountNumber(ctx, next)
+	return nil
+}
+
+// PruneVestedAccounts removes the fully vested accounts from the vesting accounts
+// index, and queues them to clear their cached unvested coins
+func (k Keeper) PruneVestedAccounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := types.VestingIndexTimePrefix(ctx.BlockTime().Unix() + 1)
+	iterator := store.Iterator(types.VestingIndexKeyPrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		k.ScheduleUnvestedRefresh(ctx, ctx.BlockTime(), types.AddressFromVestingIndexKey(key))
+	}
+}
+
+// RebuildVestingAccounts rebuilds the vesting accounts index from all the accounts
+func (k Keeper) RebuildVestingAccounts(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingIndexKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	var next uint64
+	k.accountKeeper.IterateAccounts(ctx, func(account sdk.AccountI) bool {
+		k.IndexVestingAccount(ctx, account)
+		if account.GetAccountNumber() >= next {
+			next = account.GetAccountNumber() + 1
+		}
+		return false
+	})
+
+	k.setNextAccountNumber(ctx, next)
+}
+
+// getNextAccountNumber returns the next account number to check for new vesting accounts
+func (k Keeper) getNextAccountNumber(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.NextAccountNumberKey)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// setNextAccountNumber persists the next account number to check for new vesting accounts
+func (k Keeper) setNextAccountNumber(ctx sdk.Context, accountNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, accountNumber)
+	store.Set(types.NextAccountNumberKey, b)
+}
@@ -0,0 +1,1515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/supply/v1/breakdown.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SupplyBreakdown itemizes the total supply of the coins. The liquid supply is the
+// total supply less the unvested supply and the balances of the excluded accounts;
+// the staked supply and the tier-locked positions are held by the staking pools.
+type SupplyBreakdown struct {
+	// total is the total supply of the coins
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// unvested is the supply locked in the vesting accounts which are not excluded
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// accounts are the balances of the module accounts and addresses excluded from the
+	// liquid supply
+	Accounts []ExcludedBalance `protobuf:"bytes,3,rep,name=accounts,proto3" json:"accounts"`
+	// staked is the supply bonded to validators
+	Staked github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=staked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"staked"`
+	// tier_locked are the amounts locked in the tier positions, by tier
+	TierLocked []TierLockedSupply `protobuf:"bytes,5,rep,name=tier_locked,json=tierLocked,proto3" json:"tier_locked"`
+	// burned is the cumulative total of the coins burned through x/inflation, which are
+	// no longer part of the total supply
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// liquid is the liquid supply of the coins
+	Liquid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=liquid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"liquid"`
+}
+
+func (m *SupplyBreakdown) Reset()         { *m = SupplyBreakdown{} }
+func (m *SupplyBreakdown) String() string { return proto.CompactTextString(m) }
+func (*SupplyBreakdown) ProtoMessage()    {}
+func (*SupplyBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aca8256afb161a4, []int{0}
+}
+func (m *SupplyBreakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyBreakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyBreakdown.Merge(m, src)
+}
+func (m *SupplyBreakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyBreakdown proto.InternalMessageInfo
+
+func (m *SupplyBreakdown) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetAccounts() []ExcludedBalance {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetStaked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Staked
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetTierLocked() []TierLockedSupply {
+	if m != nil {
+		return m.TierLocked
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *SupplyBreakdown) GetLiquid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Liquid
+	}
+	return nil
+}
+
+// ExcludedBalance is the balance of an account excluded from the liquid supply
+type ExcludedBalance struct {
+	// module_name is the name of the excluded module account, empty for an excluded
+	// address
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// address is the address of the account
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// balance is the balance of the account
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *ExcludedBalance) Reset()         { *m = ExcludedBalance{} }
+func (m *ExcludedBalance) String() string { return proto.CompactTextString(m) }
+func (*ExcludedBalance) ProtoMessage()    {}
+func (*ExcludedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aca8256afb161a4, []int{1}
+}
+func (m *ExcludedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExcludedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExcludedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExcludedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedBalance.Merge(m, src)
+}
+func (m *ExcludedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExcludedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedBalance proto.InternalMessageInfo
+
+func (m *ExcludedBalance) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *ExcludedBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExcludedBalance) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// TierLockedSupply is the amount locked in the positions of a tier
+type TierLockedSupply struct {
+	// tier_id is the id of the tier
+	TierId uint32 `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	// amount is the amount locked in the positions of the tier, whether delegated,
+	// unbonding or undelegated, valued at the current state of the delegations,
+	// unbonding entries and balances of the positions
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *TierLockedSupply) Reset()         { *m = TierLockedSupply{} }
+func (m *TierLockedSupply) String() string { return proto.CompactTextString(m) }
+func (*TierLockedSupply) ProtoMessage()    {}
+func (*TierLockedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aca8256afb161a4, []int{2}
+}
+func (m *TierLockedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TierLockedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TierLockedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TierLockedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TierLockedSupply.Merge(m, src)
+}
+func (m *TierLockedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *TierLockedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TierLockedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TierLockedSupply proto.InternalMessageInfo
+
+func (m *TierLockedSupply) GetTierId() uint32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+func (m *TierLockedSupply) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// SupplySnapshot is the supply breakdown stored at a block height
+type SupplySnapshot struct {
+	// height is the block height of the snapshot
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the snapshot
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// breakdown is the supply breakdown at the block height
+	Breakdown SupplyBreakdown `protobuf:"bytes,3,opt,name=breakdown,proto3" json:"breakdown"`
+}
+
+func (m *SupplySnapshot) Reset()         { *m = SupplySnapshot{} }
+func (m *SupplySnapshot) String() string { return proto.CompactTextString(m) }
+func (*SupplySnapshot) ProtoMessage()    {}
+func (*SupplySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aca8256afb161a4, []int{3}
+}
+func (m *SupplySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplySnapshot.Merge(m, src)
+}
+func (m *SupplySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplySnapshot proto.InternalMessageInfo
+
+func (m *SupplySnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SupplySnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SupplySnapshot) GetBreakdown() SupplyBreakdown {
+	if m != nil {
+		return m.Breakdown
+	}
+	return SupplyBreakdown{}
+}
+
+func init() {
+	proto.RegisterType((*SupplyBreakdown)(nil), "chainmain.supply.v1.SupplyBreakdown")
+	proto.RegisterType((*ExcludedBalance)(nil), "chainmain.supply.v1.ExcludedBalance")
+	proto.RegisterType((*TierLockedSupply)(nil), "chainmain.supply.v1.TierLockedSupply")
+	proto.RegisterType((*SupplySnapshot)(nil), "chainmain.supply.v1.SupplySnapshot")
+}
+
+func init() {
+	proto.RegisterFile("chainmain/supply/v1/breakdown.proto", fileDescriptor_3aca8256afb161a4)
+}
+
+var fileDescriptor_3aca8256afb161a4 = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xd6, 0xbf, 0x73, 0x05, 0x43, 0x06, 0x41, 0xe8, 0x21, 0xad, 0x0a, 0x48, 0xbd, 0xd4,
+	0xa6, 0x43, 0x48, 0x9c, 0x83, 0x40, 0x20, 0x4d, 0x20, 0x65, 0x3b, 0x71, 0x99, 0x9c, 0xd8, 0xa4,
+	0x56, 0x93, 0x38, 0xc4, 0x4e, 0x59, 0xbf, 0x45, 0x3f, 0x03, 0x47, 0xc4, 0x8d, 0x2f, 0xb1, 0xe3,
+	0x8e, 0x9c, 0x18, 0x6a, 0xbf, 0x08, 0x8a, 0x9d, 0x14, 0x54, 0x6d, 0xb7, 0xf6, 0x92, 0xf8, 0x39,
+	0xef, 0xfd, 0x7e, 0xbf, 0xbc, 0x67, 0xff, 0xc0, 0x93, 0x60, 0x4a, 0x78, 0x12, 0x13, 0x9e, 0x60,
+	0x99, 0xa7, 0x69, 0xb4, 0xc0, 0xf3, 0x09, 0xf6, 0x33, 0x46, 0x66, 0x54, 0x7c, 0x4d, 0x50, 0x9a,
+	0x09, 0x25, 0xe0, 0xfd, 0x4d, 0x12, 0x32, 0x49, 0x68, 0x3e, 0xe9, 0x39, 0x81, 0x90, 0xb1, 0x90,
+	0xd8, 0x27, 0x92, 0xe1, 0xf9, 0xc4, 0x67, 0x8a, 0x4c, 0x70, 0x20, 0x78, 0x59, 0xd4, 0x7b, 0x10,
+	0x8a, 0x50, 0xe8, 0x25, 0x2e, 0x56, 0xe5, 0x6e, 0x3f, 0x14, 0x22, 0x8c, 0x18, 0xd6, 0x91, 0x9f,
+	0x7f, 0xc6, 0x8a, 0xc7, 0x4c, 0x2a, 0x12, 0xa7, 0x26, 0x61, 0xf8, 0xad, 0x09, 0x8e, 0x4e, 0x35,
+	0x89, 0x5b, 0xa9, 0x80, 0x04, 0x34, 0x95, 0x50, 0x24, 0xb2, 0xad, 0x41, 0x7d, 0xd4, 0x3d, 0x7e,
+	0x8c, 0x0c, 0x35, 0x2a, 0xa8, 0x51, 0x49, 0x8d, 0x5e, 0x0b, 0x9e, 0xb8, 0xcf, 0x2f, 0x7f, 0xf7,
+	0x6b, 0xdf, 0xaf, 0xfb, 0xa3, 0x90, 0xab, 0x69, 0xee, 0xa3, 0x40, 0xc4, 0xb8, 0xd4, 0x69, 0x5e,
+	0x63, 0x49, 0x67, 0x58, 0x2d, 0x52, 0x26, 0x75, 0x81, 0xf4, 0x0c, 0x32, 0x0c, 0x41, 0x27, 0x4f,
+	0xe6, 0x4c, 0x2a, 0x46, 0xed, 0x83, 0xdd, 0xb3, 0x6c, 0xc0, 0xe1, 0x5b, 0xd0, 0x21, 0x41, 0x20,
+	0xf2, 0x44, 0x49, 0xbb, 0xae, 0x89, 0x9e, 0xa2, 0x1b, 0xda, 0x8b, 0xde, 0x5c, 0x04, 0x51, 0x4e,
+	0x19, 0x75, 0x49, 0x44, 0x92, 0x80, 0xb9, 0x8d, 0x82, 0xd3, 0xdb, 0xd4, 0xc2, 0x00, 0xb4, 0xa4,
+	0x22, 0x33, 0x46, 0xed, 0xc6, 0xee, 0xe5, 0x96, 0xd0, 0xf0, 0x04, 0x74, 0x15, 0x67, 0xd9, 0x79,
+	0x24, 0x82, 0x82, 0xa9, 0xa9, 0x99, 0x9e, 0xdd, 0xa8, 0xf7, 0x8c, 0xb3, 0xec, 0x44, 0xa7, 0x95,
+	0xd3, 0x33, 0x82, 0x81, 0xda, 0xec, 0x17, 0x92, 0xfd, 0x3c, 0x4b, 0x18, 0xb5, 0x5b, 0x7b, 0x90,
+	0x6c, 0xa0, 0x0b, 0x92, 0x88, 0x7f, 0xc9, 0x39, 0xb5, 0xdb, 0x7b, 0x20, 0x31, 0xd0, 0xc3, 0x9f,
+	0x16, 0x38, 0xda, 0x1a, 0x10, 0xec, 0x83, 0x6e, 0x2c, 0x68, 0x1e, 0xb1, 0xf3, 0x84, 0xc4, 0xcc,
+	0xb6, 0x06, 0xd6, 0xe8, 0xd0, 0x03, 0x66, 0xeb, 0x03, 0x89, 0x19, 0xb4, 0x41, 0x9b, 0x50, 0x9a,
+	0x31, 0x29, 0xed, 0x03, 0xfd, 0xb1, 0x0a, 0x21, 0x03, 0x6d, 0xdf, 0xa0, 0xd8, 0xf5, 0xdd, 0x8b,
+	0xae, 0xb0, 0x87, 0x4b, 0x0b, 0xdc, 0xdb, 0x1e, 0x13, 0x7c, 0x04, 0xda, 0x7a, 0xc4, 0x9c, 0x6a,
+	0xc9, 0x77, 0xbc, 0x56, 0x11, 0xbe, 0xd7, 0x8d, 0x24, 0x71, 0x71, 0xd6, 0xf6, 0x71, 0x1f, 0x4a,
+	0xe8, 0xe1, 0x0f, 0x0b, 0xdc, 0x35, 0x42, 0x4e, 0x13, 0x92, 0xca, 0xa9, 0x50, 0xf0, 0x21, 0x68,
+	0x4d, 0x19, 0x0f, 0xa7, 0x4a, 0xeb, 0xa9, 0x7b, 0x65, 0x04, 0x5f, 0x81, 0x46, 0xe1, 0x15, 0xba,
+	0x77, 0xdd, 0xe3, 0x1e, 0x32, 0x46, 0x82, 0x2a, 0x23, 0x41, 0x67, 0x95, 0x91, 0xb8, 0x9d, 0x42,
+	0xce, 0xf2, 0xba, 0x6f, 0x79, 0xba, 0x02, 0xbe, 0x03, 0x87, 0x1b, 0x47, 0xb3, 0xeb, 0x03, 0xeb,
+	0xd6, 0x3b, 0xb7, 0xe5, 0x3b, 0xe5, 0x11, 0xfe, 0x57, 0xec, 0x7e, 0xbc, 0x5c, 0x39, 0xd6, 0xd5,
+	0xca, 0xb1, 0xfe, 0xac, 0x1c, 0x6b, 0xb9, 0x76, 0x6a, 0x57, 0x6b, 0xa7, 0xf6, 0x6b, 0xed, 0xd4,
+	0x3e, 0xbd, 0xfc, 0xff, 0xd7, 0xb3, 0x45, 0xaa, 0xc4, 0x58, 0x64, 0xe1, 0x58, 0xb3, 0x60, 0xfd,
+	0x1c, 0x6b, 0x93, 0xbd, 0xa8, 0x6c, 0x56, 0x77, 0xc3, 0x6f, 0x69, 0xf9, 0x2f, 0xfe, 0x0e, 0x00,
+	0x99, 0xb6, 0xd7, 0x8a, 0x87, 0x05, 0x00, 0x00,
+}
+
+func (m *SupplyBreakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyBreakdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyBreakdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquid) > 0 {
+		for iNdEx := len(m.Liquid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TierLocked) > 0 {
+		for iNdEx := len(m.TierLocked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TierLocked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Staked) > 0 {
+		for iNdEx := len(m.Staked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Staked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExcludedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExcludedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExcludedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBreakdown(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintBreakdown(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TierLockedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TierLockedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TierLockedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBreakdown(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TierId != 0 {
+		i = encodeVarintBreakdown(dAtA, i, uint64(m.TierId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBreakdown(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintBreakdown(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintBreakdown(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBreakdown(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBreakdown(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupplyBreakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.Staked) > 0 {
+		for _, e := range m.Staked {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.TierLocked) > 0 {
+		for _, e := range m.TierLocked {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	if len(m.Liquid) > 0 {
+		for _, e := range m.Liquid {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExcludedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovBreakdown(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBreakdown(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TierLockedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TierId != 0 {
+		n += 1 + sovBreakdown(uint64(m.TierId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBreakdown(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBreakdown(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBreakdown(uint64(l))
+	l = m.Breakdown.Size()
+	n += 1 + l + sovBreakdown(uint64(l))
+	return n
+}
+
+func sovBreakdown(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBreakdown(x uint64) (n int) {
+	return sovBreakdown(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SupplyBreakdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyBreakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyBreakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ExcludedBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Staked = append(m.Staked, types.Coin{})
+			if err := m.Staked[len(m.Staked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TierLocked = append(m.TierLocked, TierLockedSupply{})
+			if err := m.TierLocked[len(m.TierLocked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquid = append(m.Liquid, types.Coin{})
+			if err := m.Liquid[len(m.Liquid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExcludedBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExcludedBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExcludedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TierLockedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TierLockedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TierLockedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierId", wireType)
+			}
+			m.TierId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TierId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBreakdown
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBreakdown(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBreakdown
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBreakdown(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBreakdown
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBreakdown
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBreakdown
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBreakdown
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBreakdown
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBreakdown        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBreakdown          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBreakdown = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,14 +2,22 @@ package types
 
 import (
 	context "context"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // BankKeeper defines the bank contract that must be fulfilled when
 // creating a x/supply keeper.
 type BankKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
@@ -18,5 +26,36 @@ type BankKeeper interface {
 // creating a x/supply keeper.
 type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	IterateAccounts(ctx context.Context, cb func(account sdk.AccountI) (stop bool))
 }
+
+// InflationKeeper defines the inflation contract that must be fulfilled when
+// creating a x/supply keeper.
+type InflationKeeper interface {
+	GetTotalBurned(ctx context.Context) (sdk.Coins, error)
+}
+
+// AccountNumberIndex defines the x/auth index of the accounts by account number, used
+// to find the accounts created since the last block.
+type AccountNumberIndex interface {
+	Walk(
+		ctx context.Context,
+		ranger collections.Ranger[uint64],
+		walkFunc func(accountNumber uint64, addr sdk.AccAddress) (stop bool, err error),
+	) error
+}
+
+// StakingKeeper defines the staking contract that must be fulfilled when
+// creating a x/supply keeper.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+	UnbondingTime(ctx context.Context) (time.Duration, error)
+}
+
+// TieredRewardsKeeper defines the tieredrewards contract that must be fulfilled when
+// creating a x/supply keeper.
+type TieredRewardsKeeper interface {
+	IterateLockedAmountsByTier(ctx context.Context, cb func(tierId uint32, amount math.Int) (stop bool)) error
+}
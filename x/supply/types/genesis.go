@@ -1,13 +1,14 @@
 package types
 
-// DefaultGenesis returns the default Capability genesis state
+// DefaultGenesis returns the default supply genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	// Since the genesis state for supply module is empty, we don't have any validations to perform.
-	return nil
+	return gs.Params.Validate()
 }
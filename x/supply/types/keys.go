@@ -1,5 +1,12 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "supply"
@@ -8,5 +15,99 @@ const (
 	StoreKey = ModuleName
 )
 
-// VestingAccountsKey for storing vesting account addresses
-var VestingAccountsKey = []byte("vestingAccounts")
+var (
+	// VestingAccountsKey for storing vesting account addresses at genesis, replaced by
+	// the vesting accounts index
+	VestingAccountsKey = []byte("vestingAccounts")
+
+	// ParamsKey for storing the module parameters
+	ParamsKey = []byte("params")
+
+	// VestingIndexKeyPrefix for indexing the vesting accounts by vesting end time
+	VestingIndexKeyPrefix = []byte("vestingIndex/")
+
+	// NextAccountNumberKey for storing the next account number to check for new
+	// vesting accounts
+	NextAccountNumberKey = []byte("nextAccountNumber")
+
+	// SupplySnapshotKeyPrefix for storing the supply snapshots by height
+	SupplySnapshotKeyPrefix = []byte("supplySnapshot/")
+
+	// UnvestedSupplyKeyPrefix for caching the unvested supply by denom
+	UnvestedSupplyKeyPrefix = []byte("unvestedSupply/")
+
+	// UnvestedAccountKeyPrefix for caching the unvested coins of each vesting account
+	// by denom, which make up the cached unvested supply
+	UnvestedAccountKeyPrefix = []byte("unvestedAccount/")
+
+	// UnvestedRefreshKeyPrefix for queuing the vesting accounts by the time their
+	// unvested coins can change
+	UnvestedRefreshKeyPrefix = []byte("unvestedRefresh/")
+)
+
+// UnvestedSupplyKey returns the key of the cached unvested supply of a denom
+func UnvestedSupplyKey(denom string) []byte {
+	return append(append([]byte{}, UnvestedSupplyKeyPrefix...), denom...)
+}
+
+// UnvestedAccountPrefix returns the prefix of the keys of the cached unvested coins of
+// a vesting account
+func UnvestedAccountPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, UnvestedAccountKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// UnvestedAccountKey returns the key of the cached unvested coins of a denom of a
+// vesting account
+func UnvestedAccountKey(addr sdk.AccAddress, denom string) []byte {
+	return append(UnvestedAccountPrefix(addr), denom...)
+}
+
+// UnvestedRefreshKey returns the key queuing a vesting account at the time, in unix
+// nanoseconds, its unvested coins can change
+func UnvestedRefreshKey(time int64, addr sdk.AccAddress) []byte {
+	key := UnvestedRefreshTimePrefix(time)
+	return append(key, addr...)
+}
+
+// UnvestedRefreshTimePrefix returns the prefix of the keys of the vesting accounts
+// queued at the given time, in unix nanoseconds
+func UnvestedRefreshTimePrefix(time int64) []byte {
+	key := make([]byte, len(UnvestedRefreshKeyPrefix)+8)
+	copy(key, UnvestedRefreshKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(UnvestedRefreshKeyPrefix):], uint64(time))
+	return key
+}
+
+// AddressFromUnvestedRefreshKey returns the vesting account address of an unvested
+// refresh key
+func AddressFromUnvestedRefreshKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[len(UnvestedRefreshKeyPrefix)+8:])
+}
+
+// SupplySnapshotKey returns the key of the supply snapshot at a height
+func SupplySnapshotKey(height int64) []byte {
+	key := make([]byte, len(SupplySnapshotKeyPrefix)+8)
+	copy(key, SupplySnapshotKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(SupplySnapshotKeyPrefix):], uint64(height))
+	return key
+}
+
+// VestingIndexKey returns the key indexing a vesting account by its vesting end time
+func VestingIndexKey(endTime int64, addr sdk.AccAddress) []byte {
+	key := VestingIndexTimePrefix(endTime)
+	return append(key, addr...)
+}
+
+// VestingIndexTimePrefix returns the prefix of the keys of the vesting accounts fully
+// vested at the given time
+func VestingIndexTimePrefix(endTime int64) []byte {
+	key := make([]byte, len(VestingIndexKeyPrefix)+8)
+	copy(key, VestingIndexKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(VestingIndexKeyPrefix):], uint64(endTime))
+	return key
+}
+
+// AddressFromVestingIndexKey returns the vesting account address of a vesting index key
+func AddressFromVestingIndexKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[len(VestingIndexKeyPrefix)+8:])
+}
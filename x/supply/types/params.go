@@ -0,0 +1,96 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	tieredrewardstypes "github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DefaultExcludedModuleAccounts defines the module accounts excluded from the liquid
+// supply by default
+var DefaultExcludedModuleAccounts = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	minttypes.ModuleName,
+	govtypes.ModuleName,
+	tieredrewardstypes.RewardsPoolName,
+}
+
+// DefaultMaxSnapshots is the default number of the most recent supply snapshots kept
+const DefaultMaxSnapshots = 720
+
+// NewParams creates a new Params instance
+func NewParams(excludedModuleAccounts, excludedAddresses []string, snapshotInterval, maxSnapshots uint64) Params {
+	return Params{
+		ExcludedModuleAccounts: excludedModuleAccounts,
+		ExcludedAddresses:      excludedAddresses,
+		SnapshotInterval:       snapshotInterval,
+		MaxSnapshots:           maxSnapshots,
+	}
+}
+
+// DefaultParams returns a default set of parameters, with the supply snapshots disabled
+func DefaultParams() Params {
+	return NewParams(DefaultExcludedModuleAccounts, []string{}, 0, DefaultMaxSnapshots)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateExcludedModuleAccounts(p.ExcludedModuleAccounts); err != nil {
+		return err
+	}
+
+	if err := validateExcludedAddresses(p.ExcludedAddresses); err != nil {
+		return err
+	}
+
+	if p.SnapshotInterval > 0 && p.MaxSnapshots == 0 {
+		return fmt.Errorf("max snapshots must be positive when the snapshots are enabled")
+	}
+
+	return nil
+}
+
+func validateExcludedModuleAccounts(moduleNames []string) error {
+	nameMap := make(map[string]bool)
+
+	for i, name := range moduleNames {
+		if strings.TrimSpace(name) == "" {
+			return fmt.Errorf("empty excluded module account name at index %d", i)
+		}
+
+		if nameMap[name] {
+			return fmt.Errorf("duplicate excluded module account found: %s", name)
+		}
+		nameMap[name] = true
+	}
+
+	return nil
+}
+
+func validateExcludedAddresses(addresses []string) error {
+	addressMap := make(map[string]bool)
+
+	for i, addr := range addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid excluded address at index %d: %s, error: %w", i, addr, err)
+		}
+
+		if addressMap[addr] {
+			return fmt.Errorf("duplicate excluded address found: %s", addr)
+		}
+		addressMap[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,464 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: chainmain/supply/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the supply module.
+type Params struct {
+	// excluded_module_accounts are the names of the module accounts whose balances are
+	// excluded from the liquid supply
+	ExcludedModuleAccounts []string `protobuf:"bytes,1,rep,name=excluded_module_accounts,json=excludedModuleAccounts,proto3" json:"excluded_module_accounts,omitempty"`
+	// excluded_addresses are the addresses, such as foundation wallets, whose balances
+	// are excluded from the liquid supply
+	ExcludedAddresses []string `protobuf:"bytes,2,rep,name=excluded_addresses,json=excludedAddresses,proto3" json:"excluded_addresses,omitempty"`
+	// snapshot_interval is the number of blocks between the supply snapshots, or zero to
+	// disable the snapshots
+	SnapshotInterval uint64 `protobuf:"varint,3,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// max_snapshots is the number of the most recent supply snapshots kept
+	MaxSnapshots uint64 `protobuf:"varint,4,opt,name=max_snapshots,json=maxSnapshots,proto3" json:"max_snapshots,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c15a89203c31dfae, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetExcludedModuleAccounts() []string {
+	if m != nil {
+		return m.ExcludedModuleAccounts
+	}
+	return nil
+}
+
+func (m *Params) GetExcludedAddresses() []string {
+	if m != nil {
+		return m.ExcludedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetSnapshotInterval() uint64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshots() uint64 {
+	if m != nil {
+		return m.MaxSnapshots
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "chainmain.supply.v1.Params")
+}
+
+func init() { proto.RegisterFile("chainmain/supply/v1/params.proto", fileDescriptor_c15a89203c31dfae) }
+
+var fileDescriptor_c15a89203c31dfae = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xbd, 0x4a, 0x03, 0x41,
+	0x14, 0x85, 0xb3, 0x26, 0x04, 0x5c, 0x14, 0xcc, 0x2a, 0xba, 0x06, 0x59, 0x82, 0x36, 0x41, 0xd9,
+	0x1d, 0x82, 0x08, 0x62, 0x97, 0x34, 0x62, 0x21, 0x4a, 0xd2, 0xd9, 0x2c, 0x93, 0xd9, 0x21, 0x59,
+	0xd8, 0xf9, 0x61, 0xee, 0x6c, 0xd8, 0xbc, 0x82, 0x95, 0x8f, 0x62, 0xe1, 0x43, 0x58, 0x06, 0x2b,
+	0x4b, 0x49, 0x0a, 0x1f, 0xc2, 0x46, 0x9c, 0xc9, 0xa4, 0xb1, 0xb9, 0x70, 0xcf, 0x77, 0xce, 0x19,
+	0xe6, 0xfa, 0x1d, 0x32, 0xc5, 0x39, 0x67, 0x38, 0xe7, 0x08, 0x4a, 0x29, 0x8b, 0x39, 0x9a, 0xf5,
+	0x90, 0xc4, 0x0a, 0x33, 0x48, 0xa4, 0x12, 0x5a, 0x04, 0xfb, 0x1b, 0x47, 0x62, 0x1d, 0xc9, 0xac,
+	0xd7, 0x6e, 0x61, 0x96, 0x73, 0x81, 0xcc, 0xb4, 0xbe, 0xf6, 0x31, 0x11, 0xc0, 0x04, 0xa4, 0x66,
+	0x43, 0x76, 0xb1, 0xe8, 0xf4, 0xc7, 0xf3, 0x9b, 0x8f, 0xa6, 0x33, 0xb8, 0xf6, 0x43, 0x5a, 0x91,
+	0xa2, 0xcc, 0x68, 0x96, 0x32, 0x91, 0x95, 0x05, 0x4d, 0x31, 0x21, 0xa2, 0xe4, 0x1a, 0x42, 0xaf,
+	0x53, 0xef, 0x6e, 0x0f, 0x0f, 0x1d, 0xbf, 0x37, 0xb8, 0xbf, 0xa6, 0xc1, 0xad, 0x1f, 0x6c, 0x92,
+	0x38, 0xcb, 0x14, 0x05, 0xa0, 0x10, 0x6e, 0xfd, 0x65, 0x06, 0xe1, 0xc7, 0x5b, 0x7c, 0xb0, 0x7e,
+	0xb2, 0x6f, 0xd9, 0x48, 0xab, 0x9c, 0x4f, 0x86, 0x2d, 0x97, 0xe9, 0xbb, 0x48, 0x70, 0xe1, 0xb7,
+	0x80, 0x63, 0x09, 0x53, 0xa1, 0xd3, 0x9c, 0x6b, 0xaa, 0x66, 0xb8, 0x08, 0xeb, 0x1d, 0xaf, 0xdb,
+	0x18, 0xee, 0x39, 0x70, 0xb7, 0xd6, 0x83, 0x33, 0x7f, 0x97, 0xe1, 0x2a, 0x75, 0x3a, 0x84, 0x0d,
+	0x63, 0xdc, 0x61, 0xb8, 0x1a, 0x39, 0xed, 0xe6, 0xe4, 0xf9, 0xfb, 0xf5, 0xfc, 0xe8, 0xdf, 0x25,
+	0xed, 0x97, 0x07, 0x0f, 0xef, 0xcb, 0xc8, 0x5b, 0x2c, 0x23, 0xef, 0x6b, 0x19, 0x79, 0x2f, 0xab,
+	0xa8, 0xb6, 0x58, 0x45, 0xb5, 0xcf, 0x55, 0x54, 0x7b, 0xba, 0x9a, 0xe4, 0x7a, 0x5a, 0x8e, 0x13,
+	0x22, 0x18, 0x22, 0x6a, 0x2e, 0xb5, 0x88, 0x85, 0x9a, 0xc4, 0xa6, 0x08, 0x99, 0x19, 0x9b, 0xbe,
+	0xca, 0x35, 0xea, 0xb9, 0xa4, 0x30, 0x6e, 0x9a, 0xab, 0x5e, 0xfe, 0x0e, 0x00, 0x35, 0x10, 0x2c,
+	0x0b, 0xbc, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSnapshots != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSnapshots))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExcludedAddresses) > 0 {
+		for iNdEx := len(m.ExcludedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedAddresses[iNdEx])
+			copy(dAtA[i:], m.ExcludedAddresses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExcludedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExcludedModuleAccounts) > 0 {
+		for iNdEx := len(m.ExcludedModuleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedModuleAccounts[iNdEx])
+			copy(dAtA[i:], m.ExcludedModuleAccounts[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExcludedModuleAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExcludedModuleAccounts) > 0 {
+		for _, s := range m.ExcludedModuleAccounts {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ExcludedAddresses) > 0 {
+		for _, s := range m.ExcludedAddresses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotInterval))
+	}
+	if m.MaxSnapshots != 0 {
+		n += 1 + sovParams(uint64(m.MaxSnapshots))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedModuleAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedModuleAccounts = append(m.ExcludedModuleAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedAddresses = append(m.ExcludedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshots", wireType)
+			}
+			m.MaxSnapshots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
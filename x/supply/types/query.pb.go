@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -34,6 +35,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // SupplyRequest is the request type for the Query/TotalSupply RPC
 // method.
 type SupplyRequest struct {
+	// denom filters the supply of the Query/TotalSupply and Query/LiquidSupply RPC
+	// methods to a single denom, in which case the pagination is ignored
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination by denom for the Query/TotalSupply
+	// and Query/LiquidSupply RPC methods.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SupplyRequest) Reset()         { *m = SupplyRequest{} }
@@ -69,11 +76,31 @@ func (m *SupplyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SupplyRequest proto.InternalMessageInfo
 
+func (m *SupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SupplyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // SupplyResponse is the response type for the Query/TotalSupply RPC
 // method
 type SupplyResponse struct {
 	// supply is the supply of the coins
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// burned is the cumulative total of the coins burned through x/inflation, which
+	// are no longer part of the supply
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// pagination defines the pagination in the response, if the supply is not of a
+	// single denom.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *SupplyResponse) Reset()         { *m = SupplyResponse{} }
@@ -116,38 +143,392 @@ func (m *SupplyResponse) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *SupplyResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *SupplyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// ExcludedSupplyResponse is the response type for the Query/ExcludedSupply RPC
+// method
+type ExcludedSupplyResponse struct {
+	// unvested is the supply locked in the vesting accounts which are not excluded
+	Unvested github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=unvested,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unvested"`
+	// accounts are the balances of the excluded module accounts and addresses
+	Accounts []ExcludedBalance `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (m *ExcludedSupplyResponse) Reset()         { *m = ExcludedSupplyResponse{} }
+func (m *ExcludedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*ExcludedSupplyResponse) ProtoMessage()    {}
+func (*ExcludedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{2}
+}
+func (m *ExcludedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExcludedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExcludedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExcludedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedSupplyResponse.Merge(m, src)
+}
+func (m *ExcludedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExcludedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedSupplyResponse proto.InternalMessageInfo
+
+func (m *ExcludedSupplyResponse) GetUnvested() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unvested
+	}
+	return nil
+}
+
+func (m *ExcludedSupplyResponse) GetAccounts() []ExcludedBalance {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// SupplyBreakdownResponse is the response type for the Query/SupplyBreakdown RPC
+// method
+type SupplyBreakdownResponse struct {
+	// breakdown is the breakdown of the total supply
+	Breakdown SupplyBreakdown `protobuf:"bytes,1,opt,name=breakdown,proto3" json:"breakdown"`
+}
+
+func (m *SupplyBreakdownResponse) Reset()         { *m = SupplyBreakdownResponse{} }
+func (m *SupplyBreakdownResponse) String() string { return proto.CompactTextString(m) }
+func (*SupplyBreakdownResponse) ProtoMessage()    {}
+func (*SupplyBreakdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{3}
+}
+func (m *SupplyBreakdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyBreakdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyBreakdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyBreakdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyBreakdownResponse.Merge(m, src)
+}
+func (m *SupplyBreakdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyBreakdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyBreakdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyBreakdownResponse proto.InternalMessageInfo
+
+func (m *SupplyBreakdownResponse) GetBreakdown() SupplyBreakdown {
+	if m != nil {
+		return m.Breakdown
+	}
+	return SupplyBreakdown{}
+}
+
+// QuerySupplySnapshotsRequest is the request type for the Query/SupplySnapshots RPC
+// method
+type QuerySupplySnapshotsRequest struct {
+	// start_height is the minimum height of the snapshots, inclusive
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the maximum height of the snapshots, inclusive, or zero for no
+	// maximum height
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySupplySnapshotsRequest) Reset()         { *m = QuerySupplySnapshotsRequest{} }
+func (m *QuerySupplySnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplySnapshotsRequest) ProtoMessage()    {}
+func (*QuerySupplySnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{4}
+}
+func (m *QuerySupplySnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplySnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplySnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplySnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplySnapshotsRequest.Merge(m, src)
+}
+func (m *QuerySupplySnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplySnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplySnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplySnapshotsRequest proto.InternalMessageInfo
+
+func (m *QuerySupplySnapshotsRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QuerySupplySnapshotsRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *QuerySupplySnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySupplySnapshotsResponse is the response type for the Query/SupplySnapshots
+// RPC method
+type QuerySupplySnapshotsResponse struct {
+	// snapshots are the supply snapshots in the height range, by ascending height
+	Snapshots []SupplySnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySupplySnapshotsResponse) Reset()         { *m = QuerySupplySnapshotsResponse{} }
+func (m *QuerySupplySnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplySnapshotsResponse) ProtoMessage()    {}
+func (*QuerySupplySnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{5}
+}
+func (m *QuerySupplySnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplySnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplySnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplySnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplySnapshotsResponse.Merge(m, src)
+}
+func (m *QuerySupplySnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplySnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplySnapshotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplySnapshotsResponse proto.InternalMessageInfo
+
+func (m *QuerySupplySnapshotsResponse) GetSnapshots() []SupplySnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QuerySupplySnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f169a8ce271fb0e1, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*SupplyRequest)(nil), "chainmain.supply.v1.SupplyRequest")
 	proto.RegisterType((*SupplyResponse)(nil), "chainmain.supply.v1.SupplyResponse")
+	proto.RegisterType((*ExcludedSupplyResponse)(nil), "chainmain.supply.v1.ExcludedSupplyResponse")
+	proto.RegisterType((*SupplyBreakdownResponse)(nil), "chainmain.supply.v1.SupplyBreakdownResponse")
+	proto.RegisterType((*QuerySupplySnapshotsRequest)(nil), "chainmain.supply.v1.QuerySupplySnapshotsRequest")
+	proto.RegisterType((*QuerySupplySnapshotsResponse)(nil), "chainmain.supply.v1.QuerySupplySnapshotsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "chainmain.supply.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "chainmain.supply.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("chainmain/supply/v1/query.proto", fileDescriptor_f169a8ce271fb0e1) }
 
 var fileDescriptor_f169a8ce271fb0e1 = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
-	0x14, 0xc6, 0x93, 0x56, 0xb7, 0x43, 0x7a, 0xff, 0x48, 0xb9, 0x77, 0xb8, 0x84, 0x92, 0xa2, 0x74,
-	0xe9, 0x12, 0x9b, 0x14, 0xf1, 0x02, 0x65, 0x45, 0x42, 0x14, 0x26, 0x36, 0x27, 0xb5, 0x52, 0x8b,
-	0xd4, 0x27, 0x8d, 0x9d, 0x8a, 0x20, 0x26, 0x06, 0xc4, 0x88, 0xc4, 0x5b, 0xf0, 0x24, 0x1d, 0x2b,
-	0xb1, 0x30, 0x01, 0x6a, 0x79, 0x10, 0x14, 0x3b, 0x45, 0x20, 0x55, 0x4c, 0x4c, 0x3e, 0x3a, 0x3e,
-	0xe7, 0xfb, 0x7e, 0xfe, 0x6c, 0xb5, 0xa3, 0x11, 0x61, 0x7c, 0x4c, 0x18, 0xc7, 0x22, 0x4f, 0xd3,
-	0xa4, 0xc0, 0xd3, 0x00, 0x4f, 0x72, 0x9a, 0x15, 0x28, 0xcd, 0x40, 0x82, 0xfd, 0xf7, 0x7d, 0x00,
-	0xe9, 0x01, 0x34, 0x0d, 0x1c, 0x37, 0x02, 0x31, 0x06, 0x81, 0x43, 0x22, 0x28, 0x9e, 0x06, 0x21,
-	0x95, 0x24, 0xc0, 0x11, 0x30, 0xae, 0x97, 0x9c, 0x7f, 0x31, 0xc4, 0xa0, 0x4a, 0x5c, 0x56, 0x55,
-	0xb7, 0x15, 0x03, 0xc4, 0x09, 0xc5, 0x24, 0x65, 0x98, 0x70, 0x0e, 0x92, 0x48, 0x06, 0x5c, 0xe8,
-	0x5b, 0xef, 0x8f, 0xf5, 0xeb, 0x58, 0x19, 0x0c, 0xe8, 0x24, 0xa7, 0x42, 0x7a, 0xb9, 0xf5, 0x7b,
-	0xd5, 0x10, 0x29, 0x70, 0x41, 0xed, 0xc8, 0x6a, 0x68, 0x86, 0xff, 0xe6, 0x76, 0xbd, 0xdb, 0xec,
-	0x6d, 0x20, 0xcd, 0x81, 0x4a, 0x0e, 0x54, 0x71, 0xa0, 0x7d, 0x60, 0xbc, 0xbf, 0x33, 0x7b, 0x6a,
-	0x1b, 0xf7, 0xcf, 0xed, 0x6e, 0xcc, 0xe4, 0x28, 0x0f, 0x51, 0x04, 0x63, 0x5c, 0x41, 0xeb, 0xc3,
-	0x17, 0xc3, 0x33, 0x2c, 0x8b, 0x94, 0x0a, 0xb5, 0x20, 0x06, 0x95, 0x74, 0xef, 0xba, 0x66, 0xfd,
-	0x38, 0x2a, 0x03, 0xb0, 0x2f, 0xac, 0xe6, 0x09, 0x48, 0x92, 0x68, 0x0a, 0xdb, 0x43, 0x6b, 0xa2,
-	0x40, 0x9f, 0x98, 0x9d, 0xce, 0x97, 0x33, 0xfa, 0x19, 0x9e, 0x77, 0xf5, 0xf0, 0x7a, 0x57, 0x6b,
-	0xd9, 0x0e, 0x5e, 0x17, 0xbe, 0x2c, 0x2d, 0xed, 0x4b, 0xeb, 0xe7, 0x01, 0x9b, 0xe4, 0x6c, 0xf8,
-	0xdd, 0xe6, 0x1d, 0x65, 0xbe, 0x65, 0x6f, 0xae, 0x35, 0x4f, 0x94, 0xa7, 0x53, 0xbf, 0xa9, 0x99,
-	0xfd, 0xc3, 0xd9, 0xc2, 0x35, 0xe7, 0x0b, 0xd7, 0x7c, 0x59, 0xb8, 0xe6, 0xed, 0xd2, 0x35, 0xe6,
-	0x4b, 0xd7, 0x78, 0x5c, 0xba, 0xc6, 0xe9, 0xde, 0xc7, 0x50, 0xb3, 0x22, 0x95, 0xe0, 0x43, 0x16,
-	0xfb, 0x4a, 0x50, 0xcb, 0xfa, 0x4a, 0xf7, 0x7c, 0xa5, 0xac, 0x72, 0x0e, 0x1b, 0xea, 0xa3, 0x77,
-	0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x74, 0x93, 0xb4, 0x2f, 0x74, 0x02, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xb1, 0x6f, 0xd3, 0x4a,
+	0x1c, 0x8e, 0x93, 0xd7, 0xa8, 0xb9, 0xf4, 0xf5, 0x49, 0xd7, 0xea, 0xbd, 0xbc, 0xb4, 0x75, 0x82,
+	0x03, 0x6d, 0x04, 0xc4, 0x6e, 0x8a, 0x18, 0x58, 0x83, 0x68, 0x3b, 0x20, 0x51, 0x52, 0x26, 0x16,
+	0x74, 0xb1, 0x4f, 0x8e, 0xd5, 0xe4, 0xce, 0xf5, 0x9d, 0x43, 0x83, 0x18, 0x10, 0x53, 0x25, 0x16,
+	0x24, 0x76, 0x16, 0x06, 0x24, 0x26, 0xfe, 0x07, 0x96, 0xb2, 0x55, 0x62, 0x61, 0x02, 0xd4, 0xf2,
+	0x87, 0xa0, 0xdc, 0x9d, 0xdd, 0x26, 0x72, 0xd3, 0x50, 0xd1, 0xa9, 0xee, 0xf9, 0xf7, 0xfb, 0xbe,
+	0xef, 0x3e, 0xff, 0xbe, 0xbb, 0x80, 0x92, 0xdd, 0x46, 0x1e, 0xe9, 0x22, 0x8f, 0x58, 0x2c, 0xf4,
+	0xfd, 0x4e, 0xdf, 0xea, 0xd5, 0xad, 0xdd, 0x10, 0x07, 0x7d, 0xd3, 0x0f, 0x28, 0xa7, 0x70, 0x2e,
+	0x2e, 0x30, 0x65, 0x81, 0xd9, 0xab, 0x17, 0x2b, 0x49, 0x5d, 0xad, 0x00, 0xa3, 0x1d, 0x87, 0x3e,
+	0x25, 0xb2, 0xb3, 0x58, 0x4e, 0x2a, 0xf2, 0x51, 0x80, 0xba, 0x4c, 0x55, 0x5c, 0xb7, 0x29, 0xeb,
+	0x52, 0x66, 0xb5, 0x10, 0xc3, 0x92, 0xd4, 0xea, 0xd5, 0x5b, 0x98, 0xa3, 0x41, 0x9d, 0xeb, 0x11,
+	0xc4, 0x3d, 0x1a, 0xa1, 0xe9, 0xa7, 0x6b, 0xa3, 0x2a, 0x9b, 0x7a, 0xd1, 0xfb, 0x79, 0x97, 0xba,
+	0x54, 0x3c, 0x5a, 0x83, 0x27, 0xb5, 0xba, 0xe8, 0x52, 0xea, 0x76, 0xb0, 0x85, 0x7c, 0xcf, 0x42,
+	0x84, 0x50, 0x2e, 0x20, 0x15, 0xbf, 0xd1, 0x05, 0x7f, 0x6f, 0x0b, 0x65, 0x4d, 0xbc, 0x1b, 0x62,
+	0xc6, 0xe1, 0x3c, 0x98, 0x72, 0x30, 0xa1, 0xdd, 0x82, 0x56, 0xd6, 0xaa, 0xb9, 0xa6, 0xfc, 0x07,
+	0xae, 0x03, 0x70, 0x22, 0xa7, 0x90, 0x2e, 0x6b, 0xd5, 0xfc, 0xda, 0xb2, 0x29, 0xf5, 0x98, 0x03,
+	0x3d, 0xa6, 0x34, 0x4c, 0xa9, 0x32, 0xb7, 0x90, 0x8b, 0x15, 0x62, 0xf3, 0x54, 0xa7, 0xf1, 0x2e,
+	0x0d, 0x66, 0x23, 0x3e, 0xe6, 0x53, 0xc2, 0x30, 0xb4, 0x41, 0x56, 0x7a, 0x53, 0xd0, 0xca, 0x99,
+	0x6a, 0x7e, 0xed, 0xff, 0x21, 0xd8, 0x08, 0xf0, 0x2e, 0xf5, 0x48, 0x63, 0xf5, 0xe0, 0x5b, 0x29,
+	0xf5, 0xe1, 0x7b, 0xa9, 0xea, 0x7a, 0xbc, 0x1d, 0xb6, 0x4c, 0x9b, 0x76, 0x2d, 0xe5, 0x89, 0xfc,
+	0x53, 0x63, 0xce, 0x8e, 0xc5, 0xfb, 0x3e, 0x66, 0xa2, 0x81, 0x35, 0x15, 0xf4, 0x80, 0xa4, 0x15,
+	0x06, 0x04, 0x3b, 0x85, 0xf4, 0x25, 0x90, 0x48, 0x68, 0xb8, 0x31, 0x64, 0x52, 0x46, 0x98, 0xb4,
+	0x72, 0xae, 0x49, 0xd2, 0x86, 0x21, 0x97, 0x3e, 0x6b, 0xe0, 0xdf, 0x7b, 0x7b, 0x76, 0x27, 0x74,
+	0xb0, 0x33, 0xe2, 0x96, 0x0b, 0xa6, 0x43, 0xd2, 0xc3, 0x8c, 0x63, 0xe7, 0x32, 0xfc, 0x8a, 0xc1,
+	0xe1, 0x3a, 0x98, 0x46, 0xb6, 0x4d, 0x43, 0xc2, 0x99, 0xf2, 0xec, 0xaa, 0x99, 0x90, 0x03, 0x33,
+	0xd2, 0xd9, 0x40, 0x1d, 0x44, 0x6c, 0xdc, 0xf8, 0x6b, 0xc0, 0xd9, 0x8c, 0x7b, 0x0d, 0x1b, 0xfc,
+	0x27, 0xb7, 0xd0, 0x88, 0xb2, 0x11, 0xef, 0x65, 0x13, 0xe4, 0xe2, 0xc0, 0x88, 0x71, 0x3b, 0x8b,
+	0x63, 0x04, 0x40, 0x71, 0x9c, 0x34, 0x1b, 0xef, 0x35, 0xb0, 0xf0, 0x70, 0xe0, 0xad, 0xac, 0xdc,
+	0x26, 0xc8, 0x67, 0x6d, 0xca, 0x59, 0x34, 0xd4, 0x57, 0xc0, 0x0c, 0xe3, 0x28, 0xe0, 0x4f, 0xda,
+	0xd8, 0x73, 0xdb, 0x5c, 0x90, 0x65, 0x9a, 0x79, 0xb1, 0xb6, 0x29, 0x96, 0xe0, 0x12, 0x00, 0x98,
+	0x38, 0x51, 0x41, 0x5a, 0x14, 0xe4, 0x30, 0x71, 0xd4, 0xeb, 0xf5, 0x84, 0x6f, 0x7b, 0x91, 0x00,
+	0x7c, 0xd4, 0xc0, 0x62, 0xb2, 0x52, 0x65, 0xca, 0x06, 0xc8, 0xb1, 0x68, 0x51, 0x7d, 0xe1, 0xca,
+	0x18, 0x53, 0x22, 0x80, 0xc8, 0x93, 0xb8, 0x77, 0x64, 0x1a, 0xd3, 0x17, 0x9f, 0xc6, 0x79, 0x00,
+	0x85, 0xe2, 0x2d, 0x71, 0x6e, 0xa9, 0x4d, 0x19, 0x5b, 0x60, 0x6e, 0x68, 0x55, 0xc9, 0xbf, 0x03,
+	0xb2, 0xf2, 0x7c, 0x53, 0x1f, 0x74, 0x21, 0x51, 0xbb, 0x6c, 0x52, 0x9a, 0x55, 0xc3, 0xda, 0xa7,
+	0x2c, 0x98, 0x12, 0x90, 0xf0, 0x19, 0xc8, 0x3f, 0xa2, 0x1c, 0x75, 0xe4, 0x16, 0xa1, 0x31, 0x66,
+	0xff, 0x4a, 0x4e, 0xb1, 0x32, 0xb6, 0x46, 0x8a, 0x33, 0x8c, 0x97, 0x5f, 0x7e, 0xbe, 0x49, 0x2f,
+	0xc2, 0xa2, 0x95, 0x74, 0x2e, 0xf3, 0x01, 0x25, 0x7c, 0x0e, 0x66, 0xee, 0x7b, 0xbb, 0xa1, 0xe7,
+	0xfc, 0x69, 0xf2, 0x8a, 0x20, 0x5f, 0x82, 0x0b, 0x89, 0xe4, 0x1d, 0xc1, 0x09, 0xf7, 0x35, 0x30,
+	0x3b, 0x9c, 0xfc, 0x89, 0x04, 0xdc, 0x18, 0x1b, 0xcd, 0x11, 0x21, 0xd7, 0x84, 0x90, 0x12, 0x5c,
+	0x4a, 0x14, 0x82, 0x55, 0x13, 0x7c, 0xa5, 0x81, 0x7f, 0x46, 0x82, 0x37, 0x91, 0x96, 0x9b, 0x93,
+	0x44, 0x38, 0x16, 0xb3, 0x2c, 0xc4, 0x94, 0xa1, 0x6e, 0x8d, 0xbd, 0x4f, 0xe1, 0xdb, 0x58, 0x4d,
+	0x1c, 0x19, 0xb8, 0x9a, 0xc8, 0x34, 0xe6, 0x1c, 0x28, 0xd6, 0x7f, 0xa3, 0x63, 0x22, 0x81, 0x27,
+	0x71, 0x7b, 0xa1, 0x81, 0xac, 0x1c, 0x6b, 0xb8, 0x72, 0x36, 0xcb, 0x50, 0x86, 0x8a, 0xd5, 0xf3,
+	0x0b, 0x27, 0x1a, 0x1e, 0x19, 0xa0, 0x62, 0x66, 0x3f, 0xad, 0x35, 0x1e, 0x1c, 0x1c, 0xe9, 0xda,
+	0xe1, 0x91, 0xae, 0xfd, 0x38, 0xd2, 0xb5, 0xd7, 0xc7, 0x7a, 0xea, 0xf0, 0x58, 0x4f, 0x7d, 0x3d,
+	0xd6, 0x53, 0x8f, 0x6f, 0x9f, 0xbe, 0x05, 0x82, 0xbe, 0xcf, 0x69, 0x8d, 0x06, 0x6e, 0x4d, 0x00,
+	0x4a, 0xd8, 0x9a, 0xc0, 0xdd, 0x8b, 0x90, 0xc5, 0xc5, 0xd0, 0xca, 0x8a, 0x1f, 0x0a, 0xb7, 0x7e,
+	0x0d, 0x00, 0x74, 0xa8, 0xee, 0xdc, 0x27, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -164,10 +545,23 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // Deprecated: Do not use.
 type QueryClient interface {
-	// TotalSupply queries the total supply of all coins.
+	// TotalSupply queries the total supply of the coins, of a denom or paginated by
+	// denom.
 	TotalSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyResponse, error)
-	// LiquidSupply queries the liquid supply of all coins.
+	// LiquidSupply queries the liquid supply of the coins, of a denom or paginated by
+	// denom. The unvested supply is the one cached at the end of the block.
 	LiquidSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyResponse, error)
+	// ExcludedSupply queries the balances excluded from the liquid supply, reported
+	// separately for the unvested supply and each excluded account. The unvested
+	// supply is the one cached at the end of the block.
+	ExcludedSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*ExcludedSupplyResponse, error)
+	// SupplyBreakdown queries the breakdown of the total supply.
+	SupplyBreakdown(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyBreakdownResponse, error)
+	// SupplySnapshots queries the supply breakdowns stored periodically, in a height
+	// range.
+	SupplySnapshots(ctx context.Context, in *QuerySupplySnapshotsRequest, opts ...grpc.CallOption) (*QuerySupplySnapshotsResponse, error)
+	// Params queries the parameters of the supply module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -197,14 +591,63 @@ func (c *queryClient) LiquidSupply(ctx context.Context, in *SupplyRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ExcludedSupply(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*ExcludedSupplyResponse, error) {
+	out := new(ExcludedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.supply.v1.Query/ExcludedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyBreakdown(ctx context.Context, in *SupplyRequest, opts ...grpc.CallOption) (*SupplyBreakdownResponse, error) {
+	out := new(SupplyBreakdownResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.supply.v1.Query/SupplyBreakdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplySnapshots(ctx context.Context, in *QuerySupplySnapshotsRequest, opts ...grpc.CallOption) (*QuerySupplySnapshotsResponse, error) {
+	out := new(QuerySupplySnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.supply.v1.Query/SupplySnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/chainmain.supply.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 //
 // Deprecated: Do not use.
 type QueryServer interface {
-	// TotalSupply queries the total supply of all coins.
+	// TotalSupply queries the total supply of the coins, of a denom or paginated by
+	// denom.
 	TotalSupply(context.Context, *SupplyRequest) (*SupplyResponse, error)
-	// LiquidSupply queries the liquid supply of all coins.
+	// LiquidSupply queries the liquid supply of the coins, of a denom or paginated by
+	// denom. The unvested supply is the one cached at the end of the block.
 	LiquidSupply(context.Context, *SupplyRequest) (*SupplyResponse, error)
+	// ExcludedSupply queries the balances excluded from the liquid supply, reported
+	// separately for the unvested supply and each excluded account. The unvested
+	// supply is the one cached at the end of the block.
+	ExcludedSupply(context.Context, *SupplyRequest) (*ExcludedSupplyResponse, error)
+	// SupplyBreakdown queries the breakdown of the total supply.
+	SupplyBreakdown(context.Context, *SupplyRequest) (*SupplyBreakdownResponse, error)
+	// SupplySnapshots queries the supply breakdowns stored periodically, in a height
+	// range.
+	SupplySnapshots(context.Context, *QuerySupplySnapshotsRequest) (*QuerySupplySnapshotsResponse, error)
+	// Params queries the parameters of the supply module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // Deprecated: Do not use.
@@ -218,6 +661,18 @@ func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *SupplyReq
 func (*UnimplementedQueryServer) LiquidSupply(ctx context.Context, req *SupplyRequest) (*SupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidSupply not implemented")
 }
+func (*UnimplementedQueryServer) ExcludedSupply(ctx context.Context, req *SupplyRequest) (*ExcludedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExcludedSupply not implemented")
+}
+func (*UnimplementedQueryServer) SupplyBreakdown(ctx context.Context, req *SupplyRequest) (*SupplyBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyBreakdown not implemented")
+}
+func (*UnimplementedQueryServer) SupplySnapshots(ctx context.Context, req *QuerySupplySnapshotsRequest) (*QuerySupplySnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplySnapshots not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 // Deprecated: Do not use.
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
@@ -260,43 +715,151 @@ func _Query_LiquidSupply_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "chainmain.supply.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TotalSupply",
-			Handler:    _Query_TotalSupply_Handler,
-		},
-		{
-			MethodName: "LiquidSupply",
-			Handler:    _Query_LiquidSupply_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "chainmain/supply/v1/query.proto",
-}
-
-func (m *SupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ExcludedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ExcludedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.supply.v1.Query/ExcludedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExcludedSupply(ctx, req.(*SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_SupplyBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.supply.v1.Query/SupplyBreakdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyBreakdown(ctx, req.(*SupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplySnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplySnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplySnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.supply.v1.Query/SupplySnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplySnapshots(ctx, req.(*QuerySupplySnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainmain.supply.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chainmain.supply.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TotalSupply",
+			Handler:    _Query_TotalSupply_Handler,
+		},
+		{
+			MethodName: "LiquidSupply",
+			Handler:    _Query_LiquidSupply_Handler,
+		},
+		{
+			MethodName: "ExcludedSupply",
+			Handler:    _Query_ExcludedSupply_Handler,
+		},
+		{
+			MethodName: "SupplyBreakdown",
+			Handler:    _Query_SupplyBreakdown_Handler,
+		},
+		{
+			MethodName: "SupplySnapshots",
+			Handler:    _Query_SupplySnapshots_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chainmain/supply/v1/query.proto",
+}
+
+func (m *SupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -320,6 +883,32 @@ func (m *SupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Supply) > 0 {
 		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -327,58 +916,1117 @@ func (m *SupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExcludedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExcludedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExcludedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Unvested) > 0 {
+		for iNdEx := len(m.Unvested) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unvested[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyBreakdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyBreakdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyBreakdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Breakdown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplySnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplySnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplySnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplySnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplySnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplySnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ExcludedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unvested) > 0 {
+		for _, e := range m.Unvested {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyBreakdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Breakdown.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplySnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplySnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExcludedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExcludedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExcludedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unvested = append(m.Unvested, types.Coin{})
+			if err := m.Unvested[len(m.Unvested)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ExcludedBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyBreakdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyBreakdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyBreakdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakdown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Breakdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplySnapshotsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplySnapshotsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplySnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0xa
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *SupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *SupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Supply) > 0 {
-		for _, e := range m.Supply {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySupplySnapshotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplySnapshotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplySnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, SupplySnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *SupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -401,10 +2049,10 @@ func (m *SupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -428,7 +2076,7 @@ func (m *SupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -451,15 +2099,15 @@ func (m *SupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,8 +2134,7 @@ func (m *SupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supply = append(m.Supply, types.Coin{})
-			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21,6 +21,9 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 // If fee-collector balance (after community tax) implies a shortfall, it transfers
 // the difference from the rewards pool to distribution and allocates pro-rata
 // by last-block consensus voting power.
+// The provisions diverted by the x/inflation mint distributions in the mint begin
+// blocker never reach the fee collector. They are counted with its balance, so that
+// the top up does not make up for them.
 func (k Keeper) topUpBaseRewards(ctx context.Context) error {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -71,7 +74,11 @@ func (k Keeper) topUpBaseRewards(ctx context.Context) error {
 	}
 	feeCollectorAddr := feeCollector.GetAddress()
 	feeCollectorBalance := k.bankKeeper.GetBalance(ctx, feeCollectorAddr, bondDenom)
-	defaultStakersRewardPerBlock := math.LegacyNewDecFromInt(feeCollectorBalance.Amount).
+	diverted, err := k.inflationKeeper.GetBlockDiverted(ctx, bondDenom)
+	if err != nil {
+		return err
+	}
+	defaultStakersRewardPerBlock := math.LegacyNewDecFromInt(feeCollectorBalance.Amount.Add(diverted)).
 		MulTruncate(math.LegacyOneDec().Sub(communityTax))
 
 	shortFallAmount := targetStakersRewardPerBlock.Sub(defaultStakersRewardPerBlock).TruncateInt()
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	abci "github.com/cometbft/cometbft/abci/types"
+	inflationtypes "github.com/crypto-org-chain/chain-main/v8/x/inflation/types"
 	"github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -123,6 +124,86 @@ func (s *KeeperSuite) TestBeginBlocker_TopUpFromPool() {
 	s.Require().Equal(expectedShortfall, distrReceived, "distribution module should receive the exact shortfall amount")
 }
 
+// TestBeginBlocker_TopUpFundedByEmissions verifies that the minted provisions diverted
+// from the fee collector by the inflation module count toward the staker rewards, so that
+// the top up does not make up for them.
+func (s *KeeperSuite) TestBeginBlocker_TopUpFundedByEmissions() {
+	s.ctx = s.ctxWithVoteInfos()
+	params := s.setExtremeRate()
+	s.drainFeeCollector()
+
+	// a share small enough to leave a shortfall against the extreme rate
+	inflationParams := inflationtypes.DefaultParams()
+	inflationParams.MintDistributions = []inflationtypes.MintDistribution{
+		inflationtypes.NewMintDistribution(types.RewardsPoolName, sdkmath.LegacyNewDecWithPrec(1, 6)),
+	}
+	s.Require().NoError(s.app.InflationKeeper.SetParams(s.ctx, inflationParams))
+
+	s.fundRewardsPool(sdkmath.NewInt(1_000_000_000), sdk.DefaultBondDenom)
+	poolAddr := s.app.AccountKeeper.GetModuleAddress(types.RewardsPoolName)
+	poolBefore := s.app.BankKeeper.GetBalance(s.ctx, poolAddr, sdk.DefaultBondDenom)
+
+	s.Require().NoError(s.app.InflationKeeper.MintFn()(s.ctx, &s.app.MintKeeper))
+	s.drainFeeCollector()
+	poolFunded := s.app.BankKeeper.GetBalance(s.ctx, poolAddr, sdk.DefaultBondDenom)
+	diverted := poolFunded.Amount.Sub(poolBefore.Amount)
+	s.Require().True(diverted.IsPositive())
+
+	blockDiverted, err := s.app.InflationKeeper.GetBlockDiverted(s.ctx, sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(diverted, blockDiverted)
+
+	totalBonded, err := s.app.StakingKeeper.TotalBondedTokens(s.ctx)
+	s.Require().NoError(err)
+	mintParams, err := s.app.MintKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	communityTax, err := s.app.DistrKeeper.GetCommunityTax(s.ctx)
+	s.Require().NoError(err)
+	target := sdkmath.LegacyNewDecFromInt(totalBonded).
+		Mul(params.TargetBaseRewardsRate).
+		Quo(sdkmath.LegacyNewDec(int64(mintParams.BlocksPerYear)))
+	expectedShortfall := target.
+		Sub(sdkmath.LegacyNewDecFromInt(diverted).MulTruncate(sdkmath.LegacyOneDec().Sub(communityTax))).
+		TruncateInt()
+	s.Require().True(expectedShortfall.IsPositive())
+	s.Require().True(expectedShortfall.LT(target.TruncateInt()))
+
+	err = s.keeper.BeginBlocker(s.ctx)
+	s.Require().NoError(err)
+
+	poolAfter := s.app.BankKeeper.GetBalance(s.ctx, poolAddr, sdk.DefaultBondDenom)
+	s.Require().Equal(expectedShortfall, poolFunded.Amount.Sub(poolAfter.Amount))
+}
+
+// TestBeginBlocker_DivertedCoversTarget verifies that no top up occurs when the
+// provisions diverted from the fee collector alone meet the target.
+func (s *KeeperSuite) TestBeginBlocker_DivertedCoversTarget() {
+	s.ctx = s.ctxWithVoteInfos()
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, types.NewParams(sdkmath.LegacyNewDecWithPrec(1, 2))))
+	s.drainFeeCollector()
+
+	inflationParams := inflationtypes.DefaultParams()
+	inflationParams.MintDistributions = []inflationtypes.MintDistribution{
+		inflationtypes.NewMintDistribution(distrtypes.ModuleName, sdkmath.LegacyOneDec()),
+	}
+	s.Require().NoError(s.app.InflationKeeper.SetParams(s.ctx, inflationParams))
+
+	// all the minted provisions fund the community pool
+	s.Require().NoError(s.app.InflationKeeper.MintFn()(s.ctx, &s.app.MintKeeper))
+	feeCollectorAddr := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, sdk.DefaultBondDenom).IsZero())
+
+	poolAddr := s.app.AccountKeeper.GetModuleAddress(types.RewardsPoolName)
+	s.fundRewardsPool(sdkmath.NewInt(1_000_000_000), sdk.DefaultBondDenom)
+	poolBefore := s.app.BankKeeper.GetBalance(s.ctx, poolAddr, sdk.DefaultBondDenom)
+
+	err := s.keeper.BeginBlocker(s.ctx)
+	s.Require().NoError(err)
+
+	poolAfter := s.app.BankKeeper.GetBalance(s.ctx, poolAddr, sdk.DefaultBondDenom)
+	s.Require().Equal(poolBefore.Amount, poolAfter.Amount)
+}
+
 // TestBeginBlocker_InsufficientPool verifies that when the pool has some
 // but not enough funds, it drains what's available.
 func (s *KeeperSuite) TestBeginBlocker_InsufficientPool() {
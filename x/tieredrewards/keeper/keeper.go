@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,11 +42,18 @@ type Keeper struct {
 	// RedelegationMappings maps a redelegation unbonding id to the tier position id that issued the redelegation.
 	RedelegationMappings *collections.IndexedMap[uint64, uint64, RedelegationMappingsIndexes]
 
+	// Locked amounts by tier: the delegated shares by validator, with the shares counted
+	// for each position, and the ids of the unbonding or undelegated positions.
+	PositionLockedAmounts collections.Map[uint64, types.PositionLockedAmount]
+	LockedSharesByTier    collections.Map[collections.Pair[uint32, sdk.ValAddress], math.LegacyDec]
+	UndelegatedByTier     collections.KeySet[collections.Pair[uint32, uint64]]
+
 	mintKeeper         types.MintKeeper
 	stakingKeeper      types.StakingKeeper
 	accountKeeper      types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	distributionKeeper types.DistributionKeeper
+	inflationKeeper    types.InflationKeeper
 }
 
 func NewKeeper(
@@ -57,6 +65,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distributionKeeper types.DistributionKeeper,
+	inflationKeeper types.InflationKeeper,
 ) Keeper {
 	if addr := accountKeeper.GetModuleAddress(types.RewardsPoolName); addr == nil {
 		panic(fmt.Sprintf("the %s module account has not been set", types.RewardsPoolName))
@@ -82,6 +91,10 @@ func NewKeeper(
 		panic("distribution keeper is nil")
 	}
 
+	if inflationKeeper == nil {
+		panic("inflation keeper is nil")
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc:                      cdc,
@@ -92,6 +105,7 @@ func NewKeeper(
 		accountKeeper:            accountKeeper,
 		bankKeeper:               bankKeeper,
 		distributionKeeper:       distributionKeeper,
+		inflationKeeper:          inflationKeeper,
 		Params:                   collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Tiers:                    collections.NewMap(sb, types.TiersKey, "tiers", collections.Uint32Key, codec.CollValue[types.Tier](cdc)),
 		Positions:                collections.NewMap(sb, types.PositionsKey, "positions", collections.Uint64Key, codec.CollValue[types.Position](cdc)),
@@ -103,6 +117,9 @@ func NewKeeper(
 		ValidatorEvents:          collections.NewMap(sb, types.ValidatorEventsKey, "validator_events", collections.PairKeyCodec(sdk.ValAddressKey, collections.Uint64Key), codec.CollValue[types.ValidatorEvent](cdc)),
 		ValidatorEventSeq:        collections.NewMap(sb, types.ValidatorEventSeqKey, "validator_event_current_seq", sdk.ValAddressKey, collections.Uint64Value),
 		RedelegationMappings:     collections.NewIndexedMap(sb, types.RedelegationMappingsKey, "redelegation_mappings", collections.Uint64Key, collections.Uint64Value, newRedelegationMappingsIndexes(sb)),
+		PositionLockedAmounts:    collections.NewMap(sb, types.PositionLockedAmountsKey, "position_locked_amounts", collections.Uint64Key, codec.CollValue[types.PositionLockedAmount](cdc)),
+		LockedSharesByTier:       collections.NewMap(sb, types.LockedSharesByTierKey, "locked_shares_by_tier", collections.PairKeyCodec(collections.Uint32Key, sdk.ValAddressKey), sdk.LegacyDecValue),
+		UndelegatedByTier:        collections.NewKeySet(sb, types.UndelegatedByTierKey, "undelegated_by_tier", collections.PairKeyCodec(collections.Uint32Key, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setPositionLockedAmount replaces the amount counted for a position in the locked
+// amount of its tier with the amount of its current state. Delegated positions are
+// counted by their shares, so that the locked amounts follow the validator slashes.
+// Unbonding and undelegated positions are only indexed by tier, as their amounts
+// change on slashes and on the unbonding completion without updating the position.
+func (k Keeper) setPositionLockedAmount(ctx context.Context, state types.PositionState) error {
+	if err := k.removePositionLockedAmount(ctx, state.Id); err != nil {
+		return err
+	}
+
+	locked := types.PositionLockedAmount{
+		TierId: state.TierId,
+		Shares: math.LegacyZeroDec(),
+	}
+	if state.IsDelegated() {
+		locked.ValidatorAddress = state.Delegation.ValidatorAddress
+		locked.Shares = state.Delegation.Shares
+		if err := k.addLockedShares(ctx, locked, false); err != nil {
+			return err
+		}
+	} else if err := k.UndelegatedByTier.Set(ctx, collections.Join(state.TierId, state.Id)); err != nil {
+		return err
+	}
+
+	return k.PositionLockedAmounts.Set(ctx, state.Id, locked)
+}
+
+// removePositionLockedAmount removes the amount counted for a position from the
+// locked amount of its tier, if any.
+func (k Keeper) removePositionLockedAmount(ctx context.Context, posId uint64) error {
+	locked, err := k.PositionLockedAmounts.Get(ctx, posId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if locked.ValidatorAddress != "" {
+		if err := k.addLockedShares(ctx, locked, true); err != nil {
+			return err
+		}
+	} else if err := k.UndelegatedByTier.Remove(ctx, collections.Join(locked.TierId, posId)); err != nil {
+		return err
+	}
+	return k.PositionLockedAmounts.Remove(ctx, posId)
+}
+
+// addLockedShares adds, or subtracts, the delegated shares of a position to the
+// locked shares of its tier. Zero totals are removed.
+func (k Keeper) addLockedShares(ctx context.Context, locked types.PositionLockedAmount, subtract bool) error {
+	valAddr, err := sdk.ValAddressFromBech32(locked.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(locked.TierId, valAddr)
+	shares, err := k.LockedSharesByTier.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		shares = math.LegacyZeroDec()
+	} else if err != nil {
+		return err
+	}
+
+	if subtract {
+		shares = shares.Sub(locked.Shares)
+	} else {
+		shares = shares.Add(locked.Shares)
+	}
+	if !shares.IsPositive() {
+		return k.LockedSharesByTier.Remove(ctx, key)
+	}
+	return k.LockedSharesByTier.Set(ctx, key, shares)
+}
+
+// getLockedAmountForTier returns the total amount locked in the positions of a tier,
+// with the delegated shares valued at the current exchange rates of the validators
+// and the unbonding or undelegated positions at their current amounts.
+func (k Keeper) getLockedAmountForTier(ctx context.Context, tierId uint32) (math.Int, error) {
+	total := math.ZeroInt()
+
+	sharesRng := collections.NewPrefixedPairRange[uint32, sdk.ValAddress](tierId)
+	err := k.LockedSharesByTier.Walk(ctx, sharesRng, func(key collections.Pair[uint32, sdk.ValAddress], shares math.LegacyDec) (bool, error) {
+		amount, err := k.reconcileAmountFromShares(ctx, key.K2(), shares)
+		if err != nil {
+			return true, err
+		}
+		total = total.Add(amount)
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	positionsRng := collections.NewPrefixedPairRange[uint32, uint64](tierId)
+	err = k.UndelegatedByTier.Walk(ctx, positionsRng, func(key collections.Pair[uint32, uint64]) (bool, error) {
+		pos, err := k.getPosition(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		amount, err := k.getUndelegatedAmount(ctx, pos.DelegatorAddress)
+		if err != nil {
+			return true, err
+		}
+		total = total.Add(amount)
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+	return total, nil
+}
+
+// rebuildLockedAmounts recounts the locked amounts of the tiers from the current
+// state of all the positions.
+func (k Keeper) rebuildLockedAmounts(ctx context.Context) error {
+	if err := k.PositionLockedAmounts.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.LockedSharesByTier.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.UndelegatedByTier.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	ids, err := k.Positions.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := ids.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range keys {
+		state, err := k.getPositionState(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.setPositionLockedAmount(ctx, state); err != nil {
+			return err
+		}
+	}
+	return nil
+}
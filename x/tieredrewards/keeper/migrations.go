@@ -17,3 +17,8 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, m.keeper.Positions, m.keeper.accountKeeper, m.keeper)
 }
+
+// Migrate2to3 counts the amounts locked in the existing positions by tier.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.rebuildLockedAmounts(ctx)
+}
@@ -188,6 +188,10 @@ func (k Keeper) setPositionWithState(ctx context.Context, state types.PositionSt
 		}
 	}
 
+	if err := k.setPositionLockedAmount(ctx, state); err != nil {
+		return err
+	}
+
 	if update == nil {
 		return nil
 	}
@@ -239,6 +243,9 @@ func (k Keeper) deletePosition(ctx context.Context, pos types.Position, update *
 	if err := k.decreasePositionCountForTier(ctx, pos.TierId); err != nil {
 		return err
 	}
+	if err := k.removePositionLockedAmount(ctx, pos.Id); err != nil {
+		return err
+	}
 
 	if update == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -146,3 +147,17 @@ func (k Keeper) decreasePositionCountForValidator(ctx context.Context, valAddr s
 	}
 	return nil
 }
+
+// IterateLockedAmountsByTier iterates over the tiers with the total amount locked in
+// their positions, whether delegated, unbonding or undelegated. The delegated shares
+// are valued at the current exchange rates of the validators, and the unbonding or
+// undelegated positions at their current unbonding entries or balances.
+func (k Keeper) IterateLockedAmountsByTier(ctx context.Context, cb func(tierId uint32, amount math.Int) (stop bool)) error {
+	return k.Tiers.Walk(ctx, nil, func(tierId uint32, _ types.Tier) (bool, error) {
+		total, err := k.getLockedAmountForTier(ctx, tierId)
+		if err != nil {
+			return true, err
+		}
+		return cb(tierId, total), nil
+	})
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/keeper"
 	"github.com/crypto-org-chain/chain-main/v8/x/tieredrewards/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -221,3 +222,78 @@ func (s *KeeperSuite) TestIncreaseDecreaseValidatorPositionCount() {
 	// Decrease on 0 is a no-op.
 	s.Require().NoError(s.keeper.DecreasePositionCountForValidator(s.ctx, valAddr))
 }
+
+func (s *KeeperSuite) TestIterateLockedAmountsByTier() {
+	pos1 := s.setupNewTierPosition(sdkmath.NewInt(10_000), false)
+	pos2 := s.setupNewTierPosition(sdkmath.NewInt(5_000), true)
+	s.setupTier(2)
+
+	amounts := map[uint32]sdkmath.Int{}
+	err := s.keeper.IterateLockedAmountsByTier(s.ctx, func(tierId uint32, amount sdkmath.Int) bool {
+		amounts[tierId] = amount
+		return false
+	})
+	s.Require().NoError(err)
+	s.Require().Len(amounts, 2)
+	s.Require().Equal(s.getPositionAmount(pos1).Add(s.getPositionAmount(pos2)), amounts[1])
+	s.Require().True(amounts[2].IsZero())
+}
+
+func (s *KeeperSuite) TestIterateLockedAmountsByTier_FollowsPositions() {
+	pos1 := s.setupNewTierPosition(sdkmath.NewInt(10_000), true)
+	pos2 := s.setupNewTierPosition(sdkmath.NewInt(5_000), false)
+	valAddr := sdk.MustValAddressFromBech32(pos1.Delegation.ValidatorAddress)
+
+	lockedAmount := func() sdkmath.Int {
+		var total sdkmath.Int
+		err := s.keeper.IterateLockedAmountsByTier(s.ctx, func(tierId uint32, amount sdkmath.Int) bool {
+			if tierId == 1 {
+				total = amount
+			}
+			return false
+		})
+		s.Require().NoError(err)
+		return total
+	}
+	positionsAmount := func() sdkmath.Int {
+		total := sdkmath.ZeroInt()
+		for _, id := range []uint64{pos1.Id, pos2.Id} {
+			state, err := s.keeper.GetPositionState(s.ctx, id)
+			s.Require().NoError(err)
+			total = total.Add(s.getPositionAmount(state))
+		}
+		return total
+	}
+
+	// the delegated shares are valued at the exchange rate after a slash
+	s.slashValidatorDirect(valAddr, sdkmath.LegacyNewDecWithPrec(1, 1))
+	s.Require().InDelta(positionsAmount().Int64(), lockedAmount().Int64(), 1)
+	s.Require().True(lockedAmount().LT(sdkmath.NewInt(15_000)))
+
+	// an undelegated position is counted by its unbonding amount
+	_, bondDenom := s.getStakingData()
+	s.fundRewardsPool(sdkmath.NewInt(1_000_000), bondDenom)
+	s.advancePastExitDuration()
+	_, err := keeper.NewMsgServerImpl(s.keeper).TierUndelegate(s.ctx, &types.MsgTierUndelegate{Owner: pos1.Owner, PositionId: pos1.Id})
+	s.Require().NoError(err)
+	s.Require().InDelta(positionsAmount().Int64(), lockedAmount().Int64(), 1)
+
+	// the unbonding amount follows the slashes of the unbonding entry
+	unbonding := lockedAmount()
+	s.slashValidatorDirect(valAddr, sdkmath.LegacyNewDecWithPrec(1, 1))
+	s.Require().InDelta(positionsAmount().Int64(), lockedAmount().Int64(), 1)
+	s.Require().True(lockedAmount().LT(unbonding))
+
+	// the undelegated amount is counted once the unbonding completes
+	unbondingTime, err := s.app.StakingKeeper.UnbondingTime(s.ctx)
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(unbondingTime))
+	_, err = s.app.StakingKeeper.CompleteUnbonding(s.ctx, sdk.MustAccAddressFromBech32(pos1.DelegatorAddress), valAddr)
+	s.Require().NoError(err)
+	s.Require().InDelta(positionsAmount().Int64(), lockedAmount().Int64(), 1)
+
+	// the migration recounts the same amounts
+	expected := lockedAmount()
+	s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate2to3(s.ctx))
+	s.Require().Equal(expected, lockedAmount())
+}
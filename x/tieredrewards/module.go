@@ -29,7 +29,7 @@ var (
 	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 type AppModuleBasic struct {
 	cdc codec.Codec
@@ -106,6 +106,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Errorf("failed to register tieredrewards migration v1->v2: %w", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Errorf("failed to register tieredrewards migration v2->v3: %w", err))
+	}
 }
 
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
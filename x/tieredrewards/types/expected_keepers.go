@@ -56,6 +56,10 @@ type MintKeeper interface {
 	GetParams(ctx context.Context) (minttypes.Params, error)
 }
 
+type InflationKeeper interface {
+	GetBlockDiverted(ctx context.Context, denom string) (math.Int, error)
+}
+
 type DistributionKeeper interface {
 	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
 	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
@@ -15,6 +15,9 @@ var (
 	PositionCountByValidatorKey       = collections.NewPrefix(9)
 	RedelegationMappingsKey           = collections.NewPrefix(10)
 	RedelegationMappingsByPositionKey = collections.NewPrefix(11)
+	PositionLockedAmountsKey          = collections.NewPrefix(12)
+	LockedSharesByTierKey             = collections.NewPrefix(13)
+	UndelegatedByTierKey              = collections.NewPrefix(14)
 )
 
 const (
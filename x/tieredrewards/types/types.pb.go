@@ -159,9 +159,7 @@ type Position struct {
 	// created_at_time is the block time when this position was created.
 	CreatedAtTime time.Time `protobuf:"bytes,10,opt,name=created_at_time,json=createdAtTime,proto3,stdtime" json:"created_at_time"`
 	// delegator_address is the per-position account address that acts as the
-	// delegator in x/staking, holds the principal during the lock period, and
-	// is the withdraw source registered with x/distribution. Persisted at
-	// creation; consumers must read this rather than recompute it.
+	// delegator in staking
 	DelegatorAddress string `protobuf:"bytes,11,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
@@ -465,12 +463,72 @@ func (m *ValidatorEvent) GetReferenceCount() uint64 {
 	return 0
 }
 
+// PositionLockedAmount records the delegation of a position counted in the locked
+// amount of its tier, as of the last update of the position. The unbonding and
+// undelegated positions are tracked by tier and valued when the amounts are read.
+type PositionLockedAmount struct {
+	// tier_id is the tier the amount is counted in.
+	TierId uint32 `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	// validator_address is the validator of the position delegation, if delegated.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the delegation shares of the position, if delegated.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *PositionLockedAmount) Reset()         { *m = PositionLockedAmount{} }
+func (m *PositionLockedAmount) String() string { return proto.CompactTextString(m) }
+func (*PositionLockedAmount) ProtoMessage()    {}
+func (*PositionLockedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5704e10ee9ad3b2f, []int{4}
+}
+func (m *PositionLockedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionLockedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionLockedAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionLockedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionLockedAmount.Merge(m, src)
+}
+func (m *PositionLockedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionLockedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionLockedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionLockedAmount proto.InternalMessageInfo
+
+func (m *PositionLockedAmount) GetTierId() uint32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+func (m *PositionLockedAmount) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("chainmain.tieredrewards.v1.ValidatorEventType", ValidatorEventType_name, ValidatorEventType_value)
 	proto.RegisterType((*Tier)(nil), "chainmain.tieredrewards.v1.Tier")
 	proto.RegisterType((*Position)(nil), "chainmain.tieredrewards.v1.Position")
 	proto.RegisterType((*PositionResponse)(nil), "chainmain.tieredrewards.v1.PositionResponse")
 	proto.RegisterType((*ValidatorEvent)(nil), "chainmain.tieredrewards.v1.ValidatorEvent")
+	proto.RegisterType((*PositionLockedAmount)(nil), "chainmain.tieredrewards.v1.PositionLockedAmount")
 }
 
 func init() {
@@ -478,67 +536,70 @@ func init() {
 }
 
 var fileDescriptor_5704e10ee9ad3b2f = []byte{
-	// 956 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x3a, 0x8e, 0x1b, 0x4f, 0xea, 0x8f, 0x8c, 0x0a, 0x6c, 0x5c, 0xc5, 0x36, 0x51, 0x05,
-	0x51, 0x24, 0xef, 0xd2, 0x20, 0x71, 0x42, 0x42, 0x76, 0x6d, 0x88, 0x45, 0x9a, 0x84, 0xb5, 0x13,
-	0x3e, 0x2e, 0xcb, 0x64, 0x77, 0xba, 0x1e, 0xd9, 0x3b, 0xe3, 0xce, 0x8e, 0x93, 0xfa, 0x5f, 0xf4,
-	0x88, 0xf8, 0x05, 0x1c, 0x91, 0xc8, 0x7f, 0xa0, 0x12, 0x97, 0x2a, 0x27, 0xc4, 0xa1, 0xa0, 0xe4,
-	0xc0, 0x9f, 0xe0, 0x80, 0xe6, 0xdd, 0x5d, 0xa7, 0x69, 0x5a, 0xa1, 0x14, 0xc1, 0xa9, 0x97, 0xd5,
-	0xce, 0xfb, 0x3e, 0xef, 0xb3, 0xef, 0xc7, 0x33, 0x33, 0x8b, 0xde, 0xf3, 0x06, 0x84, 0xf1, 0x90,
-	0x30, 0x6e, 0x2b, 0x46, 0x25, 0xf5, 0x25, 0x3d, 0x26, 0xd2, 0x8f, 0xec, 0xa3, 0xbb, 0xb6, 0x9a,
-	0x8e, 0x69, 0x64, 0x8d, 0xa5, 0x50, 0x02, 0x57, 0x66, 0x38, 0xeb, 0x12, 0xce, 0x3a, 0xba, 0x5b,
-	0x59, 0x26, 0x21, 0xe3, 0xc2, 0x86, 0x67, 0x0c, 0xaf, 0xac, 0x78, 0x22, 0x0a, 0x45, 0xe4, 0xc2,
-	0xca, 0x8e, 0x17, 0x89, 0xeb, 0x56, 0x20, 0x02, 0x11, 0xdb, 0xf5, 0x5b, 0x62, 0xad, 0x06, 0x42,
-	0x04, 0x23, 0x6a, 0xc3, 0xea, 0x70, 0xf2, 0xc0, 0xf6, 0x27, 0x92, 0x28, 0x26, 0x78, 0xe2, 0xaf,
-	0xbd, 0xe8, 0x57, 0x2c, 0xa4, 0x91, 0x22, 0xe1, 0x38, 0x06, 0xac, 0xfd, 0x94, 0x41, 0xd9, 0x3e,
-	0xa3, 0x12, 0x17, 0x51, 0x86, 0xf9, 0xa6, 0x51, 0x37, 0xd6, 0x0b, 0x4e, 0x86, 0xf9, 0xf8, 0x3e,
-	0x2a, 0xd0, 0x47, 0x4c, 0xb9, 0x29, 0xa1, 0x99, 0xa9, 0x1b, 0xeb, 0x4b, 0x9b, 0x2b, 0x56, 0xcc,
-	0x68, 0xa5, 0x8c, 0x56, 0x3b, 0x01, 0xb4, 0x0a, 0x4f, 0x9e, 0xd5, 0xe6, 0xbe, 0xfb, 0xbd, 0x66,
-	0xfc, 0xf0, 0xe7, 0x8f, 0x1b, 0x86, 0x73, 0x53, 0x87, 0xa7, 0x4e, 0xdc, 0x43, 0xf9, 0x43, 0xc1,
-	0x27, 0x91, 0x4b, 0xc6, 0x53, 0x73, 0xbe, 0x6e, 0xac, 0xe7, 0x5b, 0x1f, 0x69, 0xfc, 0x6f, 0xcf,
-	0x6a, 0xb7, 0xe3, 0x3a, 0x23, 0x7f, 0x68, 0x31, 0x61, 0x87, 0x44, 0x0d, 0xac, 0x6d, 0x1a, 0x10,
-	0x6f, 0xda, 0xa6, 0xde, 0xe9, 0x49, 0x03, 0x25, 0x6d, 0x68, 0x53, 0x2f, 0x26, 0x5e, 0x04, 0xa2,
-	0xe6, 0x78, 0x8a, 0xbf, 0x42, 0xa5, 0x90, 0x71, 0x77, 0x24, 0xbc, 0xa1, 0x4b, 0x42, 0x31, 0xe1,
-	0xca, 0xcc, 0x02, 0xf5, 0x07, 0x09, 0xf5, 0x5b, 0x57, 0xa9, 0xbb, 0x5c, 0x3d, 0x47, 0xda, 0xe5,
-	0x2a, 0x26, 0x2d, 0x84, 0x8c, 0x6f, 0x0b, 0x6f, 0xd8, 0x04, 0x1a, 0xbc, 0x8a, 0x90, 0x37, 0x12,
-	0x11, 0x75, 0x05, 0x1f, 0x4d, 0xcd, 0x85, 0xba, 0xb1, 0xbe, 0xe8, 0xe4, 0xc1, 0xb2, 0xcb, 0x47,
-	0xd3, 0xb5, 0xbf, 0xb2, 0x68, 0x71, 0x4f, 0x44, 0x0c, 0x4a, 0xbb, 0xe8, 0x5c, 0x16, 0x3a, 0x67,
-	0xa1, 0x05, 0x71, 0xcc, 0xa9, 0x84, 0x8e, 0xe5, 0x5b, 0xe6, 0xe9, 0x49, 0xe3, 0x56, 0xf2, 0xb9,
-	0xa6, 0xef, 0x4b, 0x1a, 0x45, 0x3d, 0x25, 0x19, 0x0f, 0x9c, 0x18, 0x86, 0xdf, 0x41, 0x37, 0xb4,
-	0x36, 0x5c, 0xe6, 0x43, 0x63, 0x0a, 0x4e, 0x4e, 0x2f, 0xbb, 0x3e, 0xfe, 0x12, 0xe1, 0x11, 0x89,
-	0x94, 0x9b, 0x34, 0xce, 0xf3, 0xe4, 0x84, 0x8c, 0xa0, 0xc2, 0xa5, 0xcd, 0xca, 0x95, 0x39, 0xf4,
-	0xd3, 0xc9, 0xc6, 0x83, 0x78, 0x3c, 0x1b, 0x44, 0x59, 0x93, 0xb4, 0xa0, 0x67, 0x31, 0x05, 0xbe,
-	0x83, 0x8a, 0x40, 0x4c, 0x8f, 0x28, 0x57, 0x6e, 0x44, 0x1f, 0x42, 0x85, 0x59, 0xe7, 0xa6, 0xb6,
-	0x76, 0xb4, 0xb1, 0x47, 0x1f, 0xe2, 0x0d, 0xb4, 0x0c, 0xa8, 0x21, 0x17, 0xc7, 0x5c, 0x27, 0xe1,
-	0x53, 0xdf, 0xcc, 0x41, 0x2b, 0x4a, 0xda, 0xf1, 0xb9, 0xb6, 0xb7, 0xc0, 0x8c, 0xf7, 0xd1, 0x32,
-	0xa8, 0x45, 0x49, 0x16, 0x04, 0x5a, 0xe7, 0x2e, 0x51, 0xe6, 0x8d, 0xeb, 0x66, 0x5a, 0xd2, 0x1c,
-	0xfd, 0x94, 0xa2, 0xa9, 0xf0, 0x2e, 0x2a, 0x02, 0xed, 0x84, 0xc7, 0x33, 0x56, 0xe6, 0xe2, 0x75,
-	0x39, 0x41, 0x86, 0xfb, 0x10, 0xdf, 0x54, 0xba, 0x26, 0x4f, 0x52, 0xa2, 0x20, 0x41, 0x77, 0x40,
-	0x59, 0x30, 0x50, 0x66, 0x1e, 0x8a, 0x2f, 0x25, 0x8e, 0xa6, 0xda, 0x02, 0x33, 0xfe, 0x02, 0x95,
-	0x9e, 0xc3, 0xea, 0x8d, 0x63, 0xa2, 0xeb, 0x7e, 0xbd, 0x30, 0x23, 0xd5, 0x10, 0xdc, 0x41, 0xcb,
-	0x3e, 0x1d, 0xd1, 0x80, 0x28, 0x21, 0x5d, 0x12, 0x8b, 0xc1, 0x5c, 0xfa, 0x07, 0x99, 0x94, 0x67,
-	0x21, 0x89, 0x7d, 0xed, 0xe7, 0x05, 0x54, 0x4e, 0xe5, 0xe7, 0xd0, 0x68, 0x2c, 0x78, 0x44, 0xff,
-	0x3b, 0x19, 0x6e, 0xa1, 0xdc, 0xbf, 0xdc, 0x5c, 0x49, 0x3c, 0xfe, 0x04, 0xe5, 0x8f, 0xc8, 0x88,
-	0xf9, 0xba, 0x16, 0x90, 0x5c, 0xbe, 0xf5, 0xee, 0xe9, 0x49, 0x63, 0x35, 0xc1, 0x1f, 0xa4, 0xbe,
-	0xcb, 0xf9, 0x5d, 0xc4, 0x60, 0x82, 0xd2, 0x66, 0x50, 0xdf, 0x8d, 0x06, 0x44, 0xd2, 0x08, 0x14,
-	0xf9, 0xfa, 0x87, 0x49, 0x69, 0xc6, 0xd7, 0x03, 0xba, 0x37, 0x4a, 0xfe, 0x5f, 0x95, 0xfc, 0x4b,
-	0x06, 0x15, 0x67, 0x63, 0x87, 0x93, 0x07, 0xbf, 0x8d, 0x72, 0x49, 0x35, 0x5a, 0xcb, 0xf3, 0x4e,
-	0xb2, 0xc2, 0x9f, 0xa1, 0xfc, 0xec, 0xf2, 0x4a, 0x2e, 0xa3, 0x6b, 0xa4, 0x7f, 0x11, 0x8b, 0xef,
-	0x23, 0x14, 0x1f, 0x7c, 0xfa, 0xa2, 0x06, 0xad, 0x17, 0x37, 0x2d, 0xeb, 0xd5, 0x17, 0xb5, 0x75,
-	0x39, 0xc1, 0xfe, 0x74, 0x4c, 0x9d, 0x3c, 0x4d, 0x5f, 0xf1, 0xb7, 0xa8, 0xac, 0xc4, 0x90, 0xf2,
-	0xc8, 0x1d, 0x53, 0x19, 0x8b, 0x32, 0xd9, 0x28, 0xaf, 0xab, 0xc9, 0x62, 0xcc, 0xb7, 0x47, 0x25,
-	0x68, 0x12, 0xbf, 0x8f, 0x4a, 0x92, 0x3e, 0xa0, 0x92, 0x72, 0x8f, 0xba, 0x1e, 0xec, 0xc4, 0xf8,
-	0xbc, 0x2e, 0xce, 0xcc, 0xf7, 0xb4, 0x75, 0xe3, 0x7b, 0x03, 0xe1, 0xab, 0xc9, 0xe2, 0x3b, 0xa8,
-	0x7e, 0xd0, 0xdc, 0xee, 0xb6, 0x9b, 0xfd, 0x5d, 0xc7, 0xed, 0x1c, 0x74, 0x76, 0xfa, 0x6e, 0xff,
-	0xeb, 0xbd, 0x8e, 0xbb, 0xbf, 0xd3, 0xdb, 0xeb, 0xdc, 0xeb, 0x7e, 0xda, 0xed, 0xb4, 0xcb, 0x73,
-	0xb8, 0x8a, 0x2a, 0x2f, 0x45, 0xf5, 0xb6, 0x9b, 0xbd, 0xad, 0xb2, 0x81, 0x6b, 0xe8, 0xf6, 0x2b,
-	0x58, 0x5a, 0xbb, 0x3b, 0xed, 0x72, 0x06, 0xaf, 0xa2, 0x95, 0x97, 0x02, 0xc0, 0x3d, 0xdf, 0x3a,
-	0x78, 0x72, 0x56, 0x35, 0x9e, 0x9e, 0x55, 0x8d, 0x3f, 0xce, 0xaa, 0xc6, 0xe3, 0xf3, 0xea, 0xdc,
-	0xd3, 0xf3, 0xea, 0xdc, 0xaf, 0xe7, 0xd5, 0xb9, 0x6f, 0x3e, 0x0e, 0x98, 0x1a, 0x4c, 0x0e, 0x2d,
-	0x4f, 0x84, 0xb6, 0x27, 0xa7, 0x63, 0x25, 0x1a, 0x42, 0x06, 0x0d, 0x98, 0x88, 0x0d, 0xcf, 0x06,
-	0xfc, 0x69, 0x3d, 0x7a, 0xe1, 0x5f, 0x0b, 0x7e, 0xb4, 0x0e, 0x73, 0x30, 0xfc, 0x0f, 0xff, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0x3a, 0xdc, 0x64, 0xf1, 0x93, 0x09, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0x38, 0x8e, 0x37, 0xae, 0xac, 0x7f, 0xd2, 0x0a, 0x30, 0xc9, 0x2a, 0x8e, 0x89, 0x56,
+	0x10, 0x45, 0xf2, 0x0c, 0x1b, 0x24, 0x4e, 0x48, 0xc8, 0x5e, 0x1b, 0x62, 0x91, 0x75, 0xc2, 0xd8,
+	0x09, 0x3f, 0x97, 0x61, 0x32, 0xd3, 0x3b, 0x6e, 0xd9, 0xd3, 0xed, 0xed, 0x69, 0x3b, 0xeb, 0xb7,
+	0xd8, 0x23, 0xe2, 0x09, 0x38, 0x22, 0x91, 0x77, 0x60, 0x25, 0x0e, 0xac, 0x72, 0x42, 0x1c, 0x16,
+	0x94, 0x1c, 0x78, 0x09, 0x0e, 0xa8, 0x7b, 0x7e, 0xf2, 0xb7, 0x2b, 0x88, 0x22, 0x38, 0x71, 0x19,
+	0x4d, 0x57, 0x7d, 0xfd, 0x4d, 0x57, 0xd5, 0x57, 0x5d, 0x03, 0xef, 0xb8, 0x7d, 0x87, 0xd0, 0xc0,
+	0x21, 0xd4, 0x14, 0x04, 0x73, 0xec, 0x71, 0x7c, 0xe4, 0x70, 0x2f, 0x34, 0x27, 0x0f, 0x4c, 0x31,
+	0x1d, 0xe1, 0xd0, 0x18, 0x71, 0x26, 0x18, 0x5a, 0x49, 0x71, 0xc6, 0x25, 0x9c, 0x31, 0x79, 0xb0,
+	0xb2, 0xe8, 0x04, 0x84, 0x32, 0x53, 0x3d, 0x23, 0xf8, 0xca, 0xb2, 0xcb, 0xc2, 0x80, 0x85, 0xb6,
+	0x5a, 0x99, 0xd1, 0x22, 0x76, 0x2d, 0xf9, 0xcc, 0x67, 0x91, 0x5d, 0xbe, 0xc5, 0xd6, 0x8a, 0xcf,
+	0x98, 0x3f, 0xc4, 0xa6, 0x5a, 0x1d, 0x8e, 0x1f, 0x9b, 0xde, 0x98, 0x3b, 0x82, 0x30, 0x1a, 0xfb,
+	0xd7, 0xae, 0xfa, 0x05, 0x09, 0x70, 0x28, 0x9c, 0x60, 0x14, 0x01, 0xd6, 0x7f, 0xc8, 0x40, 0xb6,
+	0x47, 0x30, 0x47, 0x45, 0xc8, 0x10, 0x4f, 0xd7, 0xaa, 0xda, 0x46, 0xc1, 0xca, 0x10, 0x0f, 0x3d,
+	0x82, 0x02, 0x7e, 0x4a, 0x84, 0x9d, 0x10, 0xea, 0x99, 0xaa, 0xb6, 0xb1, 0xb0, 0xb5, 0x6c, 0x44,
+	0x8c, 0x46, 0xc2, 0x68, 0x34, 0x63, 0x40, 0xa3, 0xf0, 0xfc, 0xe5, 0xda, 0xcc, 0x37, 0xbf, 0xad,
+	0x69, 0xdf, 0xfd, 0xf1, 0xfd, 0xa6, 0x66, 0xdd, 0x95, 0xdb, 0x13, 0x27, 0xea, 0x42, 0xfe, 0x90,
+	0xd1, 0x71, 0x68, 0x3b, 0xa3, 0xa9, 0x3e, 0x5b, 0xd5, 0x36, 0xf2, 0x8d, 0x0f, 0x24, 0xfe, 0xd7,
+	0x97, 0x6b, 0xf7, 0xa2, 0x38, 0x43, 0x6f, 0x60, 0x10, 0x66, 0x06, 0x8e, 0xe8, 0x1b, 0x3b, 0xd8,
+	0x77, 0xdc, 0x69, 0x13, 0xbb, 0x27, 0xc7, 0x35, 0x88, 0xd3, 0xd0, 0xc4, 0x6e, 0x44, 0x3c, 0xaf,
+	0x88, 0xea, 0xa3, 0x29, 0xfa, 0x02, 0x4a, 0x01, 0xa1, 0xf6, 0x90, 0xb9, 0x03, 0xdb, 0x09, 0xd8,
+	0x98, 0x0a, 0x3d, 0xab, 0xa8, 0xdf, 0x8b, 0xa9, 0xdf, 0xb8, 0x4e, 0xdd, 0xa6, 0xe2, 0x02, 0x69,
+	0x9b, 0x8a, 0x88, 0xb4, 0x10, 0x10, 0xba, 0xc3, 0xdc, 0x41, 0x5d, 0xd1, 0xa0, 0x55, 0x00, 0x77,
+	0xc8, 0x42, 0x6c, 0x33, 0x3a, 0x9c, 0xea, 0x73, 0x55, 0x6d, 0x63, 0xde, 0xca, 0x2b, 0xcb, 0x2e,
+	0x1d, 0x4e, 0xd7, 0xff, 0xcc, 0xc2, 0xfc, 0x1e, 0x0b, 0x89, 0x0a, 0xed, 0x3c, 0x73, 0x59, 0x95,
+	0x39, 0x03, 0xe6, 0xd8, 0x11, 0xc5, 0x5c, 0x65, 0x2c, 0xdf, 0xd0, 0x4f, 0x8e, 0x6b, 0x4b, 0xf1,
+	0xe7, 0xea, 0x9e, 0xc7, 0x71, 0x18, 0x76, 0x05, 0x27, 0xd4, 0xb7, 0x22, 0x18, 0x7a, 0x0b, 0xee,
+	0x48, 0x6d, 0xd8, 0xc4, 0x53, 0x89, 0x29, 0x58, 0x39, 0xb9, 0x6c, 0x7b, 0xe8, 0x73, 0x40, 0x43,
+	0x27, 0x14, 0x76, 0x9c, 0x38, 0xd7, 0xe5, 0x63, 0x67, 0xa8, 0x22, 0x5c, 0xd8, 0x5a, 0xb9, 0x56,
+	0x87, 0x5e, 0x52, 0xd9, 0xa8, 0x10, 0xcf, 0xd2, 0x42, 0x94, 0x25, 0x49, 0x43, 0xe5, 0x2c, 0xa2,
+	0x40, 0xf7, 0xa1, 0xa8, 0x88, 0xf1, 0x04, 0x53, 0x61, 0x87, 0xf8, 0x89, 0x8a, 0x30, 0x6b, 0xdd,
+	0x95, 0xd6, 0x96, 0x34, 0x76, 0xf1, 0x13, 0xb4, 0x09, 0x8b, 0x0a, 0x35, 0xa0, 0xec, 0x88, 0xca,
+	0x43, 0x78, 0xd8, 0xd3, 0x73, 0x2a, 0x15, 0x25, 0xe9, 0xf8, 0x54, 0xda, 0x1b, 0xca, 0x8c, 0xf6,
+	0x61, 0x51, 0xa9, 0x45, 0x70, 0xe2, 0xfb, 0x52, 0xe7, 0xb6, 0x23, 0xf4, 0x3b, 0x37, 0x3d, 0x69,
+	0x49, 0x72, 0xf4, 0x12, 0x8a, 0xba, 0x40, 0xbb, 0x50, 0x54, 0xb4, 0x63, 0x1a, 0xd5, 0x58, 0xe8,
+	0xf3, 0x37, 0xe5, 0x54, 0x32, 0xdc, 0x57, 0xfb, 0xeb, 0x42, 0xc6, 0xe4, 0x72, 0xec, 0x08, 0x75,
+	0x40, 0xbb, 0x8f, 0x89, 0xdf, 0x17, 0x7a, 0x5e, 0x05, 0x5f, 0x8a, 0x1d, 0x75, 0xb1, 0xad, 0xcc,
+	0xe8, 0x33, 0x28, 0x5d, 0xc0, 0xca, 0xc6, 0xd1, 0xe1, 0xa6, 0x5f, 0x2f, 0xa4, 0xa4, 0x12, 0x82,
+	0x5a, 0xb0, 0xe8, 0xe1, 0x21, 0xf6, 0x1d, 0xc1, 0xb8, 0xed, 0x44, 0x62, 0xd0, 0x17, 0xfe, 0x46,
+	0x26, 0xe5, 0x74, 0x4b, 0x6c, 0x5f, 0xff, 0x71, 0x0e, 0xca, 0x89, 0xfc, 0x2c, 0x1c, 0x8e, 0x18,
+	0x0d, 0xf1, 0xbf, 0x27, 0xc3, 0x6d, 0xc8, 0xdd, 0xb2, 0xb9, 0xe2, 0xfd, 0xe8, 0x23, 0xc8, 0x4f,
+	0x9c, 0x21, 0xf1, 0x64, 0x2c, 0x4a, 0x72, 0xf9, 0xc6, 0xdb, 0x27, 0xc7, 0xb5, 0xd5, 0x18, 0x7f,
+	0x90, 0xf8, 0x2e, 0x9f, 0xef, 0x7c, 0x0f, 0x72, 0x20, 0x49, 0x06, 0xf6, 0xec, 0xb0, 0xef, 0x70,
+	0x1c, 0xea, 0xb9, 0x5b, 0x5d, 0x26, 0xa5, 0x94, 0xaf, 0xab, 0xe8, 0xfe, 0x57, 0xf2, 0x7f, 0xaa,
+	0xe4, 0x9f, 0x32, 0x50, 0x4c, 0xcb, 0xae, 0x6e, 0x1e, 0xf4, 0x26, 0xe4, 0xe2, 0x68, 0xa4, 0x96,
+	0x67, 0xad, 0x78, 0x85, 0x3e, 0x81, 0x7c, 0x3a, 0xbc, 0xf4, 0xcc, 0x4d, 0x8f, 0x7f, 0xbe, 0x17,
+	0x3d, 0x02, 0x88, 0x2e, 0x3e, 0x39, 0xa8, 0x95, 0xd6, 0x8b, 0x5b, 0x86, 0xf1, 0xfa, 0x41, 0x6d,
+	0x5c, 0x3e, 0x60, 0x6f, 0x3a, 0xc2, 0x56, 0x1e, 0x27, 0xaf, 0xe8, 0x6b, 0x28, 0x0b, 0x36, 0xc0,
+	0x34, 0xb4, 0x47, 0x98, 0x47, 0xa2, 0xd4, 0xb3, 0xb7, 0xd2, 0x64, 0x31, 0xe2, 0xdb, 0xc3, 0x5c,
+	0x69, 0x12, 0xbd, 0x0b, 0x25, 0x8e, 0x1f, 0x63, 0x8e, 0xa9, 0x8b, 0x6d, 0x57, 0x75, 0x62, 0x74,
+	0x5f, 0x17, 0x53, 0xf3, 0x43, 0x69, 0x5d, 0xff, 0x59, 0x83, 0xa5, 0xe4, 0x5e, 0x90, 0xc3, 0x0c,
+	0x7b, 0xf1, 0x38, 0xbb, 0xd0, 0xdb, 0xda, 0xa5, 0xde, 0xee, 0xc0, 0x62, 0xda, 0x5d, 0x69, 0x19,
+	0x33, 0xff, 0xb4, 0x33, 0xcb, 0x93, 0x2b, 0x76, 0xd4, 0x81, 0x5c, 0xdc, 0x96, 0xb7, 0x9b, 0xf1,
+	0x31, 0xcb, 0xe6, 0xb7, 0x1a, 0xa0, 0xeb, 0xe9, 0x47, 0xf7, 0xa1, 0x7a, 0x50, 0xdf, 0x69, 0x37,
+	0xeb, 0xbd, 0x5d, 0xcb, 0x6e, 0x1d, 0xb4, 0x3a, 0x3d, 0xbb, 0xf7, 0xe5, 0x5e, 0xcb, 0xde, 0xef,
+	0x74, 0xf7, 0x5a, 0x0f, 0xdb, 0x1f, 0xb7, 0x5b, 0xcd, 0xf2, 0x0c, 0xaa, 0xc0, 0xca, 0x2b, 0x51,
+	0xdd, 0x9d, 0x7a, 0x77, 0xbb, 0xac, 0xa1, 0x35, 0xb8, 0xf7, 0x1a, 0x96, 0xc6, 0x6e, 0xa7, 0x59,
+	0xce, 0xa0, 0x55, 0x58, 0x7e, 0x25, 0x40, 0xb9, 0x67, 0x1b, 0x07, 0xcf, 0x4f, 0x2b, 0xda, 0x8b,
+	0xd3, 0x8a, 0xf6, 0xfb, 0x69, 0x45, 0x7b, 0x76, 0x56, 0x99, 0x79, 0x71, 0x56, 0x99, 0xf9, 0xe5,
+	0xac, 0x32, 0xf3, 0xd5, 0x87, 0x3e, 0x11, 0xfd, 0xf1, 0xa1, 0xe1, 0xb2, 0xc0, 0x74, 0xf9, 0x74,
+	0x24, 0x58, 0x8d, 0x71, 0xbf, 0xa6, 0x34, 0x66, 0xaa, 0x67, 0x4d, 0xfd, 0x3b, 0x3e, 0xbd, 0xf2,
+	0xf7, 0xa8, 0x7e, 0x1d, 0x0f, 0x73, 0x4a, 0xce, 0xef, 0xff, 0x35, 0x00, 0x9f, 0x8f, 0xe0, 0xe1,
+	0x65, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tier) Marshal() (dAtA []byte, err error) {
@@ -865,6 +926,51 @@ func (m *ValidatorEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PositionLockedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLockedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLockedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TierId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TierId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -999,6 +1105,24 @@ func (m *ValidatorEvent) Size() (n int) {
 	return n
 }
 
+func (m *PositionLockedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TierId != 0 {
+		n += 1 + sovTypes(uint64(m.TierId))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2081,6 +2205,141 @@ func (m *ValidatorEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PositionLockedAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLockedAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLockedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TierId", wireType)
+			}
+			m.TierId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TierId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0